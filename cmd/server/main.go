@@ -1,72 +1,183 @@
 package main
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"log"
+	"net/http"
+	"os/signal"
+	"syscall"
+	"time"
+	_ "time/tzdata" // Report ?tz= zones resolve even on hosts without a zoneinfo database
 
 	"github.com/gin-gonic/gin"
 	"github.com/maximicciullo/personal-finance-api/internal/config"
 	"github.com/maximicciullo/personal-finance-api/internal/controllers"
 	"github.com/maximicciullo/personal-finance-api/internal/middleware"
+	"github.com/maximicciullo/personal-finance-api/internal/models"
 	"github.com/maximicciullo/personal-finance-api/internal/repositories"
 	"github.com/maximicciullo/personal-finance-api/internal/services"
 	"go.uber.org/zap"
 )
 
+// shutdownTimeout bounds how long in-flight requests may take to drain on shutdown
+const shutdownTimeout = 10 * time.Second
+
 func main() {
 	// Load configuration
 	cfg := config.Load()
 
 	// Initialize logger
+	middleware.SetLogEmoji(cfg.LogEmoji)
+	middleware.SetSlowThreshold(cfg.LogSlowThreshold)
 	if err := middleware.InitLogger(cfg.Environment); err != nil {
 		log.Fatal("Failed to initialize logger:", err)
 	}
 	defer middleware.Logger.Sync()
 
+	// Business events must be routed before repositories and services capture their logger
+	if err := middleware.InitBusinessLogger(cfg.BusinessLogOutput, cfg.BusinessLogLevel); err != nil {
+		log.Fatal("Failed to initialize business logger:", err)
+	}
+	defer middleware.BusinessLogger().Sync()
+
+	if err := models.SetDateOutputFormat(cfg.DateOutputFormat); err != nil {
+		log.Fatal("Invalid DATE_OUTPUT_FORMAT:", err)
+	}
+
+	if err := models.SetCurrencyMeta(cfg.CurrencySymbols, cfg.CurrencyDecimals); err != nil {
+		log.Fatal("Invalid CURRENCY_DECIMALS:", err)
+	}
+
 	// Set Gin mode based on environment
 	if cfg.Environment == "production" {
 		gin.SetMode(gin.ReleaseMode)
 	}
 
 	// Initialize repositories
-	transactionRepo := repositories.NewMemoryTransactionRepository()
+	transactionRepo := repositories.NewMemoryTransactionRepositoryWithConfig(repositories.MemoryTransactionRepositoryConfig{
+		MaxTransactions: cfg.MaxTransactions,
+	})
+	auditRepo := repositories.NewMemoryAuditRepository()
+	templateRepo := repositories.NewMemoryTemplateRepository()
+	categoryMetaRepo := repositories.NewMemoryCategoryMetaRepository()
+	closedMonthRepo := repositories.NewMemoryClosedMonthRepository()
 
 	// Initialize services
-	transactionService := services.NewTransactionService(transactionRepo)
-	reportService := services.NewReportService(transactionRepo)
+	transactionService := services.NewTransactionServiceWithConfig(transactionRepo, auditRepo, services.TransactionServiceConfig{
+		DefaultCurrency:     cfg.DefaultCurrency,
+		SupportedCurrencies: cfg.SupportedCurrencies,
+		CategoryAliases:     cfg.CategoryAliases,
+		ReservedCategories:  cfg.ReservedCategories,
+		ExchangeRates:       cfg.ExchangeRates,
+		FarDateWarning:      cfg.FarDateWarning,
+		ClosedMonths:        closedMonthRepo,
+	})
+	templateService := services.NewTemplateService(templateRepo, transactionService)
+	reportService := services.NewReportServiceWithConfig(transactionRepo, services.ReportServiceConfig{
+		OpeningBalances: cfg.OpeningBalances,
+		ExchangeRates:   cfg.ExchangeRates,
+		CategoryMeta:    categoryMetaRepo,
+		ClosedMonths:    closedMonthRepo,
+	})
+	categoryMetaService := services.NewCategoryMetaService(categoryMetaRepo)
 
 	// Initialize controllers
-	healthController := controllers.NewHealthController()
-	transactionController := controllers.NewTransactionController(transactionService)
+	healthController := controllers.NewHealthControllerWithConfig(controllers.HealthControllerConfig{
+		WebhookURL:     cfg.WebhookURL,
+		CheckWebhook:   cfg.WebhookHealthCheck,
+		WebhookTimeout: cfg.WebhookTimeout,
+	})
+	transactionController := controllers.NewTransactionControllerWithConfig(transactionService, controllers.TransactionControllerConfig{
+		MaxPageSize:        cfg.MaxPageSize,
+		DefaultPageSize:    cfg.DefaultPageSize,
+		EmptyListStatus:    cfg.EmptyListStatus,
+		ExportWriteTimeout: cfg.WriteTimeout,
+	})
+	transactionControllerV2 := controllers.NewTransactionControllerWithConfig(transactionService, controllers.TransactionControllerConfig{
+		MaxPageSize:     cfg.MaxPageSize,
+		DefaultPageSize: cfg.DefaultPageSize,
+		EmptyListStatus: cfg.EmptyListStatus,
+		Envelope:        true,
+	})
 	reportController := controllers.NewReportController(reportService)
+	templateController := controllers.NewTemplateController(templateService)
+	categoryMetaController := controllers.NewCategoryMetaController(categoryMetaService)
+	settingsController := controllers.NewSettingsController(cfg.Sanitized())
+	currencyController := controllers.NewCurrencyController()
 
 	// Setup routes
-	router := setupRoutes(cfg, healthController, transactionController, reportController)
+	router := setupRoutes(cfg, healthController, transactionController, transactionControllerV2, reportController, templateController, categoryMetaController, settingsController, currencyController)
 
 	// Start server
 	printStartupInfo(cfg)
-	middleware.Logger.Info("🚀 Server starting",
+	middleware.Logger.Info(middleware.EmojiMessage("🚀", "Server starting"),
 		zap.String("port", cfg.Port),
 		zap.String("environment", cfg.Environment),
 	)
 
-	log.Fatal(router.Run(":" + cfg.Port))
+	server := newServer(cfg, router)
+
+	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer stop()
+
+	go func() {
+		if err := server.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			log.Fatal("Server failed:", err)
+		}
+	}()
+
+	<-ctx.Done()
+	middleware.Logger.Info(middleware.EmojiMessage("🛑", "Shutting down server"))
+
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+	defer cancel()
+
+	if err := server.Shutdown(shutdownCtx); err != nil {
+		middleware.Logger.Error(middleware.EmojiMessage("❌", "Graceful shutdown failed"), zap.Error(err))
+	}
+}
+
+// newServer builds the HTTP server with the configured timeouts so slow clients
+// cannot hold connections open indefinitely
+func newServer(cfg *config.Config, handler http.Handler) *http.Server {
+	return &http.Server{
+		Addr:              ":" + cfg.Port,
+		Handler:           handler,
+		ReadTimeout:       cfg.ReadTimeout,
+		ReadHeaderTimeout: cfg.ReadTimeout,
+		WriteTimeout:      cfg.WriteTimeout,
+		IdleTimeout:       cfg.IdleTimeout,
+	}
 }
 
 func setupRoutes(
 	cfg *config.Config,
 	healthController *controllers.HealthController,
 	transactionController *controllers.TransactionController,
+	transactionControllerV2 *controllers.TransactionController,
 	reportController *controllers.ReportController,
+	templateController *controllers.TemplateController,
+	categoryMetaController *controllers.CategoryMetaController,
+	settingsController *controllers.SettingsController,
+	currencyController *controllers.CurrencyController,
 ) *gin.Engine {
 	router := gin.Default()
 
+	if err := configureTrustedProxies(router, cfg); err != nil {
+		log.Fatal("Invalid TRUSTED_PROXIES:", err)
+	}
+
 	// Global middleware
 	router.Use(gin.Recovery())
 
 	// Logging middleware based on environment
 	if cfg.Environment == "production" {
-		router.Use(middleware.ProductionLogger())
+		logConfig := middleware.ProductionLogConfig()
+		logConfig.SkipPaths = []string{cfg.HealthPath, cfg.HealthPath + "/ready", "/metrics"}
+		logConfig.SampleEvery = cfg.LogSampleEvery
+		router.Use(middleware.ZapLoggerWithConfig(logConfig))
 	} else {
 		router.Use(middleware.DevelopmentLogger())
 	}
@@ -84,8 +195,18 @@ func setupRoutes(
 		router.Use(middleware.DevelopmentCORS())
 	}
 
+	// Request timeout middleware
+	timeoutConfig := middleware.DefaultTimeoutConfig()
+	timeoutConfig.Timeout = cfg.RequestTimeout
+	timeoutConfig.ExemptPaths = []string{"/api/v1/transactions/export"}
+	router.Use(middleware.TimeoutWithConfig(timeoutConfig))
+
+	// Unknown routes return the standard JSON error
+	router.NoRoute(controllers.NotFound)
+
 	// Health check endpoint
-	router.GET("/health", healthController.HealthCheck)
+	router.GET(cfg.HealthPath, healthController.HealthCheck)
+	router.GET(cfg.HealthPath+"/ready", healthController.ReadinessCheck)
 
 	// API routes group
 	api := router.Group("/api/v1")
@@ -94,29 +215,113 @@ func setupRoutes(
 		transactions := api.Group("/transactions")
 		{
 			transactions.POST("", transactionController.CreateTransaction)
+			transactions.POST("/import", transactionController.ImportTransactions)
+			transactions.POST("/recategorize", transactionController.RecategorizeTransactions)
+			transactions.POST("/validate", transactionController.ValidateTransaction)
 			transactions.GET("", transactionController.GetTransactions)
+			transactions.PATCH("", transactionController.BulkUpdateTransactions)
+			transactions.GET("/recent", transactionController.GetRecentTransactions)
+			transactions.GET("/top-expenses", transactionController.GetTopExpenses)
+			transactions.GET("/by-day/:year/:month", transactionController.GetTransactionsByDay)
+			transactions.GET("/batch", transactionController.GetTransactionsBatch)
+			transactions.GET("/export", transactionController.ExportTransactions)
 			transactions.GET("/:id", transactionController.GetTransaction)
+			transactions.GET("/:id/history", transactionController.GetTransactionHistory)
 			transactions.PUT("/:id", transactionController.UpdateTransaction)
+			transactions.PATCH("/:id", transactionController.UpdateTransaction)
+			transactions.POST("/:id/pin", transactionController.PinTransaction)
+			transactions.POST("/:id/unpin", transactionController.UnpinTransaction)
+			transactions.POST("/:id/clone", transactionController.CloneTransaction)
 			transactions.DELETE("/:id", transactionController.DeleteTransaction)
 		}
 
 		// Report routes
 		reports := api.Group("/reports")
 		{
+			reports.GET("/months", reportController.GetReportMonths)
 			reports.GET("/monthly/:year/:month", reportController.GetMonthlyReport)
+			reports.GET("/monthly/:year/:month/totals", reportController.GetMonthlyTotals)
 			reports.GET("/current-month", reportController.GetCurrentMonthReport)
+			reports.GET("/savings-rate/:year/:month", reportController.GetSavingsRate)
+			reports.GET("/income-expense/:year/:month", reportController.GetIncomeExpense)
+			reports.GET("/diff", reportController.GetMonthDiff)
+			reports.GET("/group-by", reportController.GetGroupedReport)
+			reports.GET("/networth", reportController.GetNetWorthReport)
+			reports.GET("/balance", reportController.GetBalanceAsOf)
+			reports.POST("/close/:year/:month", reportController.CloseMonth)
+			reports.POST("/reopen/:year/:month", reportController.ReopenMonth)
+			if cfg.FeatureEnabled(config.FeatureReportInsights) {
+				reports.GET("/anomalies", reportController.GetAnomalyReport)
+				reports.GET("/trends/category/:category", reportController.GetCategoryTrend)
+			}
+		}
+
+		// Template routes
+		templates := api.Group("/templates")
+		{
+			templates.POST("", templateController.CreateTemplate)
+			templates.GET("", templateController.GetTemplates)
+			templates.GET("/:id", templateController.GetTemplate)
+			templates.PUT("/:id", templateController.UpdateTemplate)
+			templates.DELETE("/:id", templateController.DeleteTemplate)
+			templates.POST("/:id/apply", templateController.ApplyTemplate)
+		}
+
+		// Category display metadata, keyed by category name
+		categoryMeta := api.Group("/category-meta")
+		{
+			categoryMeta.GET("", categoryMetaController.GetAllCategoryMeta)
+			categoryMeta.GET("/:category", categoryMetaController.GetCategoryMeta)
+			categoryMeta.PUT("/:category", categoryMetaController.SetCategoryMeta)
+			categoryMeta.DELETE("/:category", categoryMetaController.DeleteCategoryMeta)
+		}
+
+		// Effective non-sensitive configuration
+		api.GET("/settings", settingsController.GetSettings)
+
+		// Currency symbols and decimal places
+		api.GET("/currencies/meta", currencyController.GetCurrencyMeta)
+
+		// Storage diagnostics
+		api.GET("/admin/integrity", transactionController.CheckIntegrity)
+	}
+
+	// v2 shares the service layer; list responses are always enveloped
+	if cfg.FeatureEnabled(config.FeatureAPIV2) {
+		apiV2 := router.Group("/api/v2")
+		{
+			transactions := apiV2.Group("/transactions")
+			{
+				transactions.GET("", transactionControllerV2.GetTransactions)
+				transactions.GET("/recent", transactionControllerV2.GetRecentTransactions)
+				transactions.GET("/:id", transactionControllerV2.GetTransaction)
+			}
 		}
 	}
 
 	return router
 }
 
+// configureTrustedProxies limits which proxies may set the client IP via X-Forwarded-For.
+// Production trusts none unless configured; development keeps gin's permissive default.
+func configureTrustedProxies(router *gin.Engine, cfg *config.Config) error {
+	if len(cfg.TrustedProxies) > 0 {
+		return router.SetTrustedProxies(cfg.TrustedProxies)
+	}
+	if cfg.Environment == "production" {
+		return router.SetTrustedProxies(nil)
+	}
+	return nil
+}
+
 func printStartupInfo(cfg *config.Config) {
 	fmt.Printf("\n🚀 Personal Finance API\n")
 	fmt.Printf("━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━\n")
 	fmt.Printf("🌐 Server starting on port: %s\n", cfg.Port)
 	fmt.Printf("🏗️  Environment: %s\n", cfg.Environment)
 	fmt.Printf("💰 Default currency: %s\n", cfg.DefaultCurrency)
+	fmt.Printf("⏱️  Request timeout: %s\n", cfg.RequestTimeout)
+	fmt.Printf("🔌 Server timeouts: read=%s write=%s idle=%s\n", cfg.ReadTimeout, cfg.WriteTimeout, cfg.IdleTimeout)
 
 	baseURL := fmt.Sprintf("http://localhost:%s", cfg.Port)
 	fmt.Printf("🔗 Base URL: %s\n", baseURL)
@@ -126,26 +331,85 @@ func printStartupInfo(cfg *config.Config) {
 
 	// Health endpoint
 	fmt.Printf("🔍 Health Check:\n")
-	fmt.Printf("  GET    %s/health\n", baseURL)
+	fmt.Printf("  GET    %s%s\n", baseURL, cfg.HealthPath)
+	fmt.Printf("  GET    %s%s/ready\n", baseURL, cfg.HealthPath)
 
 	// Transaction endpoints
 	fmt.Printf("\n💳 Transactions:\n")
 	fmt.Printf("  POST   %s/api/v1/transactions\n", baseURL)
 	fmt.Printf("  GET    %s/api/v1/transactions\n", baseURL)
+	fmt.Printf("  PATCH  %s/api/v1/transactions\n", baseURL)
+	fmt.Printf("  POST   %s/api/v1/transactions/import?format=ofx\n", baseURL)
+	fmt.Printf("  POST   %s/api/v1/transactions/recategorize\n", baseURL)
+	fmt.Printf("  POST   %s/api/v1/transactions/validate\n", baseURL)
+	fmt.Printf("  GET    %s/api/v1/transactions/recent\n", baseURL)
+	fmt.Printf("  GET    %s/api/v1/transactions/top-expenses?currency=\n", baseURL)
+	fmt.Printf("  GET    %s/api/v1/transactions/by-day/:year/:month\n", baseURL)
+	fmt.Printf("  GET    %s/api/v1/transactions/batch?ids=1,2,3\n", baseURL)
+	fmt.Printf("  GET    %s/api/v1/transactions/export\n", baseURL)
 	fmt.Printf("  GET    %s/api/v1/transactions/:id\n", baseURL)
+	fmt.Printf("  GET    %s/api/v1/transactions/:id/history\n", baseURL)
 	fmt.Printf("  PUT    %s/api/v1/transactions/:id\n", baseURL)
+	fmt.Printf("  PATCH  %s/api/v1/transactions/:id\n", baseURL)
+	fmt.Printf("  POST   %s/api/v1/transactions/:id/pin\n", baseURL)
+	fmt.Printf("  POST   %s/api/v1/transactions/:id/unpin\n", baseURL)
+	fmt.Printf("  POST   %s/api/v1/transactions/:id/clone\n", baseURL)
 	fmt.Printf("  DELETE %s/api/v1/transactions/:id\n", baseURL)
 
 	// Report endpoints
 	fmt.Printf("\n📊 Reports:\n")
 	fmt.Printf("  GET    %s/api/v1/reports/monthly/:year/:month\n", baseURL)
+	fmt.Printf("  GET    %s/api/v1/reports/monthly/:year/:month/totals\n", baseURL)
+	fmt.Printf("  GET    %s/api/v1/reports/months\n", baseURL)
 	fmt.Printf("  GET    %s/api/v1/reports/current-month\n", baseURL)
+	fmt.Printf("  GET    %s/api/v1/reports/savings-rate/:year/:month\n", baseURL)
+	fmt.Printf("  GET    %s/api/v1/reports/income-expense/:year/:month\n", baseURL)
+	fmt.Printf("  GET    %s/api/v1/reports/diff?a=YYYY-MM&b=YYYY-MM\n", baseURL)
+	fmt.Printf("  GET    %s/api/v1/reports/group-by?field=category\n", baseURL)
+	fmt.Printf("  GET    %s/api/v1/reports/networth?from=&to=&granularity=month\n", baseURL)
+	fmt.Printf("  GET    %s/api/v1/reports/balance?as_of=YYYY-MM-DD&base=USD\n", baseURL)
+	fmt.Printf("  POST   %s/api/v1/reports/close/:year/:month\n", baseURL)
+	fmt.Printf("  POST   %s/api/v1/reports/reopen/:year/:month\n", baseURL)
+	if cfg.FeatureEnabled(config.FeatureReportInsights) {
+		fmt.Printf("  GET    %s/api/v1/reports/anomalies?from=&to=\n", baseURL)
+		fmt.Printf("  GET    %s/api/v1/reports/trends/category/:category?months=6\n", baseURL)
+	}
+
+	// Template endpoints
+	fmt.Printf("\n📝 Templates:\n")
+	fmt.Printf("  POST   %s/api/v1/templates\n", baseURL)
+	fmt.Printf("  GET    %s/api/v1/templates\n", baseURL)
+	fmt.Printf("  GET    %s/api/v1/templates/:id\n", baseURL)
+	fmt.Printf("  PUT    %s/api/v1/templates/:id\n", baseURL)
+	fmt.Printf("  DELETE %s/api/v1/templates/:id\n", baseURL)
+	fmt.Printf("  POST   %s/api/v1/templates/:id/apply\n", baseURL)
+
+	// Category metadata endpoints
+	fmt.Printf("\n🎨 Category Metadata:\n")
+	fmt.Printf("  GET    %s/api/v1/category-meta\n", baseURL)
+	fmt.Printf("  GET    %s/api/v1/category-meta/:category\n", baseURL)
+	fmt.Printf("  PUT    %s/api/v1/category-meta/:category\n", baseURL)
+	fmt.Printf("  DELETE %s/api/v1/category-meta/:category\n", baseURL)
+
+	// Settings endpoint
+	fmt.Printf("\n⚙️  Settings:\n")
+	fmt.Printf("  GET    %s/api/v1/settings\n", baseURL)
+	fmt.Printf("  GET    %s/api/v1/currencies/meta\n", baseURL)
+	fmt.Printf("  GET    %s/api/v1/admin/integrity\n", baseURL)
+
+	// v2 endpoints
+	if cfg.FeatureEnabled(config.FeatureAPIV2) {
+		fmt.Printf("\n📦 v2 (enveloped lists):\n")
+		fmt.Printf("  GET    %s/api/v2/transactions\n", baseURL)
+		fmt.Printf("  GET    %s/api/v2/transactions/recent\n", baseURL)
+		fmt.Printf("  GET    %s/api/v2/transactions/:id\n", baseURL)
+	}
 
 	// Quick test commands
 	fmt.Printf("\n🧪 Quick Test Commands:\n")
 	fmt.Printf("━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━\n")
 	fmt.Printf("# Health check\n")
-	fmt.Printf("curl %s/health\n\n", baseURL)
+	fmt.Printf("curl %s%s\n\n", baseURL, cfg.HealthPath)
 
 	fmt.Printf("# Create transaction\n")
 	fmt.Printf("curl -X POST %s/api/v1/transactions \\\n", baseURL)
@@ -0,0 +1,161 @@
+package main
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/maximicciullo/personal-finance-api/internal/config"
+	"github.com/maximicciullo/personal-finance-api/internal/controllers"
+	"github.com/maximicciullo/personal-finance-api/internal/middleware"
+	"github.com/maximicciullo/personal-finance-api/internal/repositories"
+	"github.com/maximicciullo/personal-finance-api/internal/services"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewServer_UsesConfiguredTimeouts(t *testing.T) {
+	// Given
+	cfg := &config.Config{
+		Port:         "9090",
+		ReadTimeout:  5 * time.Second,
+		WriteTimeout: 45 * time.Second,
+		IdleTimeout:  90 * time.Second,
+	}
+	handler := http.NewServeMux()
+
+	// When
+	server := newServer(cfg, handler)
+
+	// Then
+	assert.Equal(t, ":9090", server.Addr)
+	assert.Equal(t, handler, server.Handler)
+	assert.Equal(t, 5*time.Second, server.ReadTimeout)
+	assert.Equal(t, 5*time.Second, server.ReadHeaderTimeout)
+	assert.Equal(t, 45*time.Second, server.WriteTimeout)
+	assert.Equal(t, 90*time.Second, server.IdleTimeout)
+}
+
+func clientIPRouter(t *testing.T, cfg *config.Config) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	assert.NoError(t, configureTrustedProxies(router, cfg))
+	router.GET("/ip", func(c *gin.Context) {
+		c.String(http.StatusOK, c.ClientIP())
+	})
+	return router
+}
+
+func requestClientIP(router *gin.Engine, remoteAddr string) string {
+	req := httptest.NewRequest("GET", "/ip", nil)
+	req.RemoteAddr = remoteAddr
+	req.Header.Set("X-Forwarded-For", "203.0.113.7")
+
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+	return w.Body.String()
+}
+
+func TestConfigureTrustedProxies_HonorsForwardedForFromTrustedProxy(t *testing.T) {
+	// Given
+	router := clientIPRouter(t, &config.Config{
+		Environment:    "production",
+		TrustedProxies: []string{"192.0.2.1"},
+	})
+
+	// When / Then
+	assert.Equal(t, "203.0.113.7", requestClientIP(router, "192.0.2.1:4000"))
+	assert.Equal(t, "198.51.100.2", requestClientIP(router, "198.51.100.2:4000"))
+}
+
+func TestConfigureTrustedProxies_ProductionIgnoresForwardedForByDefault(t *testing.T) {
+	// Given
+	router := clientIPRouter(t, &config.Config{Environment: "production"})
+
+	// When / Then
+	assert.Equal(t, "192.0.2.1", requestClientIP(router, "192.0.2.1:4000"))
+}
+
+func TestConfigureTrustedProxies_InvalidProxy(t *testing.T) {
+	// Given
+	router := gin.New()
+
+	// When
+	err := configureTrustedProxies(router, &config.Config{TrustedProxies: []string{"not-an-ip"}})
+
+	// Then
+	assert.Error(t, err)
+}
+
+// routesRouter wires every controller over fresh in-memory repositories
+func routesRouter(cfg *config.Config) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	middleware.InitLogger("test")
+	repo := repositories.NewMemoryTransactionRepository()
+	return setupRoutes(
+		cfg,
+		controllers.NewHealthController(),
+		controllers.NewTransactionController(services.NewTransactionService(repo)),
+		controllers.NewTransactionControllerWithConfig(services.NewTransactionService(repo), controllers.TransactionControllerConfig{Envelope: true}),
+		controllers.NewReportController(services.NewReportService(repo)),
+		controllers.NewTemplateController(services.NewTemplateService(repositories.NewMemoryTemplateRepository(), services.NewTransactionService(repo))),
+		controllers.NewCategoryMetaController(services.NewCategoryMetaService(repositories.NewMemoryCategoryMetaRepository())),
+		controllers.NewSettingsController(map[string]interface{}{}),
+		controllers.NewCurrencyController(),
+	)
+}
+
+func TestSetupRoutes_ServesHealthCheckOnConfiguredPath(t *testing.T) {
+	// Given
+	router := routesRouter(&config.Config{Environment: "production", HealthPath: "/healthz", MaxPageSize: 200})
+
+	// When
+	custom := httptest.NewRecorder()
+	router.ServeHTTP(custom, httptest.NewRequest("GET", "/healthz", nil))
+	fallback := httptest.NewRecorder()
+	router.ServeHTTP(fallback, httptest.NewRequest("GET", "/health", nil))
+
+	// Then
+	assert.Equal(t, http.StatusOK, custom.Code)
+	assert.Contains(t, custom.Body.String(), "healthy")
+	assert.Equal(t, http.StatusNotFound, fallback.Code)
+}
+
+func TestSetupRoutes_FeatureFlagsGuardOptionalRoutes(t *testing.T) {
+	paths := []string{
+		"/api/v2/transactions",
+		"/api/v1/reports/anomalies",
+		"/api/v1/reports/trends/category/food",
+	}
+
+	testCases := []struct {
+		name     string
+		features map[string]bool
+		expected int
+	}{
+		{"flags off", map[string]bool{}, http.StatusNotFound},
+		{"flags on", map[string]bool{config.FeatureAPIV2: true, config.FeatureReportInsights: true}, http.StatusOK},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// Given
+			router := routesRouter(&config.Config{HealthPath: "/health", MaxPageSize: 200, Features: tc.features})
+
+			for _, path := range paths {
+				// When
+				w := httptest.NewRecorder()
+				router.ServeHTTP(w, httptest.NewRequest("GET", path, nil))
+
+				// Then
+				assert.Equal(t, tc.expected, w.Code, path)
+			}
+
+			// Core routes are never flagged
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, httptest.NewRequest("GET", "/api/v1/transactions", nil))
+			assert.Equal(t, http.StatusOK, w.Code)
+		})
+	}
+}
@@ -2,14 +2,54 @@ package config
 
 import (
 	"os"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/joho/godotenv"
 )
 
+// Feature flags for optional endpoints, enabled by listing them in FEATURES
+const (
+	FeatureAPIV2          = "api_v2"          // /api/v2 routes
+	FeatureReportInsights = "report_insights" // Anomaly and trend reports
+)
+
+// defaultFeatures are enabled when FEATURES is not set
+const defaultFeatures = FeatureAPIV2 + "," + FeatureReportInsights
+
 type Config struct {
-	Port           string
-	Environment    string
-	DefaultCurrency string
+	Port                string
+	Environment         string
+	HealthPath          string // Route for the health check, e.g. /healthz for some orchestrators
+	DefaultCurrency     string
+	SupportedCurrencies []string           // Empty means any ISO 4217 code is accepted
+	CategoryAliases     map[string]string  // Alias -> canonical category
+	ReservedCategories  []string           // System categories that cannot be recategorized away
+	OpeningBalances     map[string]float64 // Net worth starting balance, by currency
+	ExchangeRates       map[string]float64 // Value of one unit per currency, e.g. USD=1,ARS=0.001
+	CurrencySymbols     map[string]string  // Display symbol overrides, e.g. ARS=AR$
+	CurrencyDecimals    map[string]int     // Decimal place overrides, e.g. CLP=0
+	TrustedProxies      []string           // IPs/CIDRs allowed to set X-Forwarded-For
+	Features            map[string]bool    // Enabled feature flags, see FeatureEnabled
+	WebhookURL          string             // May embed credentials, never shown in settings
+	WebhookHealthCheck  bool               // Probe WebhookURL from the readiness endpoint
+	WebhookTimeout      time.Duration      // Bound for the readiness webhook probe
+	MaxPageSize         int                // Upper bound for the list endpoint's limit
+	DefaultPageSize     int                // List page size when limit is omitted; 0 returns everything
+	EmptyListStatus     int                // 200 ([]) or 204 (no body) for an empty list
+	DateOutputFormat    string             // rfc3339, epoch_ms or date_only for response dates
+	MaxTransactions     int                // Cap on stored transactions; 0 means unlimited
+	FarDateWarning      time.Duration      // Creates dated further than this from today carry a warning; 0 disables
+	BusinessLogOutput   string             // Separate path for business events; empty shares the request log
+	BusinessLogLevel    string             // Minimum level written to BusinessLogOutput
+	LogEmoji            bool               // Emoji prefixes on log messages
+	LogSlowThreshold    time.Duration      // Performance entries above it are logged as warnings
+	LogSampleEvery      int                // Production logs 1 in N successful requests; errors always
+	RequestTimeout      time.Duration
+	ReadTimeout         time.Duration
+	WriteTimeout        time.Duration
+	IdleTimeout         time.Duration
 }
 
 func Load() *Config {
@@ -17,15 +57,188 @@ func Load() *Config {
 	godotenv.Load()
 
 	return &Config{
-		Port:           getEnvOrDefault("PORT", "8080"),
-		Environment:    getEnvOrDefault("ENVIRONMENT", "development"),
-		DefaultCurrency: getEnvOrDefault("DEFAULT_CURRENCY", "ARS"),
+		Port:                getEnvOrDefault("PORT", "8080"),
+		Environment:         getEnvOrDefault("ENVIRONMENT", "development"),
+		HealthPath:          getEnvOrDefault("HEALTH_PATH", "/health"),
+		DefaultCurrency:     getEnvOrDefault("DEFAULT_CURRENCY", "ARS"),
+		SupportedCurrencies: getListOrDefault("SUPPORTED_CURRENCIES", nil),
+		CategoryAliases:     getMapOrDefault("CATEGORY_ALIASES", map[string]string{}),
+		ReservedCategories:  getListOrDefault("RESERVED_CATEGORIES", nil),
+		OpeningBalances:     getAmountMapOrDefault("OPENING_BALANCES", map[string]float64{}),
+		ExchangeRates:       getAmountMapOrDefault("EXCHANGE_RATES", map[string]float64{}),
+		CurrencySymbols:     getMapOrDefault("CURRENCY_SYMBOLS", map[string]string{}),
+		CurrencyDecimals:    getIntMapOrDefault("CURRENCY_DECIMALS", map[string]int{}),
+		TrustedProxies:      getListOrDefault("TRUSTED_PROXIES", nil),
+		Features:            getFlags("FEATURES", defaultFeatures),
+		WebhookURL:          os.Getenv("WEBHOOK_URL"),
+		WebhookHealthCheck:  getBoolOrDefault("WEBHOOK_HEALTH_CHECK", true),
+		WebhookTimeout:      getDurationOrDefault("WEBHOOK_TIMEOUT", 2*time.Second),
+		MaxPageSize:         getIntOrDefault("MAX_PAGE_SIZE", 200),
+		DefaultPageSize:     getIntOrDefault("DEFAULT_PAGE_SIZE", 0),
+		EmptyListStatus:     getIntOrDefault("EMPTY_LIST_STATUS", 200),
+		DateOutputFormat:    getEnvOrDefault("DATE_OUTPUT_FORMAT", "rfc3339"),
+		MaxTransactions:     getIntOrDefault("MAX_TRANSACTIONS", 0),
+		FarDateWarning:      getDurationOrDefault("FAR_DATE_WARNING", 365*24*time.Hour),
+		BusinessLogOutput:   os.Getenv("BUSINESS_LOG_OUTPUT"),
+		BusinessLogLevel:    getEnvOrDefault("BUSINESS_LOG_LEVEL", "info"),
+		LogEmoji:            getBoolOrDefault("LOG_EMOJI", true),
+		LogSlowThreshold:    getDurationOrDefault("LOG_SLOW_THRESHOLD", 500*time.Millisecond),
+		LogSampleEvery:      getIntOrDefault("LOG_SAMPLE_EVERY", 1),
+		RequestTimeout:      getDurationOrDefault("REQUEST_TIMEOUT", 30*time.Second),
+		ReadTimeout:         getDurationOrDefault("READ_TIMEOUT", 15*time.Second),
+		WriteTimeout:        getDurationOrDefault("WRITE_TIMEOUT", 60*time.Second),
+		IdleTimeout:         getDurationOrDefault("IDLE_TIMEOUT", 120*time.Second),
+	}
+}
+
+// Sanitized returns the effective configuration that is safe to show operators. Fields
+// are listed explicitly so anything added later, secrets included, stays hidden until
+// it is deliberately published here. Trusted proxies are left out as network details.
+func (c *Config) Sanitized() map[string]interface{} {
+	return map[string]interface{}{
+		"port":                 c.Port,
+		"environment":          c.Environment,
+		"health_path":          c.HealthPath,
+		"default_currency":     c.DefaultCurrency,
+		"supported_currencies": c.SupportedCurrencies,
+		"category_aliases":     c.CategoryAliases,
+		"reserved_categories":  c.ReservedCategories,
+		"opening_balances":     c.OpeningBalances,
+		"exchange_rates":       c.ExchangeRates,
+		"currency_symbols":     c.CurrencySymbols,
+		"currency_decimals":    c.CurrencyDecimals,
+		"features":             c.Features,
+		"webhook_health_check": c.WebhookHealthCheck,
+		"webhook_timeout":      c.WebhookTimeout.String(),
+		"max_page_size":        c.MaxPageSize,
+		"default_page_size":    c.DefaultPageSize,
+		"empty_list_status":    c.EmptyListStatus,
+		"date_output_format":   c.DateOutputFormat,
+		"max_transactions":     c.MaxTransactions,
+		"far_date_warning":     c.FarDateWarning.String(),
+		"business_log_output":  c.BusinessLogOutput,
+		"business_log_level":   c.BusinessLogLevel,
+		"log_emoji":            c.LogEmoji,
+		"log_slow_threshold":   c.LogSlowThreshold.String(),
+		"log_sample_every":     c.LogSampleEvery,
+		"request_timeout":      c.RequestTimeout.String(),
+		"read_timeout":         c.ReadTimeout.String(),
+		"write_timeout":        c.WriteTimeout.String(),
+		"idle_timeout":         c.IdleTimeout.String(),
 	}
 }
 
+// FeatureEnabled reports whether the named feature flag is on
+func (c *Config) FeatureEnabled(name string) bool {
+	return c.Features[name]
+}
+
 func getEnvOrDefault(key, defaultValue string) string {
 	if value := os.Getenv(key); value != "" {
 		return value
 	}
 	return defaultValue
-}
\ No newline at end of file
+}
+
+func getIntOrDefault(key string, defaultValue int) int {
+	if value := os.Getenv(key); value != "" {
+		if number, err := strconv.Atoi(value); err == nil {
+			return number
+		}
+	}
+	return defaultValue
+}
+
+func getBoolOrDefault(key string, defaultValue bool) bool {
+	if value := os.Getenv(key); value != "" {
+		if flag, err := strconv.ParseBool(value); err == nil {
+			return flag
+		}
+	}
+	return defaultValue
+}
+
+func getDurationOrDefault(key string, defaultValue time.Duration) time.Duration {
+	if value := os.Getenv(key); value != "" {
+		if duration, err := time.ParseDuration(value); err == nil {
+			return duration
+		}
+	}
+	return defaultValue
+}
+
+func getListOrDefault(key string, defaultValue []string) []string {
+	value := os.Getenv(key)
+	if value == "" {
+		return defaultValue
+	}
+
+	var items []string
+	for _, item := range strings.Split(value, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
+
+// getFlags parses comma-separated flag names into a set. "none" disables every flag.
+func getFlags(key, defaultValue string) map[string]bool {
+	flags := make(map[string]bool)
+	for _, name := range strings.Split(getEnvOrDefault(key, defaultValue), ",") {
+		if name = strings.ToLower(strings.TrimSpace(name)); name != "" && name != "none" {
+			flags[name] = true
+		}
+	}
+	return flags
+}
+
+// getMapOrDefault parses "key=value" pairs separated by commas, skipping malformed pairs
+func getMapOrDefault(key string, defaultValue map[string]string) map[string]string {
+	value := os.Getenv(key)
+	if value == "" {
+		return defaultValue
+	}
+
+	items := make(map[string]string)
+	for _, pair := range strings.Split(value, ",") {
+		k, v, found := strings.Cut(pair, "=")
+		k, v = strings.TrimSpace(k), strings.TrimSpace(v)
+		if found && k != "" && v != "" {
+			items[k] = v
+		}
+	}
+	return items
+}
+
+// getAmountMapOrDefault parses "key=amount" pairs, skipping amounts that are not numbers
+func getAmountMapOrDefault(key string, defaultValue map[string]float64) map[string]float64 {
+	pairs := getMapOrDefault(key, nil)
+	if pairs == nil {
+		return defaultValue
+	}
+
+	amounts := make(map[string]float64)
+	for k, v := range pairs {
+		if amount, err := strconv.ParseFloat(v, 64); err == nil {
+			amounts[k] = amount
+		}
+	}
+	return amounts
+}
+
+// getIntMapOrDefault parses "key=number" pairs, skipping values that are not integers
+func getIntMapOrDefault(key string, defaultValue map[string]int) map[string]int {
+	pairs := getMapOrDefault(key, nil)
+	if pairs == nil {
+		return defaultValue
+	}
+
+	numbers := make(map[string]int)
+	for k, v := range pairs {
+		if number, err := strconv.Atoi(v); err == nil {
+			numbers[k] = number
+		}
+	}
+	return numbers
+}
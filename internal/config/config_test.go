@@ -0,0 +1,72 @@
+package config_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/maximicciullo/personal-finance-api/internal/config"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSanitized_ExposesKnownFieldsOnly(t *testing.T) {
+	// Given
+	cfg := &config.Config{
+		Port:             "9090",
+		Environment:      "production",
+		DefaultCurrency:  "USD",
+		TrustedProxies:   []string{"10.0.0.1"},
+		MaxPageSize:      50,
+		MaxTransactions:  1000,
+		DefaultPageSize:  25,
+		BusinessLogLevel: "warn",
+		RequestTimeout:   5 * time.Second,
+		WebhookURL:       "https://hooks.example.com/secret-token",
+	}
+
+	// When
+	settings := cfg.Sanitized()
+
+	// Then
+	assert.Equal(t, "9090", settings["port"])
+	assert.Equal(t, "production", settings["environment"])
+	assert.Equal(t, "USD", settings["default_currency"])
+	assert.Equal(t, 50, settings["max_page_size"])
+	assert.Equal(t, 1000, settings["max_transactions"])
+	assert.Equal(t, 25, settings["default_page_size"])
+	assert.Equal(t, "warn", settings["business_log_level"])
+	assert.Equal(t, "5s", settings["request_timeout"])
+	assert.NotContains(t, settings, "trusted_proxies")
+	assert.NotContains(t, settings, "webhook_url")
+	for key, value := range settings {
+		assert.NotEqual(t, "https://hooks.example.com/secret-token", value, "setting %s leaks the webhook URL", key)
+	}
+	for key, value := range settings {
+		assert.NotEqual(t, []string{"10.0.0.1"}, value, "setting %s leaks trusted proxies", key)
+	}
+}
+
+func TestLoad_Features(t *testing.T) {
+	testCases := []struct {
+		name     string
+		value    string
+		expected map[string]bool
+	}{
+		{"default", "", map[string]bool{config.FeatureAPIV2: true, config.FeatureReportInsights: true}},
+		{"listed flags only", " API_V2 , custom", map[string]bool{config.FeatureAPIV2: true, "custom": true}},
+		{"none", "none", map[string]bool{}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// Given
+			t.Setenv("FEATURES", tc.value)
+
+			// When
+			cfg := config.Load()
+
+			// Then
+			assert.Equal(t, tc.expected, cfg.Features)
+			assert.Equal(t, tc.expected[config.FeatureAPIV2], cfg.FeatureEnabled(config.FeatureAPIV2))
+		})
+	}
+}
@@ -0,0 +1,140 @@
+package controllers
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/maximicciullo/personal-finance-api/internal/middleware"
+	"github.com/maximicciullo/personal-finance-api/internal/models"
+	"github.com/maximicciullo/personal-finance-api/internal/services"
+	"go.uber.org/zap"
+)
+
+type CategoryMetaController struct {
+	service services.CategoryMetaService
+	logger  *middleware.BusinessLoggerInstance
+}
+
+func NewCategoryMetaController(service services.CategoryMetaService) *CategoryMetaController {
+	return &CategoryMetaController{
+		service: service,
+		logger:  middleware.BusinessLogger(),
+	}
+}
+
+func (c *CategoryMetaController) GetAllCategoryMeta(ctx *gin.Context) {
+	c.logger.Controller("GetAllCategoryMeta started")
+
+	all, err := c.service.GetAllCategoryMeta(ctx.Request.Context())
+	if err != nil {
+		c.logger.Error("controller", "GetAllCategoryMeta - service error", err)
+
+		respondJSON(ctx, http.StatusInternalServerError, gin.H{
+			"error":   "Internal Server Error",
+			"message": "Failed to retrieve category metadata",
+			"status":  http.StatusInternalServerError,
+		})
+		return
+	}
+
+	c.logger.Controller("GetAllCategoryMeta completed successfully",
+		zap.Int("category_count", len(all)),
+	)
+
+	respondJSON(ctx, http.StatusOK, all)
+}
+
+func (c *CategoryMetaController) GetCategoryMeta(ctx *gin.Context) {
+	category := ctx.Param("category")
+	c.logger.Controller("GetCategoryMeta started",
+		zap.String("category", category),
+	)
+
+	meta, err := c.service.GetCategoryMeta(ctx.Request.Context(), category)
+	if err != nil {
+		c.respondServiceError(ctx, "GetCategoryMeta", category, err)
+		return
+	}
+
+	c.logger.Controller("GetCategoryMeta completed successfully",
+		zap.String("category", category),
+	)
+
+	respondJSON(ctx, http.StatusOK, meta)
+}
+
+// SetCategoryMeta creates or replaces the metadata of the category in the path
+func (c *CategoryMetaController) SetCategoryMeta(ctx *gin.Context) {
+	category := ctx.Param("category")
+	c.logger.Controller("SetCategoryMeta started",
+		zap.String("category", category),
+		zap.String("client_ip", ctx.ClientIP()),
+	)
+
+	var req models.CategoryMetaRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		c.logger.Error("controller", "SetCategoryMeta - JSON binding failed", err)
+
+		respondJSON(ctx, http.StatusBadRequest, gin.H{
+			"error":   "Bad Request",
+			"message": err.Error(),
+			"status":  http.StatusBadRequest,
+		})
+		return
+	}
+
+	meta, err := c.service.SetCategoryMeta(ctx.Request.Context(), category, &req)
+	if err != nil {
+		c.respondServiceError(ctx, "SetCategoryMeta", category, err)
+		return
+	}
+
+	c.logger.Controller("SetCategoryMeta completed successfully",
+		zap.String("category", meta.Category),
+	)
+
+	respondJSON(ctx, http.StatusOK, meta)
+}
+
+func (c *CategoryMetaController) DeleteCategoryMeta(ctx *gin.Context) {
+	category := ctx.Param("category")
+	c.logger.Controller("DeleteCategoryMeta started",
+		zap.String("category", category),
+	)
+
+	if err := c.service.DeleteCategoryMeta(ctx.Request.Context(), category); err != nil {
+		c.respondServiceError(ctx, "DeleteCategoryMeta", category, err)
+		return
+	}
+
+	c.logger.Controller("DeleteCategoryMeta completed successfully",
+		zap.String("category", category),
+	)
+
+	respondJSON(ctx, http.StatusOK, gin.H{
+		"message": "Category metadata deleted successfully",
+	})
+}
+
+// respondServiceError maps missing metadata to 404 and anything else to 400
+func (c *CategoryMetaController) respondServiceError(ctx *gin.Context, operation, category string, err error) {
+	c.logger.Error("controller", operation+" - service error", err,
+		zap.String("category", category),
+	)
+
+	if errors.Is(err, models.ErrCategoryMetaNotFound) {
+		respondJSON(ctx, http.StatusNotFound, gin.H{
+			"error":   "Not Found",
+			"message": "Category metadata not found",
+			"status":  http.StatusNotFound,
+		})
+		return
+	}
+
+	respondJSON(ctx, http.StatusBadRequest, gin.H{
+		"error":   "Bad Request",
+		"message": err.Error(),
+		"status":  http.StatusBadRequest,
+	})
+}
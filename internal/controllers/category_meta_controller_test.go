@@ -0,0 +1,98 @@
+package controllers_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/maximicciullo/personal-finance-api/internal/models"
+	"github.com/maximicciullo/personal-finance-api/internal/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+type CategoryMetaControllerTestSuite struct {
+	suite.Suite
+	server *test.TestServer
+}
+
+func (suite *CategoryMetaControllerTestSuite) SetupTest() {
+	suite.server = test.NewTestServer()
+}
+
+func (suite *CategoryMetaControllerTestSuite) TestCategoryMetaCRUD() {
+	// Given
+	path := "/api/v1/category-meta/food"
+
+	// When / Then - set
+	put := suite.server.MakeRequest("PUT", path, models.CategoryMetaRequest{Color: "#FF8800", Icon: "utensils"})
+	assert.Equal(suite.T(), http.StatusOK, put.Code)
+	var meta models.CategoryMeta
+	assert.NoError(suite.T(), json.Unmarshal(put.Body.Bytes(), &meta))
+	assert.Equal(suite.T(), "food", meta.Category)
+	assert.Equal(suite.T(), "#FF8800", meta.Color)
+
+	// When / Then - read
+	get := suite.server.MakeRequest("GET", path, nil)
+	assert.Equal(suite.T(), http.StatusOK, get.Code)
+
+	list := suite.server.MakeRequest("GET", "/api/v1/category-meta", nil)
+	var all []models.CategoryMeta
+	assert.NoError(suite.T(), json.Unmarshal(list.Body.Bytes(), &all))
+	assert.Len(suite.T(), all, 1)
+
+	// When / Then - replace
+	replace := suite.server.MakeRequest("PUT", path, models.CategoryMetaRequest{Icon: "pizza"})
+	assert.Equal(suite.T(), http.StatusOK, replace.Code)
+	var replaced models.CategoryMeta
+	assert.NoError(suite.T(), json.Unmarshal(replace.Body.Bytes(), &replaced))
+	assert.Empty(suite.T(), replaced.Color)
+	assert.Equal(suite.T(), "pizza", replaced.Icon)
+
+	// When / Then - delete
+	del := suite.server.MakeRequest("DELETE", path, nil)
+	assert.Equal(suite.T(), http.StatusOK, del.Code)
+	assert.Equal(suite.T(), http.StatusNotFound, suite.server.MakeRequest("GET", path, nil).Code)
+	assert.Equal(suite.T(), http.StatusNotFound, suite.server.MakeRequest("DELETE", path, nil).Code)
+}
+
+func (suite *CategoryMetaControllerTestSuite) TestSetCategoryMeta_ValidationErrors() {
+	cases := map[string]models.CategoryMetaRequest{
+		"empty":         {},
+		"invalid color": {Color: "orange"},
+	}
+
+	for name, req := range cases {
+		suite.Run(name, func() {
+			// When
+			w := suite.server.MakeRequest("PUT", "/api/v1/category-meta/food", req)
+
+			// Then
+			assert.Equal(suite.T(), http.StatusBadRequest, w.Code)
+		})
+	}
+}
+
+func (suite *CategoryMetaControllerTestSuite) TestMonthlyReport_IncludesCategoryMeta() {
+	// Given
+	suite.Require().Equal(http.StatusOK, suite.server.MakeRequest("PUT", "/api/v1/category-meta/food", models.CategoryMetaRequest{Color: "#ff8800", Icon: "utensils"}).Code)
+	created := suite.server.MakeRequest("POST", "/api/v1/transactions", models.CreateTransactionRequest{
+		Type: "expense", Amount: 1500, Currency: "ARS", Description: "Lunch", Category: "food", Date: stringPtr("2024-06-10"),
+	})
+	suite.Require().Equal(http.StatusCreated, created.Code)
+
+	// When
+	w := suite.server.MakeRequest("GET", "/api/v1/reports/monthly/2024/6", nil)
+
+	// Then
+	assert.Equal(suite.T(), http.StatusOK, w.Code)
+	var report models.MonthlyReport
+	assert.NoError(suite.T(), json.Unmarshal(w.Body.Bytes(), &report))
+	food := report.Summary.CategoryBreakdown["food"]
+	assert.Equal(suite.T(), "#ff8800", food.Color)
+	assert.Equal(suite.T(), "utensils", food.Icon)
+}
+
+func TestCategoryMetaControllerTestSuite(t *testing.T) {
+	suite.Run(t, new(CategoryMetaControllerTestSuite))
+}
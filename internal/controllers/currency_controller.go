@@ -0,0 +1,35 @@
+package controllers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/maximicciullo/personal-finance-api/internal/middleware"
+	"github.com/maximicciullo/personal-finance-api/internal/models"
+	"go.uber.org/zap"
+)
+
+// CurrencyController exposes display metadata for currencies
+type CurrencyController struct {
+	logger *middleware.BusinessLoggerInstance
+}
+
+func NewCurrencyController() *CurrencyController {
+	return &CurrencyController{
+		logger: middleware.BusinessLogger(),
+	}
+}
+
+// GetCurrencyMeta returns the symbol and decimal places per currency code
+func (c *CurrencyController) GetCurrencyMeta(ctx *gin.Context) {
+	c.logger.Controller("GetCurrencyMeta started",
+		zap.String("client_ip", ctx.ClientIP()),
+	)
+
+	table := models.CurrencyMetaTable()
+	respondJSON(ctx, http.StatusOK, table)
+
+	c.logger.Controller("GetCurrencyMeta completed successfully",
+		zap.Int("currency_count", len(table)),
+	)
+}
@@ -0,0 +1,35 @@
+package controllers_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/maximicciullo/personal-finance-api/internal/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+type CurrencyControllerTestSuite struct {
+	suite.Suite
+	server *test.TestServer
+}
+
+func (suite *CurrencyControllerTestSuite) SetupTest() {
+	suite.server = test.NewTestServer()
+}
+
+func (suite *CurrencyControllerTestSuite) TestGetCurrencyMeta() {
+	// When
+	w := suite.server.MakeRequest("GET", "/api/v1/currencies/meta", nil)
+
+	// Then
+	assert.Equal(suite.T(), http.StatusOK, w.Code)
+
+	response := test.GetResponseJSON(suite.T(), w)
+	assert.Equal(suite.T(), map[string]interface{}{"symbol": "¥", "decimals": float64(0)}, response["JPY"])
+	assert.Equal(suite.T(), map[string]interface{}{"symbol": "$", "decimals": float64(2)}, response["USD"])
+}
+
+func TestCurrencyControllerTestSuite(t *testing.T) {
+	suite.Run(t, new(CurrencyControllerTestSuite))
+}
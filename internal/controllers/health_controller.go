@@ -1,6 +1,7 @@
 package controllers
 
 import (
+	"context"
 	"net/http"
 	"time"
 
@@ -9,12 +10,40 @@ import (
 	"go.uber.org/zap"
 )
 
+// HealthControllerConfig holds the readiness probe configuration
+type HealthControllerConfig struct {
+	WebhookURL     string        // Webhook target; empty means webhooks are not configured
+	CheckWebhook   bool          // Probe WebhookURL on readiness checks
+	WebhookTimeout time.Duration // Bound for the webhook probe
+}
+
+// DefaultHealthControllerConfig returns a default health controller configuration
+func DefaultHealthControllerConfig() HealthControllerConfig {
+	return HealthControllerConfig{
+		CheckWebhook:   true,
+		WebhookTimeout: 2 * time.Second,
+	}
+}
+
 type HealthController struct {
+	config HealthControllerConfig
+	client *http.Client
 	logger *middleware.BusinessLoggerInstance
 }
 
 func NewHealthController() *HealthController {
+	return NewHealthControllerWithConfig(DefaultHealthControllerConfig())
+}
+
+// NewHealthControllerWithConfig creates a health controller with custom configuration
+func NewHealthControllerWithConfig(config HealthControllerConfig) *HealthController {
+	if config.WebhookTimeout <= 0 {
+		config.WebhookTimeout = DefaultHealthControllerConfig().WebhookTimeout
+	}
+
 	return &HealthController{
+		config: config,
+		client: &http.Client{Timeout: config.WebhookTimeout},
 		logger: middleware.BusinessLogger(),
 	}
 }
@@ -44,4 +73,68 @@ func (c *HealthController) HealthCheck(ctx *gin.Context) {
 	)
 
 	ctx.JSON(http.StatusOK, response)
-}
\ No newline at end of file
+}
+// ReadinessCheck reports whether the service is ready for traffic. An unreachable
+// webhook target marks it degraded but never fails the probe, since transactions
+// can still be recorded without it.
+func (c *HealthController) ReadinessCheck(ctx *gin.Context) {
+	c.logger.Controller("ReadinessCheck started",
+		zap.String("client_ip", ctx.ClientIP()),
+	)
+
+	start := time.Now()
+	webhook := c.checkWebhook(ctx.Request.Context())
+
+	status := "ready"
+	if webhook["status"] == "down" {
+		status = "degraded"
+	}
+
+	response := gin.H{
+		"status":    status,
+		"timestamp": time.Now().Format(time.RFC3339),
+		"checks": gin.H{
+			"webhook": webhook,
+		},
+	}
+
+	duration := time.Since(start)
+	c.logger.Controller("ReadinessCheck completed successfully",
+		zap.String("status", status),
+		zap.Duration("duration", duration),
+	)
+
+	ctx.JSON(http.StatusOK, response)
+}
+
+// checkWebhook sends a HEAD request to the webhook target. Any response below 500
+// counts as reachable, as many targets reject HEAD with 405.
+func (c *HealthController) checkWebhook(ctx context.Context) gin.H {
+	if c.config.WebhookURL == "" || !c.config.CheckWebhook {
+		return gin.H{"status": "disabled"}
+	}
+
+	start := time.Now()
+	req, err := http.NewRequestWithContext(ctx, http.MethodHead, c.config.WebhookURL, nil)
+	if err != nil {
+		c.logger.Error("controller", "ReadinessCheck - invalid webhook URL", err)
+		return gin.H{"status": "down", "error": "invalid webhook URL"}
+	}
+
+	resp, err := c.client.Do(req)
+	duration := time.Since(start)
+	c.logger.Performance("ReadinessCheck webhook probe", duration,
+		zap.Bool("success", err == nil),
+	)
+
+	if err != nil {
+		c.logger.Error("controller", "ReadinessCheck - webhook unreachable", err)
+		return gin.H{"status": "down", "error": "webhook target unreachable"}
+	}
+	resp.Body.Close()
+
+	if resp.StatusCode >= http.StatusInternalServerError {
+		return gin.H{"status": "down", "status_code": resp.StatusCode, "latency_ms": duration.Milliseconds()}
+	}
+	return gin.H{"status": "up", "status_code": resp.StatusCode, "latency_ms": duration.Milliseconds()}
+}
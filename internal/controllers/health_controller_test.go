@@ -1,9 +1,13 @@
 package controllers_test
 
 import (
+	"encoding/json"
 	"net/http"
+	"net/http/httptest"
 	"testing"
 
+	"github.com/gin-gonic/gin"
+	"github.com/maximicciullo/personal-finance-api/internal/controllers"
 	"github.com/maximicciullo/personal-finance-api/internal/test"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/suite"
@@ -64,6 +68,69 @@ func (suite *HealthControllerTestSuite) TestHealthCheck_MultipleRequests() {
 	}
 }
 
+func (suite *HealthControllerTestSuite) TestReadinessCheck_WebhookNotConfigured() {
+	// When
+	w := suite.server.MakeRequest("GET", "/health/ready", nil)
+
+	// Then
+	assert.Equal(suite.T(), http.StatusOK, w.Code)
+	test.AssertJSONContains(suite.T(), w, map[string]interface{}{
+		"status": "ready",
+		"checks": map[string]interface{}{
+			"webhook": map[string]interface{}{"status": "disabled"},
+		},
+	})
+}
+
+// readinessRequest serves /health/ready from a controller with the given config
+func readinessRequest(config controllers.HealthControllerConfig) (int, map[string]interface{}) {
+	router := gin.New()
+	router.GET("/health/ready", controllers.NewHealthControllerWithConfig(config).ReadinessCheck)
+
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, httptest.NewRequest("GET", "/health/ready", nil))
+
+	var response map[string]interface{}
+	json.Unmarshal(w.Body.Bytes(), &response)
+	return w.Code, response
+}
+
+func (suite *HealthControllerTestSuite) TestReadinessCheck_WebhookTarget() {
+	up := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(suite.T(), http.MethodHead, r.Method)
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer up.Close()
+
+	down := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	downURL := down.URL
+	down.Close()
+
+	testCases := []struct {
+		name           string
+		config         controllers.HealthControllerConfig
+		expectedStatus string
+		webhookStatus  string
+	}{
+		{"reachable", controllers.HealthControllerConfig{WebhookURL: up.URL, CheckWebhook: true}, "ready", "up"},
+		{"unreachable", controllers.HealthControllerConfig{WebhookURL: downURL, CheckWebhook: true}, "degraded", "down"},
+		{"check turned off", controllers.HealthControllerConfig{WebhookURL: downURL}, "ready", "disabled"},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			// When
+			code, response := readinessRequest(tc.config)
+
+			// Then
+			assert.Equal(suite.T(), http.StatusOK, code)
+			assert.Equal(suite.T(), tc.expectedStatus, response["status"])
+			webhook := response["checks"].(map[string]interface{})["webhook"].(map[string]interface{})
+			assert.Equal(suite.T(), tc.webhookStatus, webhook["status"])
+		})
+	}
+}
+
 func TestHealthControllerTestSuite(t *testing.T) {
 	suite.Run(t, new(HealthControllerTestSuite))
 }
\ No newline at end of file
@@ -1,24 +1,53 @@
 package controllers
 
 import (
+	"bytes"
+	"errors"
+	"fmt"
 	"net/http"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/gin-gonic/gin"
 	"github.com/maximicciullo/personal-finance-api/internal/middleware"
+	"github.com/maximicciullo/personal-finance-api/internal/models"
 	"github.com/maximicciullo/personal-finance-api/internal/services"
+	"github.com/maximicciullo/personal-finance-api/internal/utils"
 	"go.uber.org/zap"
 )
 
+// ReportControllerConfig holds HTTP-level settings for the report endpoints
+type ReportControllerConfig struct {
+	PastMonthMaxAge time.Duration  // Cache lifetime advertised for reports on fully-past months
+	Clock           services.Clock // Decides which months are past; nil means RealClock
+}
+
+// DefaultReportControllerConfig returns a default report controller configuration
+func DefaultReportControllerConfig() ReportControllerConfig {
+	return ReportControllerConfig{
+		PastMonthMaxAge: 24 * time.Hour,
+		Clock:           services.RealClock(),
+	}
+}
+
 type ReportController struct {
 	service services.ReportService
+	config  ReportControllerConfig
 	logger  *middleware.BusinessLoggerInstance
 }
 
 func NewReportController(service services.ReportService) *ReportController {
+	return NewReportControllerWithConfig(service, DefaultReportControllerConfig())
+}
+
+func NewReportControllerWithConfig(service services.ReportService, config ReportControllerConfig) *ReportController {
+	if config.Clock == nil {
+		config.Clock = services.RealClock()
+	}
 	return &ReportController{
 		service: service,
+		config:  config,
 		logger:  middleware.BusinessLogger(),
 	}
 }
@@ -39,7 +68,7 @@ func (c *ReportController) GetMonthlyReport(ctx *gin.Context) {
 			zap.String("year_param", yearParam),
 		)
 
-		ctx.JSON(http.StatusBadRequest, gin.H{
+		respondJSON(ctx, http.StatusBadRequest, gin.H{
 			"error":   "Bad Request",
 			"message": "Invalid year format",
 			"status":  http.StatusBadRequest,
@@ -53,7 +82,7 @@ func (c *ReportController) GetMonthlyReport(ctx *gin.Context) {
 			zap.String("month_param", monthParam),
 		)
 
-		ctx.JSON(http.StatusBadRequest, gin.H{
+		respondJSON(ctx, http.StatusBadRequest, gin.H{
 			"error":   "Bad Request",
 			"message": "Invalid month format",
 			"status":  http.StatusBadRequest,
@@ -61,13 +90,45 @@ func (c *ReportController) GetMonthlyReport(ctx *gin.Context) {
 		return
 	}
 
+	filters := models.TransactionFilters{
+		Type:              ctx.Query("type"),
+		Category:          ctx.Query("category"),
+		Currency:          ctx.Query("currency"),
+		ExcludeCategories: ctx.QueryArray("exclude_category"),
+		IncludePending:    includePending(ctx),
+	}
+	filters.HideZero, _ = strconv.ParseBool(ctx.Query("hide_zero"))
+
+	location, ok := c.reportLocation(ctx)
+	if !ok {
+		return
+	}
+	filters.Location = location
+
+	format := strings.ToLower(ctx.DefaultQuery("format", "json"))
+	if format != "json" && format != "csv" {
+		c.logger.Error("controller", "GetMonthlyReport - unsupported format", errors.New("unsupported report format"),
+			zap.String("format", format),
+		)
+
+		respondJSON(ctx, http.StatusBadRequest, gin.H{
+			"error":   "Bad Request",
+			"message": "format must be json or csv",
+			"status":  http.StatusBadRequest,
+		})
+		return
+	}
+
 	c.logger.Controller("GetMonthlyReport - parameters validated",
 		zap.Int("year", year),
 		zap.Int("month", month),
+		zap.String("type_filter", filters.Type),
+		zap.String("category_filter", filters.Category),
+		zap.String("currency_filter", filters.Currency),
 	)
 
 	start := time.Now()
-	report, err := c.service.GetMonthlyReport(year, month)
+	report, err := c.service.GetFilteredMonthlyReport(ctx.Request.Context(), year, month, filters)
 	duration := time.Since(start)
 
 	c.logger.Performance("GetMonthlyReport service call", duration,
@@ -82,7 +143,7 @@ func (c *ReportController) GetMonthlyReport(ctx *gin.Context) {
 			zap.Int("month", month),
 		)
 
-		ctx.JSON(http.StatusBadRequest, gin.H{
+		respondJSON(ctx, http.StatusBadRequest, gin.H{
 			"error":   "Bad Request",
 			"message": err.Error(),
 			"status":  http.StatusBadRequest,
@@ -90,14 +151,65 @@ func (c *ReportController) GetMonthlyReport(ctx *gin.Context) {
 		return
 	}
 
+	if format == "csv" {
+		c.respondMonthlyReportCSV(ctx, report, year, month, location, duration)
+		return
+	}
+
+	if !includeTransactions(ctx) {
+		// Keep aggregates but drop the heavy list; empty rather than null per the report contract
+		report.Transactions = []models.Transaction{}
+	}
+
+	c.logger.Controller("GetMonthlyReport completed successfully",
+		zap.Int("year", year),
+		zap.Int("month", month),
+		zap.Int("transaction_count", report.Summary.TransactionCount),
+		zap.Duration("total_duration", duration),
+	)
+
+	c.setMonthCacheControl(ctx, year, month, location)
+	respondJSON(ctx, http.StatusOK, report)
+}
+
+// respondMonthlyReportCSV sends the report's transactions and totals as a CSV attachment
+// named after the month, e.g. finance-2024-06.csv
+func (c *ReportController) respondMonthlyReportCSV(ctx *gin.Context, report *models.MonthlyReport, year, month int, location *time.Location, duration time.Duration) {
+	var buf bytes.Buffer
+	if err := utils.WriteMonthlyReportCSV(&buf, report); err != nil {
+		c.logger.Error("controller", "GetMonthlyReport - CSV encoding error", err)
+
+		respondJSON(ctx, http.StatusInternalServerError, gin.H{
+			"error":   "Internal Server Error",
+			"message": "Failed to encode report as CSV",
+			"status":  http.StatusInternalServerError,
+		})
+		return
+	}
+
 	c.logger.Controller("GetMonthlyReport completed successfully",
 		zap.Int("year", year),
 		zap.Int("month", month),
 		zap.Int("transaction_count", report.Summary.TransactionCount),
+		zap.String("format", "csv"),
 		zap.Duration("total_duration", duration),
 	)
 
-	ctx.JSON(http.StatusOK, report)
+	c.setMonthCacheControl(ctx, year, month, location)
+	ctx.Header("Content-Disposition", fmt.Sprintf(`attachment; filename="finance-%04d-%02d.csv"`, year, month))
+	ctx.Data(http.StatusOK, utils.CSVContentType, buf.Bytes())
+}
+
+// setMonthCacheControl lets clients cache reports on months that have fully ended;
+// the current and future months can still change, so they must be revalidated.
+// Months end in the report's location, like the report date ranges.
+func (c *ReportController) setMonthCacheControl(ctx *gin.Context, year, month int, location *time.Location) {
+	monthEnd := time.Date(year, time.Month(month)+1, 1, 0, 0, 0, 0, location)
+	if c.config.PastMonthMaxAge > 0 && !c.config.Clock.Now().Before(monthEnd) {
+		ctx.Header("Cache-Control", "public, max-age="+strconv.Itoa(int(c.config.PastMonthMaxAge.Seconds())))
+		return
+	}
+	ctx.Header("Cache-Control", "no-cache")
 }
 
 func (c *ReportController) GetCurrentMonthReport(ctx *gin.Context) {
@@ -108,8 +220,14 @@ func (c *ReportController) GetCurrentMonthReport(ctx *gin.Context) {
 		zap.String("client_ip", ctx.ClientIP()),
 	)
 
+	location, ok := c.reportLocation(ctx)
+	if !ok {
+		return
+	}
+
 	start := time.Now()
-	report, err := c.service.GetCurrentMonthReport()
+	includeProjected, _ := strconv.ParseBool(ctx.Query("include_projected"))
+	report, err := c.service.GetCurrentMonthReport(ctx.Request.Context(), includePending(ctx), includeProjected, location)
 	duration := time.Since(start)
 
 	c.logger.Performance("GetCurrentMonthReport service call", duration,
@@ -119,7 +237,7 @@ func (c *ReportController) GetCurrentMonthReport(ctx *gin.Context) {
 	if err != nil {
 		c.logger.Error("controller", "GetCurrentMonthReport - service error", err)
 
-		ctx.JSON(http.StatusInternalServerError, gin.H{
+		respondJSON(ctx, http.StatusInternalServerError, gin.H{
 			"error":   "Internal Server Error",
 			"message": "Failed to generate current month report",
 			"status":  http.StatusInternalServerError,
@@ -127,6 +245,11 @@ func (c *ReportController) GetCurrentMonthReport(ctx *gin.Context) {
 		return
 	}
 
+	if !includeTransactions(ctx) {
+		// Keep aggregates but drop the heavy list; empty rather than null per the report contract
+		report.Transactions = []models.Transaction{}
+	}
+
 	c.logger.Controller("GetCurrentMonthReport completed successfully",
 		zap.String("month", report.Month),
 		zap.Int("year", report.Year),
@@ -134,5 +257,828 @@ func (c *ReportController) GetCurrentMonthReport(ctx *gin.Context) {
 		zap.Duration("total_duration", duration),
 	)
 
-	ctx.JSON(http.StatusOK, report)
-}
\ No newline at end of file
+	// The current month keeps changing
+	ctx.Header("Cache-Control", "no-cache")
+	respondJSON(ctx, http.StatusOK, report)
+}
+
+// GetGroupedReport totals transactions by ?field= (category, currency or type),
+// optionally limited to ?from= and ?to=
+func (c *ReportController) GetGroupedReport(ctx *gin.Context) {
+	field := ctx.Query("field")
+
+	c.logger.Controller("GetGroupedReport started",
+		zap.String("field", field),
+		zap.String("query_params", ctx.Request.URL.RawQuery),
+		zap.String("client_ip", ctx.ClientIP()),
+	)
+
+	fromDate, err := parseOptionalDate(ctx.Query("from"))
+	if err != nil {
+		c.logger.Error("controller", "GetGroupedReport - invalid from date", err,
+			zap.String("from", ctx.Query("from")),
+		)
+
+		respondJSON(ctx, http.StatusBadRequest, gin.H{
+			"error":   "Bad Request",
+			"message": "Invalid from date format, use YYYY-MM-DD",
+			"status":  http.StatusBadRequest,
+		})
+		return
+	}
+
+	toDate, err := parseOptionalDate(ctx.Query("to"))
+	if err != nil {
+		c.logger.Error("controller", "GetGroupedReport - invalid to date", err,
+			zap.String("to", ctx.Query("to")),
+		)
+
+		respondJSON(ctx, http.StatusBadRequest, gin.H{
+			"error":   "Bad Request",
+			"message": "Invalid to date format, use YYYY-MM-DD",
+			"status":  http.StatusBadRequest,
+		})
+		return
+	}
+
+	// to is inclusive, so it covers the whole day
+	if toDate != nil {
+		end := endOfDay(*toDate)
+		toDate = &end
+	}
+
+	start := time.Now()
+	report, err := c.service.GetGroupedReport(ctx.Request.Context(), field, fromDate, toDate, includePending(ctx))
+	duration := time.Since(start)
+
+	c.logger.Performance("GetGroupedReport service call", duration,
+		zap.String("field", field),
+		zap.Bool("success", err == nil),
+	)
+
+	if err != nil {
+		c.logger.Error("controller", "GetGroupedReport - service error", err,
+			zap.String("field", field),
+		)
+
+		if errors.Is(err, models.ErrInvalidGroupField) {
+			respondJSON(ctx, http.StatusBadRequest, gin.H{
+				"error":   "Bad Request",
+				"message": err.Error(),
+				"status":  http.StatusBadRequest,
+			})
+			return
+		}
+
+		respondJSON(ctx, http.StatusInternalServerError, gin.H{
+			"error":   "Internal Server Error",
+			"message": "Failed to generate grouped report",
+			"status":  http.StatusInternalServerError,
+		})
+		return
+	}
+
+	c.logger.Controller("GetGroupedReport completed successfully",
+		zap.String("field", field),
+		zap.Int("groups_count", len(report.Groups)),
+		zap.Duration("total_duration", duration),
+	)
+
+	respondJSON(ctx, http.StatusOK, report)
+}
+
+// GetAnomalyReport flags mixed-currency categories and outlier amounts, optionally
+// limited to ?from= and ?to=
+func (c *ReportController) GetAnomalyReport(ctx *gin.Context) {
+	c.logger.Controller("GetAnomalyReport started",
+		zap.String("query_params", ctx.Request.URL.RawQuery),
+		zap.String("client_ip", ctx.ClientIP()),
+	)
+
+	fromDate, err := parseOptionalDate(ctx.Query("from"))
+	if err != nil {
+		c.logger.Error("controller", "GetAnomalyReport - invalid from date", err,
+			zap.String("from", ctx.Query("from")),
+		)
+
+		respondJSON(ctx, http.StatusBadRequest, gin.H{
+			"error":   "Bad Request",
+			"message": "Invalid from date format, use YYYY-MM-DD",
+			"status":  http.StatusBadRequest,
+		})
+		return
+	}
+
+	toDate, err := parseOptionalDate(ctx.Query("to"))
+	if err != nil {
+		c.logger.Error("controller", "GetAnomalyReport - invalid to date", err,
+			zap.String("to", ctx.Query("to")),
+		)
+
+		respondJSON(ctx, http.StatusBadRequest, gin.H{
+			"error":   "Bad Request",
+			"message": "Invalid to date format, use YYYY-MM-DD",
+			"status":  http.StatusBadRequest,
+		})
+		return
+	}
+
+	start := time.Now()
+	report, err := c.service.GetAnomalyReport(ctx.Request.Context(), fromDate, toDate, includePending(ctx))
+	duration := time.Since(start)
+
+	c.logger.Performance("GetAnomalyReport service call", duration,
+		zap.Bool("success", err == nil),
+	)
+
+	if err != nil {
+		c.logger.Error("controller", "GetAnomalyReport - service error", err)
+
+		respondJSON(ctx, http.StatusInternalServerError, gin.H{
+			"error":   "Internal Server Error",
+			"message": "Failed to generate anomaly report",
+			"status":  http.StatusInternalServerError,
+		})
+		return
+	}
+
+	c.logger.Controller("GetAnomalyReport completed successfully",
+		zap.Int("mixed_currency_categories", len(report.MixedCurrencyCategories)),
+		zap.Int("outliers", len(report.Outliers)),
+		zap.Duration("total_duration", duration),
+	)
+
+	respondJSON(ctx, http.StatusOK, report)
+}
+
+// GetCategoryTrend returns a category's expense per currency for each of the last
+// ?months= months (default 6), ending with the current month
+func (c *ReportController) GetCategoryTrend(ctx *gin.Context) {
+	category := ctx.Param("category")
+
+	c.logger.Controller("GetCategoryTrend started",
+		zap.String("category", category),
+		zap.String("query_params", ctx.Request.URL.RawQuery),
+		zap.String("client_ip", ctx.ClientIP()),
+	)
+
+	months := 6
+	if value := ctx.Query("months"); value != "" {
+		parsed, err := strconv.Atoi(value)
+		if err != nil {
+			c.logger.Error("controller", "GetCategoryTrend - invalid months", err,
+				zap.String("months", value),
+			)
+
+			respondJSON(ctx, http.StatusBadRequest, gin.H{
+				"error":   "Bad Request",
+				"message": "months must be a number",
+				"status":  http.StatusBadRequest,
+			})
+			return
+		}
+		months = parsed
+	}
+
+	start := time.Now()
+	report, err := c.service.GetCategoryTrend(ctx.Request.Context(), category, months, includePending(ctx))
+	duration := time.Since(start)
+
+	c.logger.Performance("GetCategoryTrend service call", duration,
+		zap.String("category", category),
+		zap.Bool("success", err == nil),
+	)
+
+	if err != nil {
+		c.logger.Error("controller", "GetCategoryTrend - service error", err,
+			zap.String("category", category),
+		)
+
+		respondJSON(ctx, http.StatusBadRequest, gin.H{
+			"error":   "Bad Request",
+			"message": err.Error(),
+			"status":  http.StatusBadRequest,
+		})
+		return
+	}
+
+	c.logger.Controller("GetCategoryTrend completed successfully",
+		zap.String("category", category),
+		zap.Int("months", report.Months),
+		zap.Duration("total_duration", duration),
+	)
+
+	respondJSON(ctx, http.StatusOK, report)
+}
+
+// GetSavingsRate returns (income - expense) / income per currency for a month
+func (c *ReportController) GetSavingsRate(ctx *gin.Context) {
+	yearParam := ctx.Param("year")
+	monthParam := ctx.Param("month")
+
+	c.logger.Controller("GetSavingsRate started",
+		zap.String("year_param", yearParam),
+		zap.String("month_param", monthParam),
+		zap.String("client_ip", ctx.ClientIP()),
+	)
+
+	year, yearErr := strconv.Atoi(yearParam)
+	month, monthErr := strconv.Atoi(monthParam)
+	if yearErr != nil || monthErr != nil {
+		c.logger.Error("controller", "GetSavingsRate - invalid year or month format", errors.Join(yearErr, monthErr),
+			zap.String("year_param", yearParam),
+			zap.String("month_param", monthParam),
+		)
+
+		respondJSON(ctx, http.StatusBadRequest, gin.H{
+			"error":   "Bad Request",
+			"message": "Invalid year or month format",
+			"status":  http.StatusBadRequest,
+		})
+		return
+	}
+
+	location, ok := c.reportLocation(ctx)
+	if !ok {
+		return
+	}
+
+	start := time.Now()
+	report, err := c.service.GetSavingsRate(ctx.Request.Context(), year, month, includePending(ctx), location)
+	duration := time.Since(start)
+
+	c.logger.Performance("GetSavingsRate service call", duration,
+		zap.Int("year", year),
+		zap.Int("month", month),
+		zap.Bool("success", err == nil),
+	)
+
+	if err != nil {
+		c.logger.Error("controller", "GetSavingsRate - service error", err,
+			zap.Int("year", year),
+			zap.Int("month", month),
+		)
+
+		respondJSON(ctx, http.StatusBadRequest, gin.H{
+			"error":   "Bad Request",
+			"message": err.Error(),
+			"status":  http.StatusBadRequest,
+		})
+		return
+	}
+
+	c.logger.Controller("GetSavingsRate completed successfully",
+		zap.Int("currencies_count", len(report.Rates)),
+		zap.Duration("total_duration", duration),
+	)
+
+	c.setMonthCacheControl(ctx, year, month, location)
+	respondJSON(ctx, http.StatusOK, report)
+}
+
+// GetIncomeExpense returns only a month's income and expense totals per currency
+func (c *ReportController) GetIncomeExpense(ctx *gin.Context) {
+	yearParam := ctx.Param("year")
+	monthParam := ctx.Param("month")
+
+	c.logger.Controller("GetIncomeExpense started",
+		zap.String("year_param", yearParam),
+		zap.String("month_param", monthParam),
+		zap.String("client_ip", ctx.ClientIP()),
+	)
+
+	year, yearErr := strconv.Atoi(yearParam)
+	month, monthErr := strconv.Atoi(monthParam)
+	if yearErr != nil || monthErr != nil {
+		c.logger.Error("controller", "GetIncomeExpense - invalid year or month format", errors.Join(yearErr, monthErr),
+			zap.String("year_param", yearParam),
+			zap.String("month_param", monthParam),
+		)
+
+		respondJSON(ctx, http.StatusBadRequest, gin.H{
+			"error":   "Bad Request",
+			"message": "Invalid year or month format",
+			"status":  http.StatusBadRequest,
+		})
+		return
+	}
+
+	location, ok := c.reportLocation(ctx)
+	if !ok {
+		return
+	}
+
+	start := time.Now()
+	report, err := c.service.GetIncomeExpense(ctx.Request.Context(), year, month, includePending(ctx), location)
+	duration := time.Since(start)
+
+	c.logger.Performance("GetIncomeExpense service call", duration,
+		zap.Int("year", year),
+		zap.Int("month", month),
+		zap.Bool("success", err == nil),
+	)
+
+	if err != nil {
+		c.logger.Error("controller", "GetIncomeExpense - service error", err,
+			zap.Int("year", year),
+			zap.Int("month", month),
+		)
+
+		respondJSON(ctx, http.StatusBadRequest, gin.H{
+			"error":   "Bad Request",
+			"message": err.Error(),
+			"status":  http.StatusBadRequest,
+		})
+		return
+	}
+
+	c.logger.Controller("GetIncomeExpense completed successfully",
+		zap.Duration("total_duration", duration),
+	)
+
+	c.setMonthCacheControl(ctx, year, month, location)
+	respondJSON(ctx, http.StatusOK, report)
+}
+
+// GetMonthlyTotals returns only a month's income, expense and balance per currency,
+// without the transaction list or category breakdown of the full report
+func (c *ReportController) GetMonthlyTotals(ctx *gin.Context) {
+	yearParam := ctx.Param("year")
+	monthParam := ctx.Param("month")
+
+	c.logger.Controller("GetMonthlyTotals started",
+		zap.String("year_param", yearParam),
+		zap.String("month_param", monthParam),
+		zap.String("client_ip", ctx.ClientIP()),
+	)
+
+	year, yearErr := strconv.Atoi(yearParam)
+	month, monthErr := strconv.Atoi(monthParam)
+	if yearErr != nil || monthErr != nil {
+		c.logger.Error("controller", "GetMonthlyTotals - invalid year or month format", errors.Join(yearErr, monthErr),
+			zap.String("year_param", yearParam),
+			zap.String("month_param", monthParam),
+		)
+
+		respondJSON(ctx, http.StatusBadRequest, gin.H{
+			"error":   "Bad Request",
+			"message": "Invalid year or month format",
+			"status":  http.StatusBadRequest,
+		})
+		return
+	}
+
+	location, ok := c.reportLocation(ctx)
+	if !ok {
+		return
+	}
+
+	start := time.Now()
+	totals, err := c.service.GetMonthlyTotals(ctx.Request.Context(), year, month, includePending(ctx), location)
+	duration := time.Since(start)
+
+	c.logger.Performance("GetMonthlyTotals service call", duration,
+		zap.Int("year", year),
+		zap.Int("month", month),
+		zap.Bool("success", err == nil),
+	)
+
+	if err != nil {
+		c.logger.Error("controller", "GetMonthlyTotals - service error", err,
+			zap.Int("year", year),
+			zap.Int("month", month),
+		)
+
+		respondJSON(ctx, http.StatusBadRequest, gin.H{
+			"error":   "Bad Request",
+			"message": err.Error(),
+			"status":  http.StatusBadRequest,
+		})
+		return
+	}
+
+	c.logger.Controller("GetMonthlyTotals completed successfully",
+		zap.Int("transaction_count", totals.TransactionCount),
+		zap.Duration("total_duration", duration),
+	)
+
+	c.setMonthCacheControl(ctx, year, month, location)
+	respondJSON(ctx, http.StatusOK, totals)
+}
+
+// GetMonthDiff compares two months, e.g. ?a=2024-05&b=2024-06, as b minus a per
+// currency and per category
+func (c *ReportController) GetMonthDiff(ctx *gin.Context) {
+	aParam := ctx.Query("a")
+	bParam := ctx.Query("b")
+
+	c.logger.Controller("GetMonthDiff started",
+		zap.String("a", aParam),
+		zap.String("b", bParam),
+		zap.String("client_ip", ctx.ClientIP()),
+	)
+
+	a, aErr := time.Parse("2006-01", aParam)
+	b, bErr := time.Parse("2006-01", bParam)
+	if aErr != nil || bErr != nil {
+		c.logger.Error("controller", "GetMonthDiff - invalid month format", errors.Join(aErr, bErr),
+			zap.String("a", aParam),
+			zap.String("b", bParam),
+		)
+
+		respondJSON(ctx, http.StatusBadRequest, gin.H{
+			"error":   "Bad Request",
+			"message": "a and b must be months in YYYY-MM format, e.g. 2024-06",
+			"status":  http.StatusBadRequest,
+		})
+		return
+	}
+
+	location, ok := c.reportLocation(ctx)
+	if !ok {
+		return
+	}
+
+	start := time.Now()
+	report, err := c.service.GetMonthDiff(ctx.Request.Context(), a, b, includePending(ctx), location)
+	duration := time.Since(start)
+
+	c.logger.Performance("GetMonthDiff service call", duration,
+		zap.Bool("success", err == nil),
+	)
+
+	if err != nil {
+		c.logger.Error("controller", "GetMonthDiff - service error", err,
+			zap.String("a", aParam),
+			zap.String("b", bParam),
+		)
+
+		respondJSON(ctx, http.StatusBadRequest, gin.H{
+			"error":   "Bad Request",
+			"message": err.Error(),
+			"status":  http.StatusBadRequest,
+		})
+		return
+	}
+
+	c.logger.Controller("GetMonthDiff completed successfully",
+		zap.Duration("total_duration", duration),
+	)
+
+	respondJSON(ctx, http.StatusOK, report)
+}
+
+// GetReportMonths lists the months with transactions and how many each holds
+func (c *ReportController) GetReportMonths(ctx *gin.Context) {
+	c.logger.Controller("GetReportMonths started",
+		zap.String("client_ip", ctx.ClientIP()),
+	)
+
+	months, err := c.service.GetReportMonths(ctx.Request.Context(), includePending(ctx))
+	if err != nil {
+		c.logger.Error("controller", "GetReportMonths - service error", err)
+
+		respondJSON(ctx, http.StatusInternalServerError, gin.H{
+			"error":   "Internal Server Error",
+			"message": "Failed to list report months",
+			"status":  http.StatusInternalServerError,
+		})
+		return
+	}
+
+	c.logger.Controller("GetReportMonths completed successfully",
+		zap.Int("month_count", len(months)),
+	)
+
+	respondJSON(ctx, http.StatusOK, months)
+}
+
+// CloseMonth marks a month as closed; afterwards its transactions cannot be created,
+// updated or deleted until the month is reopened
+func (c *ReportController) CloseMonth(ctx *gin.Context) {
+	year, month, ok := c.parseYearMonth(ctx, "CloseMonth")
+	if !ok {
+		return
+	}
+
+	closed, err := c.service.CloseMonth(ctx.Request.Context(), year, month)
+	if err != nil {
+		c.logger.Error("controller", "CloseMonth - service error", err,
+			zap.Int("year", year),
+			zap.Int("month", month),
+		)
+
+		respondJSON(ctx, http.StatusBadRequest, gin.H{
+			"error":   "Bad Request",
+			"message": err.Error(),
+			"status":  http.StatusBadRequest,
+		})
+		return
+	}
+
+	c.logger.Controller("CloseMonth completed successfully",
+		zap.Int("year", year),
+		zap.Int("month", month),
+	)
+
+	respondJSON(ctx, http.StatusOK, closed)
+}
+
+// ReopenMonth lifts the lock set by CloseMonth; reopening an open month is a 409
+func (c *ReportController) ReopenMonth(ctx *gin.Context) {
+	year, month, ok := c.parseYearMonth(ctx, "ReopenMonth")
+	if !ok {
+		return
+	}
+
+	if err := c.service.ReopenMonth(ctx.Request.Context(), year, month); err != nil {
+		c.logger.Error("controller", "ReopenMonth - service error", err,
+			zap.Int("year", year),
+			zap.Int("month", month),
+		)
+
+		if errors.Is(err, models.ErrMonthNotClosed) {
+			respondJSON(ctx, http.StatusConflict, gin.H{
+				"error":   "Conflict",
+				"message": "Month is not closed",
+				"status":  http.StatusConflict,
+			})
+			return
+		}
+
+		respondJSON(ctx, http.StatusBadRequest, gin.H{
+			"error":   "Bad Request",
+			"message": err.Error(),
+			"status":  http.StatusBadRequest,
+		})
+		return
+	}
+
+	c.logger.Controller("ReopenMonth completed successfully",
+		zap.Int("year", year),
+		zap.Int("month", month),
+	)
+
+	respondJSON(ctx, http.StatusOK, gin.H{
+		"message": "Month reopened successfully",
+	})
+}
+
+// parseYearMonth reads the :year and :month path parameters, responding with 400
+// when either is not a number
+func (c *ReportController) parseYearMonth(ctx *gin.Context, operation string) (int, int, bool) {
+	yearParam := ctx.Param("year")
+	monthParam := ctx.Param("month")
+
+	c.logger.Controller(operation+" started",
+		zap.String("year_param", yearParam),
+		zap.String("month_param", monthParam),
+		zap.String("client_ip", ctx.ClientIP()),
+	)
+
+	year, yearErr := strconv.Atoi(yearParam)
+	month, monthErr := strconv.Atoi(monthParam)
+	if yearErr != nil || monthErr != nil {
+		c.logger.Error("controller", operation+" - invalid year or month format", errors.Join(yearErr, monthErr),
+			zap.String("year_param", yearParam),
+			zap.String("month_param", monthParam),
+		)
+
+		respondJSON(ctx, http.StatusBadRequest, gin.H{
+			"error":   "Bad Request",
+			"message": "Invalid year or month format",
+			"status":  http.StatusBadRequest,
+		})
+		return 0, 0, false
+	}
+
+	return year, month, true
+}
+
+func (c *ReportController) GetBalanceAsOf(ctx *gin.Context) {
+	asOfParam := ctx.Query("as_of")
+	base := strings.ToUpper(ctx.Query("base"))
+
+	c.logger.Controller("GetBalanceAsOf started",
+		zap.String("as_of", asOfParam),
+		zap.String("base", base),
+		zap.String("client_ip", ctx.ClientIP()),
+	)
+
+	asOfDate, err := parseOptionalDate(asOfParam)
+	if err != nil {
+		c.logger.Error("controller", "GetBalanceAsOf - invalid as_of date", err,
+			zap.String("as_of", asOfParam),
+		)
+
+		respondJSON(ctx, http.StatusBadRequest, gin.H{
+			"error":   "Bad Request",
+			"message": "Invalid as_of date format, use YYYY-MM-DD",
+			"status":  http.StatusBadRequest,
+		})
+		return
+	}
+
+	if base != "" {
+		if err := utils.ValidateCurrency(base); err != nil {
+			c.logger.Error("controller", "GetBalanceAsOf - invalid base currency", err,
+				zap.String("base", base),
+			)
+
+			respondJSON(ctx, http.StatusBadRequest, gin.H{
+				"error":   "Bad Request",
+				"message": err.Error(),
+				"status":  http.StatusBadRequest,
+			})
+			return
+		}
+	}
+
+	// as_of defaults to today and covers the whole day
+	asOf := time.Now().UTC()
+	if asOfDate != nil {
+		asOf = *asOfDate
+	}
+	asOf = endOfDay(asOf)
+
+	start := time.Now()
+	report, err := c.service.GetBalanceAsOf(ctx.Request.Context(), asOf, base, includePending(ctx))
+	duration := time.Since(start)
+
+	c.logger.Performance("GetBalanceAsOf service call", duration,
+		zap.Bool("success", err == nil),
+	)
+
+	if err != nil {
+		c.logger.Error("controller", "GetBalanceAsOf - service error", err)
+
+		respondJSON(ctx, http.StatusInternalServerError, gin.H{
+			"error":   "Internal Server Error",
+			"message": "Failed to compute balance",
+			"status":  http.StatusInternalServerError,
+		})
+		return
+	}
+
+	c.logger.Controller("GetBalanceAsOf completed successfully",
+		zap.Int("transaction_count", report.TransactionCount),
+		zap.Duration("total_duration", duration),
+	)
+
+	respondJSON(ctx, http.StatusOK, report)
+}
+
+func (c *ReportController) GetNetWorthReport(ctx *gin.Context) {
+	granularity := ctx.Query("granularity")
+
+	c.logger.Controller("GetNetWorthReport started",
+		zap.String("query_params", ctx.Request.URL.RawQuery),
+		zap.String("client_ip", ctx.ClientIP()),
+	)
+
+	fromDate, err := parseOptionalDate(ctx.Query("from"))
+	if err != nil || fromDate == nil {
+		c.logger.Error("controller", "GetNetWorthReport - invalid from date", err,
+			zap.String("from", ctx.Query("from")),
+		)
+
+		respondJSON(ctx, http.StatusBadRequest, gin.H{
+			"error":   "Bad Request",
+			"message": "from is required, use YYYY-MM-DD",
+			"status":  http.StatusBadRequest,
+		})
+		return
+	}
+
+	toDate, err := parseOptionalDate(ctx.Query("to"))
+	if err != nil {
+		c.logger.Error("controller", "GetNetWorthReport - invalid to date", err,
+			zap.String("to", ctx.Query("to")),
+		)
+
+		respondJSON(ctx, http.StatusBadRequest, gin.H{
+			"error":   "Bad Request",
+			"message": "Invalid to date format, use YYYY-MM-DD",
+			"status":  http.StatusBadRequest,
+		})
+		return
+	}
+
+	// to is inclusive and defaults to today
+	to := time.Now().UTC()
+	if toDate != nil {
+		to = *toDate
+	}
+	to = endOfDay(to)
+
+	// Opening balances come as opening[ARS]=1000&opening[USD]=50
+	openingBalance := make(map[string]float64)
+	for currency, value := range ctx.QueryMap("opening") {
+		amount, err := strconv.ParseFloat(value, 64)
+		if err != nil {
+			c.logger.Error("controller", "GetNetWorthReport - invalid opening balance", err,
+				zap.String("currency", currency),
+				zap.String("value", value),
+			)
+
+			respondJSON(ctx, http.StatusBadRequest, gin.H{
+				"error":   "Bad Request",
+				"message": "Invalid opening balance for " + currency,
+				"status":  http.StatusBadRequest,
+			})
+			return
+		}
+		openingBalance[currency] = amount
+	}
+
+	start := time.Now()
+	report, err := c.service.GetNetWorthReport(ctx.Request.Context(), *fromDate, to, granularity, openingBalance, includePending(ctx))
+	duration := time.Since(start)
+
+	c.logger.Performance("GetNetWorthReport service call", duration,
+		zap.String("granularity", granularity),
+		zap.Bool("success", err == nil),
+	)
+
+	if err != nil {
+		c.logger.Error("controller", "GetNetWorthReport - service error", err,
+			zap.String("granularity", granularity),
+		)
+
+		respondJSON(ctx, http.StatusBadRequest, gin.H{
+			"error":   "Bad Request",
+			"message": err.Error(),
+			"status":  http.StatusBadRequest,
+		})
+		return
+	}
+
+	c.logger.Controller("GetNetWorthReport completed successfully",
+		zap.Int("periods_count", len(report.Periods)),
+		zap.Duration("total_duration", duration),
+	)
+
+	respondJSON(ctx, http.StatusOK, report)
+}
+
+// includeTransactions reports whether the report should embed its transactions.
+// Defaults to true; ?include_transactions=false drops them.
+func includeTransactions(ctx *gin.Context) bool {
+	include, err := strconv.ParseBool(ctx.Query("include_transactions"))
+	return err != nil || include
+}
+
+// includePending reports whether pending transactions count towards the report.
+// Defaults to false; ?include_pending=true adds them.
+func includePending(ctx *gin.Context) bool {
+	include, _ := strconv.ParseBool(ctx.Query("include_pending"))
+	return include
+}
+
+// reportLocation reads ?tz=, an IANA time zone such as America/Argentina/Buenos_Aires,
+// that month boundaries are computed in. Without it reports stay in UTC. An unknown
+// zone is answered with 400 and ok false.
+func (c *ReportController) reportLocation(ctx *gin.Context) (location *time.Location, ok bool) {
+	tz := ctx.Query("tz")
+	if tz == "" {
+		return time.UTC, true
+	}
+
+	// "Local" would silently depend on the server's zone
+	location, err := time.LoadLocation(tz)
+	if err == nil && tz == "Local" {
+		err = errors.New("the server's local zone is not accepted")
+	}
+	if err != nil {
+		c.logger.Error("controller", "Invalid tz parameter", err,
+			zap.String("tz", tz),
+		)
+
+		respondJSON(ctx, http.StatusBadRequest, gin.H{
+			"error":   "Bad Request",
+			"message": fmt.Sprintf("tz must be an IANA time zone such as America/Argentina/Buenos_Aires, got %q", tz),
+			"status":  http.StatusBadRequest,
+		})
+		return nil, false
+	}
+	return location, true
+}
+
+// endOfDay is the last instant of t's UTC day, so an inclusive to date covers all of it
+func endOfDay(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC).AddDate(0, 0, 1).Add(-time.Nanosecond)
+}
+
+// parseOptionalDate parses a YYYY-MM-DD query value, returning nil when empty
+func parseOptionalDate(value string) (*time.Time, error) {
+	if value == "" {
+		return nil, nil
+	}
+
+	date, err := time.Parse("2006-01-02", value)
+	if err != nil {
+		return nil, err
+	}
+
+	return &date, nil
+}
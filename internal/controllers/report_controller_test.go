@@ -1,6 +1,8 @@
 package controllers_test
 
 import (
+	"encoding/csv"
+	"encoding/json"
 	"fmt"
 	"net/http"
 	"testing"
@@ -53,6 +55,22 @@ func (suite *ReportControllerTestSuite) TestGetMonthlyReport_EmptyData() {
 	assert.Empty(suite.T(), transactions)
 }
 
+func (suite *ReportControllerTestSuite) TestGetMonthlyReport_EmptyCollectionsNotNull() {
+	// When
+	w := suite.server.MakeRequest("GET", "/api/v1/reports/monthly/2024/6", nil)
+
+	// Then
+	assert.Equal(suite.T(), http.StatusOK, w.Code)
+
+	body := w.Body.String()
+	assert.Contains(suite.T(), body, `"total_income":{}`)
+	assert.Contains(suite.T(), body, `"total_expense":{}`)
+	assert.Contains(suite.T(), body, `"balance":{}`)
+	assert.Contains(suite.T(), body, `"category_breakdown":{}`)
+	assert.Contains(suite.T(), body, `"transactions":[]`)
+	assert.NotContains(suite.T(), body, "null")
+}
+
 func (suite *ReportControllerTestSuite) TestGetMonthlyReport_WithData() {
 	// Given - create transactions for June 2024
 	transactions := []models.CreateTransactionRequest{
@@ -143,6 +161,56 @@ func (suite *ReportControllerTestSuite) TestGetMonthlyReport_WithData() {
 	assert.Len(suite.T(), transactions_response, 4)
 }
 
+func (suite *ReportControllerTestSuite) TestGetMonthlyReport_IncludesLastSubSecondOfMonth() {
+	// Given - dated like a create that defaulted to the clock, half a second before July
+	date := time.Date(2024, 6, 30, 23, 59, 59, 500000000, time.UTC)
+	suite.server.Seed([]models.Transaction{
+		{ID: 1, Type: "expense", Amount: 100, Currency: "ARS", Description: "Late", Category: "food", Date: date, CreatedAt: date},
+	}, 2)
+
+	// When
+	june := suite.server.MakeRequest("GET", "/api/v1/reports/monthly/2024/6", nil)
+	july := suite.server.MakeRequest("GET", "/api/v1/reports/monthly/2024/7", nil)
+
+	// Then - counted once, in June
+	assert.Equal(suite.T(), http.StatusOK, june.Code)
+	assert.Equal(suite.T(), float64(100), test.SafeGetMap(suite.T(), test.GetResponseJSON(suite.T(), june), "total_expense")["ARS"])
+	assert.Equal(suite.T(), http.StatusOK, july.Code)
+	assert.NotContains(suite.T(), test.SafeGetMap(suite.T(), test.GetResponseJSON(suite.T(), july), "total_expense"), "ARS")
+}
+
+func (suite *ReportControllerTestSuite) TestGetMonthlyReport_ExcludeTransactions() {
+	// Given
+	transactions := []models.CreateTransactionRequest{
+		{Type: "income", Amount: 1000, Currency: "ARS", Description: "Salary", Category: "salary", Date: stringPtr("2024-06-10")},
+		{Type: "expense", Amount: 400, Currency: "ARS", Description: "Food", Category: "food", Date: stringPtr("2024-06-12")},
+	}
+	for _, req := range transactions {
+		suite.server.MakeRequest("POST", "/api/v1/transactions", req)
+	}
+
+	// When
+	full := suite.server.MakeRequest("GET", "/api/v1/reports/monthly/2024/6", nil)
+	trimmed := suite.server.MakeRequest("GET", "/api/v1/reports/monthly/2024/6?include_transactions=false", nil)
+
+	// Then
+	assert.Equal(suite.T(), http.StatusOK, trimmed.Code)
+
+	var fullReport, trimmedReport models.MonthlyReport
+	assert.NoError(suite.T(), json.Unmarshal(full.Body.Bytes(), &fullReport))
+	assert.NoError(suite.T(), json.Unmarshal(trimmed.Body.Bytes(), &trimmedReport))
+
+	assert.Len(suite.T(), fullReport.Transactions, 2)
+	assert.Empty(suite.T(), trimmedReport.Transactions)
+	assert.Contains(suite.T(), trimmed.Body.String(), `"transactions":[]`)
+	assert.Less(suite.T(), trimmed.Body.Len(), full.Body.Len())
+
+	assert.Equal(suite.T(), models.Money(1000.0), trimmedReport.TotalIncome["ARS"])
+	assert.Equal(suite.T(), models.Money(400.0), trimmedReport.TotalExpense["ARS"])
+	assert.Equal(suite.T(), models.Money(600.0), trimmedReport.Balance["ARS"])
+	assert.Equal(suite.T(), 2, trimmedReport.Summary.TransactionCount)
+}
+
 func (suite *ReportControllerTestSuite) TestGetMonthlyReport_InvalidYear() {
 	testCases := []struct {
 		name string
@@ -380,7 +448,659 @@ func (suite *ReportControllerTestSuite) TestGetMonthlyReport_AllMonths() {
 	}
 }
 
+// Test GetGroupedReport
+func (suite *ReportControllerTestSuite) TestGetMonthlyReport_PrettyJSON() {
+	// When
+	compact := suite.server.MakeRequest("GET", "/api/v1/reports/monthly/2024/6", nil)
+	pretty := suite.server.MakeRequest("GET", "/api/v1/reports/monthly/2024/6?pretty=true", nil)
+
+	// Then
+	assert.Equal(suite.T(), http.StatusOK, compact.Code)
+	assert.Equal(suite.T(), http.StatusOK, pretty.Code)
+	assert.NotContains(suite.T(), compact.Body.String(), "\n")
+	assert.Contains(suite.T(), pretty.Body.String(), "\n    \"month\": \"June\"")
+}
+
+func (suite *ReportControllerTestSuite) TestGetMonthlyReport_PendingTransactions() {
+	// Given
+	transactions := []models.CreateTransactionRequest{
+		{Type: "expense", Amount: 1000, Currency: "ARS", Description: "Groceries", Category: "food", Date: stringPtr("2024-06-10")},
+		{Type: "expense", Amount: 400, Currency: "ARS", Description: "Card hold", Category: "food", Status: "pending", Date: stringPtr("2024-06-12")},
+	}
+	for _, req := range transactions {
+		suite.server.MakeRequest("POST", "/api/v1/transactions", req)
+	}
+
+	// When
+	excluded := suite.server.MakeRequest("GET", "/api/v1/reports/monthly/2024/6", nil)
+	included := suite.server.MakeRequest("GET", "/api/v1/reports/monthly/2024/6?include_pending=true", nil)
+
+	// Then
+	var report models.MonthlyReport
+	assert.Equal(suite.T(), http.StatusOK, excluded.Code)
+	assert.NoError(suite.T(), json.Unmarshal(excluded.Body.Bytes(), &report))
+	assert.Equal(suite.T(), 1, report.Summary.TransactionCount)
+	assert.Equal(suite.T(), models.Money(1000), report.TotalExpense["ARS"])
+
+	report = models.MonthlyReport{}
+	assert.Equal(suite.T(), http.StatusOK, included.Code)
+	assert.NoError(suite.T(), json.Unmarshal(included.Body.Bytes(), &report))
+	assert.Equal(suite.T(), 2, report.Summary.TransactionCount)
+	assert.Equal(suite.T(), models.Money(1400), report.TotalExpense["ARS"])
+}
+
+func (suite *ReportControllerTestSuite) TestGetBalanceAsOf_PendingTransactions() {
+	// Given
+	transactions := []models.CreateTransactionRequest{
+		{Type: "income", Amount: 5000, Currency: "ARS", Description: "Salary", Category: "salary", Date: stringPtr("2024-06-01")},
+		{Type: "expense", Amount: 700, Currency: "ARS", Description: "Card hold", Category: "food", Status: "pending", Date: stringPtr("2024-06-02")},
+	}
+	for _, req := range transactions {
+		suite.server.MakeRequest("POST", "/api/v1/transactions", req)
+	}
+
+	// When
+	excluded := suite.server.MakeRequest("GET", "/api/v1/reports/balance?as_of=2024-06-30", nil)
+	included := suite.server.MakeRequest("GET", "/api/v1/reports/balance?as_of=2024-06-30&include_pending=true", nil)
+
+	// Then
+	var report models.BalanceReport
+	assert.NoError(suite.T(), json.Unmarshal(excluded.Body.Bytes(), &report))
+	assert.Equal(suite.T(), models.CurrencyAmounts{"ARS": 5000}, report.Balance)
+
+	report = models.BalanceReport{}
+	assert.NoError(suite.T(), json.Unmarshal(included.Body.Bytes(), &report))
+	assert.Equal(suite.T(), models.CurrencyAmounts{"ARS": 4300}, report.Balance)
+}
+
+func (suite *ReportControllerTestSuite) TestGetGroupedReport_ByCategory() {
+	// Given
+	transactions := []models.CreateTransactionRequest{
+		{Type: "expense", Amount: 100, Currency: "ARS", Description: "Coffee", Category: "food", Date: stringPtr("2024-06-10")},
+		{Type: "expense", Amount: 300, Currency: "ARS", Description: "Lunch", Category: "food", Date: stringPtr("2024-06-11")},
+		{Type: "expense", Amount: 50, Currency: "ARS", Description: "Bus", Category: "transport", Date: stringPtr("2024-07-01")},
+	}
+
+	for _, req := range transactions {
+		createResponse := suite.server.MakeRequest("POST", "/api/v1/transactions", req)
+		assert.Equal(suite.T(), http.StatusCreated, createResponse.Code)
+	}
+
+	// When
+	w := suite.server.MakeRequest("GET", "/api/v1/reports/group-by?field=category&from=2024-06-01&to=2024-06-30", nil)
+
+	// Then
+	assert.Equal(suite.T(), http.StatusOK, w.Code)
+
+	response := test.GetResponseJSON(suite.T(), w)
+	assert.Equal(suite.T(), "category", response["field"])
+	assert.Equal(suite.T(), float64(2), response["transaction_count"])
+
+	groups := test.SafeGetMap(suite.T(), response, "groups")
+	assert.NotContains(suite.T(), groups, "transport")
+
+	food := test.SafeGetMap(suite.T(), groups, "food")
+	assert.Equal(suite.T(), float64(2), food["count"])
+	assert.Equal(suite.T(), float64(400), test.SafeGetMap(suite.T(), food, "expense")["ARS"])
+	assert.Equal(suite.T(), float64(-400), test.SafeGetMap(suite.T(), food, "balance")["ARS"])
+}
+
+func (suite *ReportControllerTestSuite) TestGetGroupedReport_ToCoversTheWholeDay() {
+	// Given - an expense recorded at 15:00 on the to date
+	afternoon := time.Date(2024, 6, 30, 15, 0, 0, 0, time.UTC)
+	suite.server.Seed([]models.Transaction{
+		{ID: 1, Type: "expense", Amount: 500, Currency: "ARS", Description: "Dinner", Category: "food", Date: afternoon, CreatedAt: afternoon, UpdatedAt: afternoon},
+	}, 2)
+
+	// When
+	w := suite.server.MakeRequest("GET", "/api/v1/reports/group-by?field=category&from=2024-06-01&to=2024-06-30", nil)
+
+	// Then
+	assert.Equal(suite.T(), http.StatusOK, w.Code)
+	response := test.GetResponseJSON(suite.T(), w)
+	assert.Equal(suite.T(), float64(1), response["transaction_count"])
+	food := test.SafeGetMap(suite.T(), test.SafeGetMap(suite.T(), response, "groups"), "food")
+	assert.Equal(suite.T(), float64(500), test.SafeGetMap(suite.T(), food, "expense")["ARS"])
+}
+
+func (suite *ReportControllerTestSuite) TestGetGroupedReport_InvalidParams() {
+	testCases := []struct {
+		name string
+		url  string
+	}{
+		{"missing field", "/api/v1/reports/group-by"},
+		{"unknown field", "/api/v1/reports/group-by?field=description"},
+		{"invalid from date", "/api/v1/reports/group-by?field=type&from=2024-13-01"},
+		{"invalid to date", "/api/v1/reports/group-by?field=type&to=yesterday"},
+	}
+
+	for _, tc := range testCases {
+		suite.T().Run(tc.name, func(t *testing.T) {
+			w := suite.server.MakeRequest("GET", tc.url, nil)
+			assert.Equal(t, http.StatusBadRequest, w.Code)
+
+			response := test.GetResponseJSON(t, w)
+			assert.Equal(t, "Bad Request", response["error"])
+		})
+	}
+}
+
 // Helper function
+func (suite *ReportControllerTestSuite) TestGetAnomalyReport() {
+	// Given
+	for i := 0; i < 15; i++ {
+		suite.server.MakeRequest("POST", "/api/v1/transactions", models.CreateTransactionRequest{
+			Type: "expense", Amount: 1500, Currency: "ARS", Description: "Coffee", Category: "food",
+		})
+	}
+	suite.server.MakeRequest("POST", "/api/v1/transactions", models.CreateTransactionRequest{
+		Type: "expense", Amount: 1600, Currency: "ARS", Description: "Coffee", Category: "food",
+	})
+	suite.server.MakeRequest("POST", "/api/v1/transactions", models.CreateTransactionRequest{
+		Type: "expense", Amount: 150000, Currency: "ARS", Description: "Coffee", Category: "food",
+	})
+
+	// When
+	w := suite.server.MakeRequest("GET", "/api/v1/reports/anomalies", nil)
+
+	// Then
+	assert.Equal(suite.T(), http.StatusOK, w.Code)
+
+	var report models.AnomalyReport
+	assert.NoError(suite.T(), json.Unmarshal(w.Body.Bytes(), &report))
+	assert.Len(suite.T(), report.Outliers, 1)
+	assert.Equal(suite.T(), models.Money(150000), report.Outliers[0].Transaction.Amount)
+	assert.Empty(suite.T(), report.MixedCurrencyCategories)
+
+	invalid := suite.server.MakeRequest("GET", "/api/v1/reports/anomalies?from=yesterday", nil)
+	assert.Equal(suite.T(), http.StatusBadRequest, invalid.Code)
+}
+
+func (suite *ReportControllerTestSuite) TestGetCategoryTrend() {
+	// Given
+	now := time.Now()
+	lastMonth := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, time.UTC).AddDate(0, -1, 0).Format("2006-01-02")
+	transactions := []models.CreateTransactionRequest{
+		{Type: "expense", Amount: 1500, Currency: "ARS", Description: "Coffee", Category: "food"},
+		{Type: "expense", Amount: 2500, Currency: "ARS", Description: "Lunch", Category: "food"},
+		{Type: "expense", Amount: 4000, Currency: "ARS", Description: "Dinner", Category: "food", Date: &lastMonth},
+		{Type: "expense", Amount: 9000, Currency: "ARS", Description: "Rent", Category: "rent"},
+		{Type: "income", Amount: 7000, Currency: "ARS", Description: "Refund", Category: "food"},
+	}
+	for _, req := range transactions {
+		suite.server.MakeRequest("POST", "/api/v1/transactions", req)
+	}
+
+	// When
+	w := suite.server.MakeRequest("GET", "/api/v1/reports/trends/category/food?months=3", nil)
+
+	// Then
+	assert.Equal(suite.T(), http.StatusOK, w.Code)
+
+	var report models.CategoryTrendReport
+	assert.NoError(suite.T(), json.Unmarshal(w.Body.Bytes(), &report))
+	assert.Equal(suite.T(), "food", report.Category)
+	assert.Len(suite.T(), report.Series, 3)
+	assert.Empty(suite.T(), report.Series[0].Expense)
+	assert.Equal(suite.T(), models.Money(4000), report.Series[1].Expense["ARS"])
+	assert.Equal(suite.T(), models.Money(4000), report.Series[2].Expense["ARS"])
+	assert.Equal(suite.T(), int(now.Month()), report.Series[2].Month)
+
+	defaults := suite.server.MakeRequest("GET", "/api/v1/reports/trends/category/food", nil)
+	assert.NoError(suite.T(), json.Unmarshal(defaults.Body.Bytes(), &report))
+	assert.Len(suite.T(), report.Series, 6)
+
+	for _, months := range []string{"0", "37", "six"} {
+		invalid := suite.server.MakeRequest("GET", "/api/v1/reports/trends/category/food?months="+months, nil)
+		assert.Equal(suite.T(), http.StatusBadRequest, invalid.Code, months)
+	}
+}
+
+func (suite *ReportControllerTestSuite) TestGetSavingsRate() {
+	// Given
+	transactions := []models.CreateTransactionRequest{
+		{Type: "income", Amount: 2000, Currency: "ARS", Description: "Salary", Category: "salary", Date: stringPtr("2024-06-01")},
+		{Type: "expense", Amount: 500, Currency: "ARS", Description: "Groceries", Category: "food", Date: stringPtr("2024-06-15")},
+		{Type: "expense", Amount: 20, Currency: "USD", Description: "Book", Category: "books", Date: stringPtr("2024-06-20")},
+	}
+	for _, req := range transactions {
+		suite.server.MakeRequest("POST", "/api/v1/transactions", req)
+	}
+
+	// When
+	w := suite.server.MakeRequest("GET", "/api/v1/reports/savings-rate/2024/6", nil)
+
+	// Then
+	assert.Equal(suite.T(), http.StatusOK, w.Code)
+
+	var report models.SavingsRateReport
+	assert.NoError(suite.T(), json.Unmarshal(w.Body.Bytes(), &report))
+	assert.InDelta(suite.T(), 0.75, *report.Rates["ARS"].Rate, 0.0001)
+	assert.Nil(suite.T(), report.Rates["USD"].Rate)
+	assert.True(suite.T(), report.Rates["USD"].NoIncome)
+
+	for _, path := range []string{"/api/v1/reports/savings-rate/2024/13", "/api/v1/reports/savings-rate/year/6"} {
+		invalid := suite.server.MakeRequest("GET", path, nil)
+		assert.Equal(suite.T(), http.StatusBadRequest, invalid.Code, path)
+	}
+}
+
+func (suite *ReportControllerTestSuite) TestGetIncomeExpense() {
+	// Given
+	transactions := []models.CreateTransactionRequest{
+		{Type: "income", Amount: 2000, Currency: "ARS", Description: "Salary", Category: "salary", Date: stringPtr("2024-06-01")},
+		{Type: "expense", Amount: 500, Currency: "ARS", Description: "Groceries", Category: "food", Date: stringPtr("2024-06-15")},
+		{Type: "expense", Amount: 250, Currency: "ARS", Description: "Dinner", Category: "food", Date: stringPtr("2024-06-16")},
+		{Type: "expense", Amount: 20, Currency: "USD", Description: "Book", Category: "books", Date: stringPtr("2024-06-20")},
+		{Type: "expense", Amount: 999, Currency: "ARS", Description: "Next month", Category: "food", Date: stringPtr("2024-07-01")},
+	}
+	for _, req := range transactions {
+		suite.server.MakeRequest("POST", "/api/v1/transactions", req)
+	}
+
+	// When
+	w := suite.server.MakeRequest("GET", "/api/v1/reports/income-expense/2024/6", nil)
+
+	// Then - only the two totals, nothing else from the monthly report
+	assert.Equal(suite.T(), http.StatusOK, w.Code)
+	assert.JSONEq(suite.T(), `{"income": {"ARS": 2000, "USD": 0}, "expense": {"ARS": 750, "USD": 20}}`, w.Body.String())
+
+	for _, path := range []string{"/api/v1/reports/income-expense/2024/13", "/api/v1/reports/income-expense/year/6"} {
+		invalid := suite.server.MakeRequest("GET", path, nil)
+		assert.Equal(suite.T(), http.StatusBadRequest, invalid.Code, path)
+	}
+}
+
+func (suite *ReportControllerTestSuite) TestGetMonthlyTotals_MatchesFullReport() {
+	// Given
+	transactions := []models.CreateTransactionRequest{
+		{Type: "income", Amount: 2000, Currency: "ARS", Description: "Salary", Category: "salary", Date: stringPtr("2024-06-01")},
+		{Type: "expense", Amount: 500.25, Currency: "ARS", Description: "Groceries", Category: "food", Date: stringPtr("2024-06-15")},
+		{Type: "expense", Amount: 20, Currency: "USD", Description: "Book", Category: "books", Date: stringPtr("2024-06-20")},
+		{Type: "expense", Amount: 999, Currency: "ARS", Description: "Next month", Category: "food", Date: stringPtr("2024-07-01")},
+	}
+	for _, req := range transactions {
+		suite.server.MakeRequest("POST", "/api/v1/transactions", req)
+	}
+
+	// When
+	w := suite.server.MakeRequest("GET", "/api/v1/reports/monthly/2024/6/totals", nil)
+	full := suite.server.MakeRequest("GET", "/api/v1/reports/monthly/2024/6", nil)
+
+	// Then
+	assert.Equal(suite.T(), http.StatusOK, w.Code)
+	assert.JSONEq(suite.T(), `{
+		"month": "June",
+		"year": 2024,
+		"total_income": {"ARS": 2000, "USD": 0},
+		"total_expense": {"ARS": 500.25, "USD": 20},
+		"balance": {"ARS": 1499.75, "USD": -20},
+		"transaction_count": 3
+	}`, w.Body.String())
+
+	var totals, report map[string]interface{}
+	suite.Require().NoError(json.Unmarshal(w.Body.Bytes(), &totals))
+	suite.Require().NoError(json.Unmarshal(full.Body.Bytes(), &report))
+	for _, field := range []string{"total_income", "total_expense", "balance"} {
+		assert.Equal(suite.T(), report[field], totals[field], field)
+	}
+
+	for _, path := range []string{"/api/v1/reports/monthly/2024/13/totals", "/api/v1/reports/monthly/year/6/totals"} {
+		invalid := suite.server.MakeRequest("GET", path, nil)
+		assert.Equal(suite.T(), http.StatusBadRequest, invalid.Code, path)
+	}
+}
+
+func (suite *ReportControllerTestSuite) TestGetMonthDiff() {
+	// Given
+	transactions := []models.CreateTransactionRequest{
+		{Type: "income", Amount: 2000, Currency: "ARS", Description: "Salary", Category: "salary", Date: stringPtr("2024-05-01")},
+		{Type: "expense", Amount: 500, Currency: "ARS", Description: "Groceries", Category: "food", Date: stringPtr("2024-05-15")},
+		{Type: "income", Amount: 2000, Currency: "ARS", Description: "Salary", Category: "salary", Date: stringPtr("2024-06-01")},
+		{Type: "expense", Amount: 700, Currency: "ARS", Description: "Groceries", Category: "food", Date: stringPtr("2024-06-15")},
+		{Type: "expense", Amount: 20, Currency: "USD", Description: "Book", Category: "books", Date: stringPtr("2024-06-20")},
+	}
+	for _, req := range transactions {
+		suite.server.MakeRequest("POST", "/api/v1/transactions", req)
+	}
+
+	// When
+	w := suite.server.MakeRequest("GET", "/api/v1/reports/diff?a=2024-05&b=2024-06", nil)
+
+	// Then
+	assert.Equal(suite.T(), http.StatusOK, w.Code)
+	assert.JSONEq(suite.T(), `{
+		"a": "2024-05",
+		"b": "2024-06",
+		"currencies": {
+			"ARS": {"income": 0, "expense": 200, "balance": -200},
+			"USD": {"income": 0, "expense": 20, "balance": -20}
+		},
+		"categories": {
+			"salary": {"a": {"ARS": 2000}, "b": {"ARS": 2000}, "delta": {"ARS": 0}},
+			"food": {"a": {"ARS": 500}, "b": {"ARS": 700}, "delta": {"ARS": 200}},
+			"books": {"a": {}, "b": {"USD": 20}, "delta": {"USD": 20}}
+		}
+	}`, w.Body.String())
+
+	for _, query := range []string{"", "?a=2024-05", "?a=2024-5&b=2024-06", "?a=2024-05&b=june"} {
+		invalid := suite.server.MakeRequest("GET", "/api/v1/reports/diff"+query, nil)
+		assert.Equal(suite.T(), http.StatusBadRequest, invalid.Code, query)
+	}
+}
+
+func (suite *ReportControllerTestSuite) TestGetMonthlyReport_TimezoneMovesMonthBoundary() {
+	// Given - midnight UTC on July 1st is still June 30th in Buenos Aires (UTC-3)
+	suite.server.MakeRequest("POST", "/api/v1/transactions", models.CreateTransactionRequest{
+		Type: "expense", Amount: 500, Currency: "ARS", Description: "Boundary", Category: "food", Date: stringPtr("2024-07-01"),
+	})
+
+	// When
+	utcJune := suite.server.MakeRequest("GET", "/api/v1/reports/monthly/2024/6", nil)
+	localJune := suite.server.MakeRequest("GET", "/api/v1/reports/monthly/2024/6?tz=America/Argentina/Buenos_Aires", nil)
+	localJuly := suite.server.MakeRequest("GET", "/api/v1/reports/income-expense/2024/7?tz=America/Argentina/Buenos_Aires", nil)
+
+	// Then
+	var report models.MonthlyReport
+	suite.Require().NoError(json.Unmarshal(utcJune.Body.Bytes(), &report))
+	assert.Equal(suite.T(), 0, report.Summary.TransactionCount)
+
+	suite.Require().Equal(http.StatusOK, localJune.Code)
+	suite.Require().NoError(json.Unmarshal(localJune.Body.Bytes(), &report))
+	assert.Equal(suite.T(), 1, report.Summary.TransactionCount)
+	assert.Equal(suite.T(), "2024-06-01T00:00:00-03:00", report.Source.StartDate.Format(time.RFC3339))
+
+	assert.JSONEq(suite.T(), `{"income": {}, "expense": {}}`, localJuly.Body.String())
+}
+
+func (suite *ReportControllerTestSuite) TestReports_InvalidTimezone() {
+	for _, path := range []string{
+		"/api/v1/reports/monthly/2024/6?tz=Mars/Olympus_Mons",
+		"/api/v1/reports/current-month?tz=Local",
+		"/api/v1/reports/savings-rate/2024/6?tz=GMT+3",
+		"/api/v1/reports/income-expense/2024/6?tz=nowhere",
+		"/api/v1/reports/diff?a=2024-05&b=2024-06&tz=Mars/Olympus_Mons",
+	} {
+		// When
+		w := suite.server.MakeRequest("GET", path, nil)
+
+		// Then
+		assert.Equal(suite.T(), http.StatusBadRequest, w.Code, path)
+		assert.Contains(suite.T(), w.Body.String(), "IANA time zone", path)
+	}
+}
+
+func (suite *ReportControllerTestSuite) TestGetMonthlyReport_HideZero() {
+	// Given - a purchase refunded in full, and one refunded in part
+	transactions := []models.CreateTransactionRequest{
+		{Type: "expense", Amount: 0.3, Currency: "USD", Description: "Headphones", Category: "electronics", Date: stringPtr("2024-06-02")},
+		{Type: "income", Amount: 0.1, Currency: "USD", Description: "Partial refund", Category: "electronics", Date: stringPtr("2024-06-05")},
+		{Type: "income", Amount: 0.2, Currency: "USD", Description: "Refund", Category: "electronics", Date: stringPtr("2024-06-09")},
+		{Type: "expense", Amount: 500, Currency: "ARS", Description: "Shoes", Category: "clothing", Date: stringPtr("2024-06-03")},
+		{Type: "income", Amount: 200, Currency: "ARS", Description: "Partial refund", Category: "clothing", Date: stringPtr("2024-06-04")},
+	}
+	for _, req := range transactions {
+		suite.server.MakeRequest("POST", "/api/v1/transactions", req)
+	}
+
+	for _, tc := range []struct {
+		query      string
+		categories []string
+	}{
+		{"", []string{"clothing", "electronics"}},
+		{"?hide_zero=false", []string{"clothing", "electronics"}},
+		{"?hide_zero=true", []string{"clothing"}},
+	} {
+		// When
+		w := suite.server.MakeRequest("GET", "/api/v1/reports/monthly/2024/6"+tc.query, nil)
+
+		// Then
+		assert.Equal(suite.T(), http.StatusOK, w.Code)
+		var report models.MonthlyReport
+		assert.NoError(suite.T(), json.Unmarshal(w.Body.Bytes(), &report))
+		categories := make([]string, 0, len(report.Summary.CategoryBreakdown))
+		for category := range report.Summary.CategoryBreakdown {
+			categories = append(categories, category)
+		}
+		assert.ElementsMatch(suite.T(), tc.categories, categories, tc.query)
+		assert.Equal(suite.T(), 5, report.Summary.TransactionCount, tc.query)
+	}
+}
+
+func (suite *ReportControllerTestSuite) TestGetMonthlyReport_CSV() {
+	// Given
+	transactions := []models.CreateTransactionRequest{
+		{Type: "income", Amount: 5000, Currency: "ARS", Description: "Salary", Category: "salary", Date: stringPtr("2024-06-01")},
+		{Type: "expense", Amount: 1200, Currency: "ARS", Description: "Groceries", Category: "food", Date: stringPtr("2024-06-10")},
+		{Type: "expense", Amount: 30, Currency: "USD", Description: "Subscription", Category: "software", Date: stringPtr("2024-06-20")},
+		{Type: "expense", Amount: 999, Currency: "ARS", Description: "Next month", Category: "food", Date: stringPtr("2024-07-01")},
+	}
+	for _, req := range transactions {
+		suite.server.MakeRequest("POST", "/api/v1/transactions", req)
+	}
+
+	// When
+	w := suite.server.MakeRequest("GET", "/api/v1/reports/monthly/2024/6?format=csv", nil)
+
+	// Then
+	assert.Equal(suite.T(), http.StatusOK, w.Code)
+	assert.Equal(suite.T(), "text/csv; charset=utf-8", w.Header().Get("Content-Type"))
+	assert.Equal(suite.T(), `attachment; filename="finance-2024-06.csv"`, w.Header().Get("Content-Disposition"))
+
+	records, err := csv.NewReader(w.Body).ReadAll()
+	suite.Require().NoError(err)
+	suite.Require().Len(records, 1+3+6) // Header, June transactions, three totals per currency
+
+	descriptions := []string{records[1][4], records[2][4], records[3][4]}
+	assert.ElementsMatch(suite.T(), []string{"Salary", "Groceries", "Subscription"}, descriptions)
+
+	footer := make([][]string, 0, 6)
+	for _, record := range records[4:] {
+		footer = append(footer, record[1:4])
+	}
+	assert.Equal(suite.T(), [][]string{
+		{"total_income", "5000", "ARS"},
+		{"total_expense", "1200", "ARS"},
+		{"balance", "3800", "ARS"},
+		{"total_income", "0", "USD"},
+		{"total_expense", "30", "USD"},
+		{"balance", "-30", "USD"},
+	}, footer)
+}
+
+func (suite *ReportControllerTestSuite) TestGetMonthlyReport_UnsupportedFormat() {
+	// When
+	w := suite.server.MakeRequest("GET", "/api/v1/reports/monthly/2024/6?format=xml", nil)
+
+	// Then
+	assert.Equal(suite.T(), http.StatusBadRequest, w.Code)
+	test.AssertJSONContains(suite.T(), w, map[string]interface{}{"message": "format must be json or csv"})
+}
+
+func (suite *ReportControllerTestSuite) TestGetReportMonths() {
+	// Given
+	for _, date := range []string{"2024-06-15", "2024-03-01", "2024-06-01"} {
+		suite.server.MakeRequest("POST", "/api/v1/transactions", models.CreateTransactionRequest{
+			Type: "expense", Amount: 100, Currency: "ARS", Description: "Item", Category: "food", Date: stringPtr(date),
+		})
+	}
+
+	// When
+	w := suite.server.MakeRequest("GET", "/api/v1/reports/months", nil)
+
+	// Then
+	assert.Equal(suite.T(), http.StatusOK, w.Code)
+	var months []models.MonthCount
+	assert.NoError(suite.T(), json.Unmarshal(w.Body.Bytes(), &months))
+	assert.Equal(suite.T(), []models.MonthCount{
+		{Year: 2024, Month: 3, TransactionCount: 1},
+		{Year: 2024, Month: 6, TransactionCount: 2},
+	}, months)
+}
+
+func (suite *ReportControllerTestSuite) TestGetReportMonths_Empty() {
+	// When
+	w := suite.server.MakeRequest("GET", "/api/v1/reports/months", nil)
+
+	// Then
+	assert.Equal(suite.T(), http.StatusOK, w.Code)
+	assert.JSONEq(suite.T(), "[]", w.Body.String())
+}
+
+func (suite *ReportControllerTestSuite) TestMonthlyReport_CacheControlByPeriod() {
+	// Given
+	now := time.Now().UTC()
+	currentPath := fmt.Sprintf("/api/v1/reports/monthly/%d/%d", now.Year(), int(now.Month()))
+	next := now.AddDate(0, 1, 1-now.Day())
+	futurePath := fmt.Sprintf("/api/v1/reports/monthly/%d/%d", next.Year(), int(next.Month()))
+
+	// When
+	past := suite.server.MakeRequest("GET", "/api/v1/reports/monthly/2024/6", nil)
+	current := suite.server.MakeRequest("GET", currentPath, nil)
+	future := suite.server.MakeRequest("GET", futurePath, nil)
+	currentMonth := suite.server.MakeRequest("GET", "/api/v1/reports/current-month", nil)
+	invalid := suite.server.MakeRequest("GET", "/api/v1/reports/monthly/2024/13", nil)
+
+	// Then
+	assert.Equal(suite.T(), "public, max-age=86400", past.Header().Get("Cache-Control"))
+	assert.Equal(suite.T(), "no-cache", current.Header().Get("Cache-Control"))
+	assert.Equal(suite.T(), "no-cache", future.Header().Get("Cache-Control"))
+	assert.Equal(suite.T(), "no-cache", currentMonth.Header().Get("Cache-Control"))
+	assert.Empty(suite.T(), invalid.Header().Get("Cache-Control"))
+}
+
+func (suite *ReportControllerTestSuite) TestCloseMonth_LocksItsTransactions() {
+	// Given
+	var june, july models.Transaction
+	created := suite.server.MakeRequest("POST", "/api/v1/transactions", models.CreateTransactionRequest{
+		Type: "expense", Amount: 500, Currency: "ARS", Description: "Groceries", Category: "food", Date: stringPtr("2024-06-15"),
+	})
+	suite.Require().NoError(json.Unmarshal(created.Body.Bytes(), &june))
+	created = suite.server.MakeRequest("POST", "/api/v1/transactions", models.CreateTransactionRequest{
+		Type: "expense", Amount: 700, Currency: "ARS", Description: "Groceries", Category: "food", Date: stringPtr("2024-07-15"),
+	})
+	suite.Require().NoError(json.Unmarshal(created.Body.Bytes(), &july))
+
+	// When
+	closeResponse := suite.server.MakeRequest("POST", "/api/v1/reports/close/2024/6", nil)
+
+	// Then
+	assert.Equal(suite.T(), http.StatusOK, closeResponse.Code)
+	var closed models.ClosedMonth
+	assert.NoError(suite.T(), json.Unmarshal(closeResponse.Body.Bytes(), &closed))
+	assert.Equal(suite.T(), 2024, closed.Year)
+	assert.Equal(suite.T(), 6, closed.Month)
+
+	junePath := fmt.Sprintf("/api/v1/transactions/%d", june.ID)
+	update := suite.server.MakeRequest("PATCH", junePath, map[string]interface{}{"amount": 600})
+	assert.Equal(suite.T(), http.StatusLocked, update.Code)
+	assert.Equal(suite.T(), http.StatusLocked, suite.server.MakeRequest("DELETE", junePath, nil).Code)
+	assert.Equal(suite.T(), http.StatusLocked, suite.server.MakeRequest("POST", "/api/v1/transactions", models.CreateTransactionRequest{
+		Type: "expense", Amount: 100, Currency: "ARS", Description: "Late entry", Category: "food", Date: stringPtr("2024-06-30"),
+	}).Code)
+
+	julyPath := fmt.Sprintf("/api/v1/transactions/%d", july.ID)
+	assert.Equal(suite.T(), http.StatusLocked, suite.server.MakeRequest("PATCH", julyPath, map[string]interface{}{"date": "2024-06-20"}).Code)
+	assert.Equal(suite.T(), http.StatusOK, suite.server.MakeRequest("PATCH", julyPath, map[string]interface{}{"amount": 800}).Code)
+
+	// When / Then - reopening unlocks the month
+	assert.Equal(suite.T(), http.StatusOK, suite.server.MakeRequest("POST", "/api/v1/reports/reopen/2024/6", nil).Code)
+	assert.Equal(suite.T(), http.StatusOK, suite.server.MakeRequest("PATCH", junePath, map[string]interface{}{"amount": 600}).Code)
+	assert.Equal(suite.T(), http.StatusConflict, suite.server.MakeRequest("POST", "/api/v1/reports/reopen/2024/6", nil).Code)
+}
+
+func (suite *ReportControllerTestSuite) TestGetBalanceAsOf() {
+	// Given
+	transactions := []models.CreateTransactionRequest{
+		{Type: "income", Amount: 5000, Currency: "ARS", Description: "Salary", Category: "salary", Date: stringPtr("2024-06-01")},
+		{Type: "expense", Amount: 1200, Currency: "ARS", Description: "Groceries", Category: "food", Date: stringPtr("2024-06-30")},
+		{Type: "expense", Amount: 3000, Currency: "ARS", Description: "Rent", Category: "rent", Date: stringPtr("2024-07-01")},
+	}
+	for _, req := range transactions {
+		suite.server.MakeRequest("POST", "/api/v1/transactions", req)
+	}
+
+	testCases := []struct {
+		name            string
+		asOf            string
+		expectedCount   int
+		expectedBalance models.CurrencyAmounts
+	}{
+		{"before any transactions", "2024-01-01", 0, models.CurrencyAmounts{}},
+		{"mid history includes the whole day", "2024-06-30", 2, models.CurrencyAmounts{"ARS": 3800}},
+		{"after all transactions", "2024-12-31", 3, models.CurrencyAmounts{"ARS": 800}},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			// When
+			w := suite.server.MakeRequest("GET", "/api/v1/reports/balance?as_of="+tc.asOf, nil)
+
+			// Then
+			assert.Equal(suite.T(), http.StatusOK, w.Code)
+
+			var report models.BalanceReport
+			assert.NoError(suite.T(), json.Unmarshal(w.Body.Bytes(), &report))
+			assert.Equal(suite.T(), tc.expectedCount, report.TransactionCount)
+			assert.Equal(suite.T(), tc.expectedBalance, report.Balance)
+		})
+	}
+}
+
+func (suite *ReportControllerTestSuite) TestGetBalanceAsOf_InvalidDate() {
+	// When
+	w := suite.server.MakeRequest("GET", "/api/v1/reports/balance?as_of=30-06-2024", nil)
+
+	// Then
+	assert.Equal(suite.T(), http.StatusBadRequest, w.Code)
+}
+
+func (suite *ReportControllerTestSuite) TestGetNetWorthReport_Success() {
+	// Given
+	transactions := []models.CreateTransactionRequest{
+		{Type: "income", Amount: 1000, Currency: "ARS", Description: "Salary", Category: "salary", Date: stringPtr("2024-01-10")},
+		{Type: "expense", Amount: 1500, Currency: "ARS", Description: "Rent", Category: "rent", Date: stringPtr("2024-02-05")},
+	}
+	for _, req := range transactions {
+		suite.server.MakeRequest("POST", "/api/v1/transactions", req)
+	}
+
+	// When
+	w := suite.server.MakeRequest("GET", "/api/v1/reports/networth?from=2024-01-01&to=2024-02-29&opening[ARS]=200", nil)
+
+	// Then
+	assert.Equal(suite.T(), http.StatusOK, w.Code)
+
+	var report models.NetWorthReport
+	assert.NoError(suite.T(), json.Unmarshal(w.Body.Bytes(), &report))
+	assert.Equal(suite.T(), models.GranularityMonth, report.Granularity)
+	assert.Len(suite.T(), report.Periods, 2)
+	assert.Equal(suite.T(), models.Money(1200.0), report.Periods[0].Balance["ARS"])
+	assert.Equal(suite.T(), models.Money(-300.0), report.Periods[1].Balance["ARS"])
+}
+
+func (suite *ReportControllerTestSuite) TestGetNetWorthReport_InvalidParams() {
+	testCases := []struct {
+		name  string
+		query string
+	}{
+		{"missing from", "to=2024-02-29"},
+		{"invalid to", "from=2024-01-01&to=29-02-2024"},
+		{"invalid granularity", "from=2024-01-01&granularity=hour"},
+		{"invalid opening", "from=2024-01-01&opening[ARS]=abc"},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			// When
+			w := suite.server.MakeRequest("GET", "/api/v1/reports/networth?"+tc.query, nil)
+
+			// Then
+			assert.Equal(suite.T(), http.StatusBadRequest, w.Code)
+		})
+	}
+}
+
 func stringPtr(s string) *string {
 	return &s
 }
@@ -0,0 +1,28 @@
+package controllers
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+)
+
+const mimeCSV = "text/csv"
+
+// respondJSON writes obj as JSON, indented when the request has ?pretty=true
+func respondJSON(ctx *gin.Context, status int, obj interface{}) {
+	if pretty, err := strconv.ParseBool(ctx.Query("pretty")); err == nil && pretty {
+		ctx.IndentedJSON(status, obj)
+		return
+	}
+	ctx.JSON(status, obj)
+}
+
+// NotFound answers unknown routes with the API's JSON error shape
+func NotFound(ctx *gin.Context) {
+	ctx.JSON(http.StatusNotFound, gin.H{
+		"error":   "Not Found",
+		"message": "route not found",
+		"status":  http.StatusNotFound,
+	})
+}
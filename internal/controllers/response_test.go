@@ -0,0 +1,38 @@
+package controllers_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/maximicciullo/personal-finance-api/internal/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+type ResponseTestSuite struct {
+	suite.Suite
+	server *test.TestServer
+}
+
+func (suite *ResponseTestSuite) SetupTest() {
+	suite.server = test.NewTestServer()
+}
+
+func (suite *ResponseTestSuite) TestNotFound_UnknownRouteReturnsJSON() {
+	// When
+	w := suite.server.MakeRequest("GET", "/api/v1/does-not-exist", nil)
+
+	// Then
+	assert.Equal(suite.T(), http.StatusNotFound, w.Code)
+	assert.Equal(suite.T(), "application/json; charset=utf-8", w.Header().Get("Content-Type"))
+
+	test.AssertJSONContains(suite.T(), w, map[string]interface{}{
+		"error":   "Not Found",
+		"message": "route not found",
+		"status":  float64(http.StatusNotFound),
+	})
+}
+
+func TestResponseTestSuite(t *testing.T) {
+	suite.Run(t, new(ResponseTestSuite))
+}
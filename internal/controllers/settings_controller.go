@@ -0,0 +1,36 @@
+package controllers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/maximicciullo/personal-finance-api/internal/middleware"
+	"go.uber.org/zap"
+)
+
+// SettingsController exposes the effective, non-sensitive server configuration
+type SettingsController struct {
+	settings map[string]interface{}
+	logger   *middleware.BusinessLoggerInstance
+}
+
+// NewSettingsController serves settings as given; callers must pass an already
+// sanitized map such as config.Config.Sanitized
+func NewSettingsController(settings map[string]interface{}) *SettingsController {
+	return &SettingsController{
+		settings: settings,
+		logger:   middleware.BusinessLogger(),
+	}
+}
+
+func (c *SettingsController) GetSettings(ctx *gin.Context) {
+	c.logger.Controller("GetSettings started",
+		zap.String("client_ip", ctx.ClientIP()),
+	)
+
+	respondJSON(ctx, http.StatusOK, c.settings)
+
+	c.logger.Controller("GetSettings completed successfully",
+		zap.Int("settings_count", len(c.settings)),
+	)
+}
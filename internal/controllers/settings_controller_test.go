@@ -0,0 +1,39 @@
+package controllers_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/maximicciullo/personal-finance-api/internal/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+type SettingsControllerTestSuite struct {
+	suite.Suite
+	server *test.TestServer
+}
+
+func (suite *SettingsControllerTestSuite) SetupTest() {
+	suite.server = test.NewTestServer()
+}
+
+func (suite *SettingsControllerTestSuite) TestGetSettings() {
+	// When
+	w := suite.server.MakeRequest("GET", "/api/v1/settings", nil)
+
+	// Then
+	assert.Equal(suite.T(), http.StatusOK, w.Code)
+
+	response := test.GetResponseJSON(suite.T(), w)
+	assert.Equal(suite.T(), "test", response["environment"])
+	assert.Equal(suite.T(), "ARS", response["default_currency"])
+	assert.Equal(suite.T(), "8080", response["port"])
+	assert.Equal(suite.T(), "30s", response["request_timeout"])
+	assert.NotContains(suite.T(), response, "trusted_proxies")
+	assert.NotContains(suite.T(), w.Body.String(), "10.0.0.1")
+}
+
+func TestSettingsControllerTestSuite(t *testing.T) {
+	suite.Run(t, new(SettingsControllerTestSuite))
+}
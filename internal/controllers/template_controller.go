@@ -0,0 +1,238 @@
+package controllers
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/maximicciullo/personal-finance-api/internal/middleware"
+	"github.com/maximicciullo/personal-finance-api/internal/models"
+	"github.com/maximicciullo/personal-finance-api/internal/services"
+	"go.uber.org/zap"
+)
+
+type TemplateController struct {
+	service services.TemplateService
+	logger  *middleware.BusinessLoggerInstance
+}
+
+func NewTemplateController(service services.TemplateService) *TemplateController {
+	return &TemplateController{
+		service: service,
+		logger:  middleware.BusinessLogger(),
+	}
+}
+
+func (c *TemplateController) CreateTemplate(ctx *gin.Context) {
+	c.logger.Controller("CreateTemplate started",
+		zap.String("client_ip", ctx.ClientIP()),
+	)
+
+	var req models.TemplateRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		c.logger.Error("controller", "CreateTemplate - JSON binding failed", err)
+
+		respondJSON(ctx, http.StatusBadRequest, gin.H{
+			"error":   "Bad Request",
+			"message": err.Error(),
+			"status":  http.StatusBadRequest,
+		})
+		return
+	}
+
+	template, err := c.service.CreateTemplate(ctx.Request.Context(), &req)
+	if err != nil {
+		c.logger.Error("controller", "CreateTemplate - service error", err)
+
+		respondJSON(ctx, http.StatusBadRequest, gin.H{
+			"error":   "Bad Request",
+			"message": err.Error(),
+			"status":  http.StatusBadRequest,
+		})
+		return
+	}
+
+	c.logger.Controller("CreateTemplate completed successfully",
+		zap.Int("template_id", template.ID),
+	)
+
+	ctx.Header("Location", fmt.Sprintf("%s/%d", ctx.FullPath(), template.ID))
+	respondJSON(ctx, http.StatusCreated, template)
+}
+
+func (c *TemplateController) GetTemplates(ctx *gin.Context) {
+	c.logger.Controller("GetTemplates started")
+
+	templates, err := c.service.GetTemplates(ctx.Request.Context())
+	if err != nil {
+		c.logger.Error("controller", "GetTemplates - service error", err)
+
+		respondJSON(ctx, http.StatusInternalServerError, gin.H{
+			"error":   "Internal Server Error",
+			"message": "Failed to retrieve templates",
+			"status":  http.StatusInternalServerError,
+		})
+		return
+	}
+
+	c.logger.Controller("GetTemplates completed successfully",
+		zap.Int("template_count", len(templates)),
+	)
+
+	respondJSON(ctx, http.StatusOK, templates)
+}
+
+func (c *TemplateController) GetTemplate(ctx *gin.Context) {
+	id, ok := c.parseID(ctx, "GetTemplate")
+	if !ok {
+		return
+	}
+
+	template, err := c.service.GetTemplate(ctx.Request.Context(), id)
+	if err != nil {
+		c.respondServiceError(ctx, "GetTemplate", id, err)
+		return
+	}
+
+	c.logger.Controller("GetTemplate completed successfully",
+		zap.Int("template_id", id),
+	)
+
+	respondJSON(ctx, http.StatusOK, template)
+}
+
+func (c *TemplateController) UpdateTemplate(ctx *gin.Context) {
+	id, ok := c.parseID(ctx, "UpdateTemplate")
+	if !ok {
+		return
+	}
+
+	var req models.TemplateRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		c.logger.Error("controller", "UpdateTemplate - JSON binding failed", err)
+
+		respondJSON(ctx, http.StatusBadRequest, gin.H{
+			"error":   "Bad Request",
+			"message": err.Error(),
+			"status":  http.StatusBadRequest,
+		})
+		return
+	}
+
+	template, err := c.service.UpdateTemplate(ctx.Request.Context(), id, &req)
+	if err != nil {
+		c.respondServiceError(ctx, "UpdateTemplate", id, err)
+		return
+	}
+
+	c.logger.Controller("UpdateTemplate completed successfully",
+		zap.Int("template_id", id),
+	)
+
+	respondJSON(ctx, http.StatusOK, template)
+}
+
+func (c *TemplateController) DeleteTemplate(ctx *gin.Context) {
+	id, ok := c.parseID(ctx, "DeleteTemplate")
+	if !ok {
+		return
+	}
+
+	if err := c.service.DeleteTemplate(ctx.Request.Context(), id); err != nil {
+		c.respondServiceError(ctx, "DeleteTemplate", id, err)
+		return
+	}
+
+	c.logger.Controller("DeleteTemplate completed successfully",
+		zap.Int("template_id", id),
+	)
+
+	respondJSON(ctx, http.StatusOK, gin.H{
+		"message": "Template deleted successfully",
+	})
+}
+
+// ApplyTemplate creates a transaction from a template. The optional body takes the
+// same fields as a transaction update and overrides the template's values.
+func (c *TemplateController) ApplyTemplate(ctx *gin.Context) {
+	id, ok := c.parseID(ctx, "ApplyTemplate")
+	if !ok {
+		return
+	}
+
+	var overrides models.UpdateTransactionRequest
+	if err := ctx.ShouldBindJSON(&overrides); err != nil && !errors.Is(err, io.EOF) {
+		c.logger.Error("controller", "ApplyTemplate - JSON binding failed", err)
+
+		respondJSON(ctx, http.StatusBadRequest, gin.H{
+			"error":   "Bad Request",
+			"message": err.Error(),
+			"status":  http.StatusBadRequest,
+		})
+		return
+	}
+
+	transaction, err := c.service.ApplyTemplate(ctx.Request.Context(), id, &overrides)
+	if err != nil {
+		c.respondServiceError(ctx, "ApplyTemplate", id, err)
+		return
+	}
+
+	c.logger.Controller("ApplyTemplate completed successfully",
+		zap.Int("template_id", id),
+		zap.Int("transaction_id", transaction.ID),
+	)
+
+	ctx.Header("Location", fmt.Sprintf("/api/v1/transactions/%d", transaction.ID))
+	respondJSON(ctx, http.StatusCreated, transaction)
+}
+
+// parseID reads the :id path parameter, responding with 400 when it is not a number
+func (c *TemplateController) parseID(ctx *gin.Context, operation string) (int, bool) {
+	idParam := ctx.Param("id")
+
+	c.logger.Controller(operation+" started",
+		zap.String("template_id", idParam),
+	)
+
+	id, err := strconv.Atoi(idParam)
+	if err != nil {
+		c.logger.Error("controller", operation+" - invalid ID format", err,
+			zap.String("id_param", idParam),
+		)
+
+		respondJSON(ctx, http.StatusBadRequest, gin.H{
+			"error":   "Bad Request",
+			"message": "Invalid template ID",
+			"status":  http.StatusBadRequest,
+		})
+		return 0, false
+	}
+
+	return id, true
+}
+
+// respondServiceError maps a missing template to 404 and anything else to 400
+func (c *TemplateController) respondServiceError(ctx *gin.Context, operation string, id int, err error) {
+	c.logger.Error("controller", operation+" - service error", err,
+		zap.Int("template_id", id),
+	)
+
+	if errors.Is(err, models.ErrTemplateNotFound) {
+		respondJSON(ctx, http.StatusNotFound, gin.H{
+			"error":   "Not Found",
+			"message": "Template not found",
+			"status":  http.StatusNotFound,
+		})
+		return
+	}
+
+	respondJSON(ctx, http.StatusBadRequest, gin.H{
+		"error":   "Bad Request",
+		"message": err.Error(),
+		"status":  http.StatusBadRequest,
+	})
+}
@@ -0,0 +1,114 @@
+package controllers_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/maximicciullo/personal-finance-api/internal/models"
+	"github.com/maximicciullo/personal-finance-api/internal/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+type TemplateControllerTestSuite struct {
+	suite.Suite
+	server *test.TestServer
+}
+
+func (suite *TemplateControllerTestSuite) SetupTest() {
+	suite.server = test.NewTestServer()
+}
+
+func (suite *TemplateControllerTestSuite) createTemplate() models.Template {
+	w := suite.server.MakeRequest("POST", "/api/v1/templates", models.TemplateRequest{
+		Name: "coffee", Type: "expense", Amount: 1500, Currency: "ARS", Description: "Coffee", Category: "food",
+	})
+	suite.Require().Equal(http.StatusCreated, w.Code)
+
+	var template models.Template
+	suite.Require().NoError(json.Unmarshal(w.Body.Bytes(), &template))
+	return template
+}
+
+func (suite *TemplateControllerTestSuite) TestTemplateCRUD() {
+	// Given
+	template := suite.createTemplate()
+	path := fmt.Sprintf("/api/v1/templates/%d", template.ID)
+
+	// When / Then - read
+	get := suite.server.MakeRequest("GET", path, nil)
+	assert.Equal(suite.T(), http.StatusOK, get.Code)
+
+	list := suite.server.MakeRequest("GET", "/api/v1/templates", nil)
+	var templates []models.Template
+	assert.NoError(suite.T(), json.Unmarshal(list.Body.Bytes(), &templates))
+	assert.Len(suite.T(), templates, 1)
+
+	// When / Then - replace
+	put := suite.server.MakeRequest("PUT", path, models.TemplateRequest{Name: "tea", Type: "expense", Amount: 900, Category: "food"})
+	assert.Equal(suite.T(), http.StatusOK, put.Code)
+	var updated models.Template
+	assert.NoError(suite.T(), json.Unmarshal(put.Body.Bytes(), &updated))
+	assert.Equal(suite.T(), "tea", updated.Name)
+	assert.Empty(suite.T(), updated.Description)
+
+	// When / Then - delete
+	del := suite.server.MakeRequest("DELETE", path, nil)
+	assert.Equal(suite.T(), http.StatusOK, del.Code)
+	assert.Equal(suite.T(), http.StatusNotFound, suite.server.MakeRequest("GET", path, nil).Code)
+}
+
+func (suite *TemplateControllerTestSuite) TestApplyTemplate() {
+	// Given
+	template := suite.createTemplate()
+	path := fmt.Sprintf("/api/v1/templates/%d/apply", template.ID)
+
+	// When
+	plain := suite.server.MakeRequest("POST", path, nil)
+	overridden := suite.server.MakeRequest("POST", path, map[string]interface{}{"amount": 1800, "account": "cash"})
+
+	// Then
+	assert.Equal(suite.T(), http.StatusCreated, plain.Code)
+	var first models.Transaction
+	assert.NoError(suite.T(), json.Unmarshal(plain.Body.Bytes(), &first))
+	assert.Equal(suite.T(), models.Money(1500), first.Amount)
+	assert.Equal(suite.T(), "Coffee", first.Description)
+
+	assert.Equal(suite.T(), http.StatusCreated, overridden.Code)
+	var second models.Transaction
+	assert.NoError(suite.T(), json.Unmarshal(overridden.Body.Bytes(), &second))
+	assert.Equal(suite.T(), models.Money(1800), second.Amount)
+	assert.Equal(suite.T(), "cash", second.Account)
+	assert.Equal(suite.T(), "food", second.Category)
+}
+
+func (suite *TemplateControllerTestSuite) TestTemplateErrors() {
+	testCases := []struct {
+		name     string
+		method   string
+		url      string
+		body     interface{}
+		expected int
+	}{
+		{"missing name", "POST", "/api/v1/templates", map[string]interface{}{"amount": 10}, http.StatusBadRequest},
+		{"invalid id", "GET", "/api/v1/templates/abc", nil, http.StatusBadRequest},
+		{"unknown template", "GET", "/api/v1/templates/99", nil, http.StatusNotFound},
+		{"apply unknown template", "POST", "/api/v1/templates/99/apply", nil, http.StatusNotFound},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			// When
+			w := suite.server.MakeRequest(tc.method, tc.url, tc.body)
+
+			// Then
+			assert.Equal(suite.T(), tc.expected, w.Code)
+		})
+	}
+}
+
+func TestTemplateControllerTestSuite(t *testing.T) {
+	suite.Run(t, new(TemplateControllerTestSuite))
+}
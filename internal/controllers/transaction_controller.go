@@ -1,71 +1,1037 @@
 package controllers
 
 import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
 	"net/http"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/gin-gonic/gin"
+	"github.com/gin-gonic/gin/binding"
+	"github.com/maximicciullo/personal-finance-api/internal/importers"
 	"github.com/maximicciullo/personal-finance-api/internal/middleware"
 	"github.com/maximicciullo/personal-finance-api/internal/models"
 	"github.com/maximicciullo/personal-finance-api/internal/services"
+	"github.com/maximicciullo/personal-finance-api/internal/utils"
 	"go.uber.org/zap"
 )
 
+// TransactionControllerConfig holds HTTP-level settings for the transaction endpoints
+type TransactionControllerConfig struct {
+	MaxPageSize        int           // Larger limit values are clamped to this
+	DefaultPageSize    int           // Page size when limit is omitted; 0 returns every match, as before
+	EmptyListStatus    int           // 200 responds to an empty list with [], 204 with no body
+	Envelope           bool          // Wrap list responses in {"data", "meta"}, as /api/v2 does; always 200
+	ExportWriteTimeout time.Duration // Exports move the write deadline this far ahead on every write; 0 keeps the server's
+}
+
+// DefaultTransactionControllerConfig returns a default transaction controller configuration
+func DefaultTransactionControllerConfig() TransactionControllerConfig {
+	return TransactionControllerConfig{
+		MaxPageSize:     200,
+		EmptyListStatus: http.StatusOK,
+	}
+}
+
 type TransactionController struct {
 	service services.TransactionService
+	config  TransactionControllerConfig
 	logger  *middleware.BusinessLoggerInstance
 }
 
 func NewTransactionController(service services.TransactionService) *TransactionController {
+	return NewTransactionControllerWithConfig(service, DefaultTransactionControllerConfig())
+}
+
+func NewTransactionControllerWithConfig(service services.TransactionService, config TransactionControllerConfig) *TransactionController {
 	return &TransactionController{
 		service: service,
+		config:  config,
 		logger:  middleware.BusinessLogger(),
 	}
 }
 
-func (c *TransactionController) CreateTransaction(ctx *gin.Context) {
-	c.logger.Controller("CreateTransaction started",
-		zap.String("client_ip", ctx.ClientIP()),
-		zap.String("user_agent", ctx.Request.UserAgent()),
+func (c *TransactionController) CreateTransaction(ctx *gin.Context) {
+	c.logger.Controller("CreateTransaction started",
+		zap.String("client_ip", ctx.ClientIP()),
+		zap.String("user_agent", ctx.Request.UserAgent()),
+	)
+
+	var req models.CreateTransactionRequest
+
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		c.logger.Error("controller", "CreateTransaction - JSON binding failed", err,
+			zap.Any("request_body", req),
+		)
+		
+		respondJSON(ctx, http.StatusBadRequest, gin.H{
+			"error":   "Bad Request",
+			"message": err.Error(),
+			"status":  http.StatusBadRequest,
+		})
+		return
+	}
+
+	c.logger.Controller("CreateTransaction - request validated",
+		zap.String("type", req.Type),
+		zap.Float64("amount", req.Amount),
+		zap.String("currency", req.Currency),
+		zap.String("category", req.Category),
+	)
+
+	dryRun := isDryRun(ctx)
+
+	start := time.Now()
+	var transaction *models.Transaction
+	var warnings []models.Warning
+	var err error
+	if dryRun {
+		transaction, warnings, err = c.service.PreviewCreateTransaction(ctx.Request.Context(), &req)
+	} else {
+		transaction, warnings, err = c.service.CreateTransactionWithWarnings(ctx.Request.Context(), &req)
+	}
+	duration := time.Since(start)
+
+	c.logger.Performance("CreateTransaction service call", duration,
+		zap.Bool("success", err == nil),
+	)
+
+	if err != nil {
+		c.logger.Error("controller", "CreateTransaction - service error", err,
+			zap.Any("request", req),
+		)
+
+		if respondIfMonthClosed(ctx, err) {
+			return
+		}
+
+		if errors.Is(err, models.ErrStorageFull) {
+			respondJSON(ctx, http.StatusInsufficientStorage, gin.H{
+				"error":   "Insufficient Storage",
+				"message": err.Error(),
+				"status":  http.StatusInsufficientStorage,
+			})
+			return
+		}
+
+		respondJSON(ctx, http.StatusBadRequest, gin.H{
+			"error":   "Bad Request",
+			"message": err.Error(),
+			"status":  http.StatusBadRequest,
+		})
+		return
+	}
+
+	if dryRun {
+		c.logger.Controller("CreateTransaction dry run completed successfully",
+			zap.Int("warnings", len(warnings)),
+			zap.Duration("total_duration", duration),
+		)
+
+		respondJSON(ctx, http.StatusOK, writeResponse(ctx, transaction, warnings))
+		return
+	}
+
+	c.logger.Controller("CreateTransaction completed successfully",
+		zap.Int("transaction_id", transaction.ID),
+		zap.Int("warnings", len(warnings)),
+		zap.Duration("total_duration", duration),
+	)
+
+	ctx.Header("Location", transactionLocation(ctx, transaction.ID))
+	respondJSON(ctx, http.StatusCreated, writeResponse(ctx, transaction, warnings))
+}
+
+// ValidateTransaction checks a create payload without storing it, for forms that
+// validate as the user types. It answers 200 whether or not the payload is valid.
+func (c *TransactionController) ValidateTransaction(ctx *gin.Context) {
+	c.logger.Controller("ValidateTransaction started",
+		zap.String("client_ip", ctx.ClientIP()),
+	)
+
+	// Decoded without binding tags so every field is reported by the service, not
+	// just the first one the binder rejects
+	var req models.CreateTransactionRequest
+	if err := json.NewDecoder(ctx.Request.Body).Decode(&req); err != nil {
+		field := "body"
+		if errors.Is(err, models.ErrInvalidAmountInput) {
+			field = "amount"
+		}
+		c.logger.Controller("ValidateTransaction completed with invalid JSON",
+			zap.String("error", err.Error()),
+		)
+		respondJSON(ctx, http.StatusOK, models.ValidationResult{
+			Valid:  false,
+			Errors: map[string]string{field: err.Error()},
+		})
+		return
+	}
+
+	fieldErrors := c.service.ValidateCreate(ctx.Request.Context(), &req)
+
+	c.logger.Controller("ValidateTransaction completed successfully",
+		zap.Int("error_count", len(fieldErrors)),
+	)
+
+	result := models.ValidationResult{Valid: len(fieldErrors) == 0}
+	if !result.Valid {
+		result.Errors = fieldErrors
+	}
+	respondJSON(ctx, http.StatusOK, result)
+}
+
+// RecategorizeTransactions moves every transaction in one category, optionally
+// narrowed by filters, to another and returns how many were updated
+func (c *TransactionController) RecategorizeTransactions(ctx *gin.Context) {
+	c.logger.Controller("RecategorizeTransactions started",
+		zap.String("client_ip", ctx.ClientIP()),
+	)
+
+	var req models.RecategorizeRequest
+
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		c.logger.Error("controller", "RecategorizeTransactions - JSON binding failed", err)
+
+		respondJSON(ctx, http.StatusBadRequest, gin.H{
+			"error":   "Bad Request",
+			"message": err.Error(),
+			"status":  http.StatusBadRequest,
+		})
+		return
+	}
+
+	start := time.Now()
+	result, err := c.service.RecategorizeTransactions(ctx.Request.Context(), &req)
+	duration := time.Since(start)
+
+	c.logger.Performance("RecategorizeTransactions service call", duration,
+		zap.Bool("success", err == nil),
+	)
+
+	if err != nil {
+		c.logger.Error("controller", "RecategorizeTransactions - service error", err,
+			zap.Any("request", req),
+		)
+
+		if respondIfMonthClosed(ctx, err) {
+			return
+		}
+
+		if errors.Is(err, models.ErrReservedCategory) {
+			respondJSON(ctx, http.StatusConflict, gin.H{
+				"error":   "Conflict",
+				"message": err.Error(),
+				"status":  http.StatusConflict,
+			})
+			return
+		}
+
+		respondJSON(ctx, http.StatusBadRequest, gin.H{
+			"error":   "Bad Request",
+			"message": err.Error(),
+			"status":  http.StatusBadRequest,
+		})
+		return
+	}
+
+	c.logger.Controller("RecategorizeTransactions completed successfully",
+		zap.Int("updated_count", result.Updated),
+		zap.Duration("total_duration", duration),
+	)
+
+	respondJSON(ctx, http.StatusOK, result)
+}
+
+// maxImportSize bounds the statement files accepted by ImportTransactions
+const maxImportSize = 5 << 20
+
+// ImportTransactions creates transactions from a bank statement sent as the request
+// body. Records that cannot be parsed or created are skipped and listed in the response.
+func (c *TransactionController) ImportTransactions(ctx *gin.Context) {
+	format := strings.ToLower(ctx.Query("format"))
+
+	c.logger.Controller("ImportTransactions started",
+		zap.String("format", format),
+		zap.String("client_ip", ctx.ClientIP()),
+	)
+
+	if format != importers.FormatOFX {
+		c.logger.Error("controller", "ImportTransactions - unsupported format", errors.New("unsupported import format"),
+			zap.String("format", format),
+		)
+
+		respondJSON(ctx, http.StatusBadRequest, gin.H{
+			"error":   "Bad Request",
+			"message": "format must be ofx",
+			"status":  http.StatusBadRequest,
+		})
+		return
+	}
+
+	records, importErrors, err := importers.ParseOFX(http.MaxBytesReader(ctx.Writer, ctx.Request.Body, maxImportSize))
+	if err != nil {
+		c.logger.Error("controller", "ImportTransactions - parse error", err)
+
+		respondJSON(ctx, http.StatusBadRequest, gin.H{
+			"error":   "Bad Request",
+			"message": err.Error(),
+			"status":  http.StatusBadRequest,
+		})
+		return
+	}
+
+	start := time.Now()
+	result := models.ImportResult{
+		Imported: make([]models.Transaction, 0, len(records)),
+		Errors:   importErrors,
+	}
+	for _, record := range records {
+		transaction, err := c.service.CreateTransaction(ctx.Request.Context(), &record.Request)
+		if err != nil {
+			result.Errors = append(result.Errors, models.ImportError{Record: record.Number, Message: err.Error()})
+			continue
+		}
+		result.Imported = append(result.Imported, *transaction)
+	}
+	duration := time.Since(start)
+
+	c.logger.Performance("ImportTransactions service calls", duration,
+		zap.Int("imported_count", len(result.Imported)),
+		zap.Int("error_count", len(result.Errors)),
+	)
+
+	if len(result.Imported) == 0 && len(result.Errors) > 0 {
+		c.logger.Error("controller", "ImportTransactions - no records imported", errors.New("every record failed"),
+			zap.Int("error_count", len(result.Errors)),
+		)
+
+		respondJSON(ctx, http.StatusBadRequest, gin.H{
+			"error":   "Bad Request",
+			"message": "no records could be imported",
+			"status":  http.StatusBadRequest,
+			"errors":  result.Errors,
+		})
+		return
+	}
+
+	c.logger.Controller("ImportTransactions completed successfully",
+		zap.Int("imported_count", len(result.Imported)),
+		zap.Int("error_count", len(result.Errors)),
+		zap.Duration("total_duration", duration),
+	)
+
+	status := http.StatusOK
+	if len(result.Imported) > 0 {
+		status = http.StatusCreated
+	}
+	respondJSON(ctx, status, result)
+}
+
+func (c *TransactionController) GetTransactions(ctx *gin.Context) {
+	c.logger.Controller("GetTransactions started",
+		zap.String("query_params", ctx.Request.URL.RawQuery),
+	)
+
+	filters, err := c.parseFilters(ctx)
+	if err != nil {
+		c.logger.Error("controller", "GetTransactions - invalid filters", err,
+			zap.String("amount", ctx.Query("amount")),
+		)
+
+		respondJSON(ctx, http.StatusBadRequest, gin.H{
+			"error":   "Bad Request",
+			"message": err.Error(),
+			"status":  http.StatusBadRequest,
+		})
+		return
+	}
+	
+	c.logger.Controller("GetTransactions - filters parsed",
+		zap.Any("filters", filters),
+	)
+
+	if filters.FromDate != nil && filters.ToDate != nil && filters.FromDate.After(*filters.ToDate) {
+		c.logger.Error("controller", "GetTransactions - inverted date range", errors.New("from_date after to_date"),
+			zap.Time("from_date", *filters.FromDate),
+			zap.Time("to_date", *filters.ToDate),
+		)
+
+		respondJSON(ctx, http.StatusBadRequest, gin.H{
+			"error":   "Bad Request",
+			"message": "from_date must be on or before to_date",
+			"status":  http.StatusBadRequest,
+		})
+		return
+	}
+
+	emptyStatus, err := c.emptyListStatus(ctx)
+	if err != nil {
+		c.logger.Error("controller", "GetTransactions - invalid empty parameter", err,
+			zap.String("empty", ctx.Query("empty")),
+		)
+
+		respondJSON(ctx, http.StatusBadRequest, gin.H{
+			"error":   "Bad Request",
+			"message": err.Error(),
+			"status":  http.StatusBadRequest,
+		})
+		return
+	}
+
+	runningBalance := false
+	if runningBalanceStr := ctx.Query("running_balance"); runningBalanceStr != "" {
+		runningBalance, err = strconv.ParseBool(runningBalanceStr)
+		if err != nil {
+			c.logger.Error("controller", "GetTransactions - invalid running_balance", err,
+				zap.String("running_balance", runningBalanceStr),
+			)
+
+			respondJSON(ctx, http.StatusBadRequest, gin.H{
+				"error":   "Bad Request",
+				"message": "running_balance must be true or false",
+				"status":  http.StatusBadRequest,
+			})
+			return
+		}
+	}
+
+	if runningBalance && filters.Currency == "" {
+		c.logger.Error("controller", "GetTransactions - running balance without currency", models.ErrCurrencyRequired)
+
+		respondJSON(ctx, http.StatusBadRequest, gin.H{
+			"error":   "Bad Request",
+			"message": models.ErrCurrencyRequired.Error(),
+			"status":  http.StatusBadRequest,
+		})
+		return
+	}
+
+	if err := c.applyPagination(ctx, &filters); err != nil {
+		c.logger.Error("controller", "GetTransactions - invalid pagination", err,
+			zap.String("limit", ctx.Query("limit")),
+			zap.String("offset", ctx.Query("offset")),
+		)
+
+		respondJSON(ctx, http.StatusBadRequest, gin.H{
+			"error":   "Bad Request",
+			"message": err.Error(),
+			"status":  http.StatusBadRequest,
+		})
+		return
+	}
+
+	// The representation depends on Accept while the validators do not, so caches must
+	// key on it, 304s included
+	ctx.Header("Vary", "Accept")
+
+	lastModified, err := c.service.GetLastModified(ctx.Request.Context())
+	if err == nil && !lastModified.IsZero() {
+		etag := fmt.Sprintf(`"%d"`, lastModified.UnixNano())
+		ctx.Header("ETag", etag)
+		if c.notModified(ctx, lastModified, etag) {
+			c.logger.Controller("GetTransactions - not modified",
+				zap.Time("last_modified", lastModified),
+			)
+			ctx.Status(http.StatusNotModified)
+			return
+		}
+		ctx.Header("Last-Modified", lastModified.UTC().Format(http.TimeFormat))
+	}
+
+	if runningBalance {
+		c.getLedger(ctx, filters)
+		return
+	}
+
+	start := time.Now()
+	transactions, err := c.service.GetTransactions(ctx.Request.Context(), filters)
+	duration := time.Since(start)
+
+	c.logger.Performance("GetTransactions service call", duration,
+		zap.Int("transaction_count", len(transactions)),
+		zap.Bool("success", err == nil),
+	)
+
+	if err != nil {
+		c.logger.Error("controller", "GetTransactions - service error", err,
+			zap.Any("filters", filters),
+		)
+		
+		respondJSON(ctx, http.StatusInternalServerError, gin.H{
+			"error":   "Internal Server Error",
+			"message": "Failed to retrieve transactions",
+			"status":  http.StatusInternalServerError,
+		})
+		return
+	}
+
+	if len(transactions) == 0 && emptyStatus == http.StatusNoContent && !c.config.Envelope {
+		c.logger.Controller("GetTransactions completed successfully - no content",
+			zap.Duration("total_duration", duration),
+		)
+		ctx.Status(http.StatusNoContent)
+		return
+	}
+
+	if ctx.NegotiateFormat(binding.MIMEJSON, mimeCSV) == mimeCSV {
+		var buf bytes.Buffer
+		if err := utils.WriteTransactionsCSV(&buf, transactions); err != nil {
+			c.logger.Error("controller", "GetTransactions - CSV encoding error", err)
+
+			respondJSON(ctx, http.StatusInternalServerError, gin.H{
+				"error":   "Internal Server Error",
+				"message": "Failed to encode transactions as CSV",
+				"status":  http.StatusInternalServerError,
+			})
+			return
+		}
+
+		c.logger.Controller("GetTransactions completed successfully",
+			zap.Int("transaction_count", len(transactions)),
+			zap.String("format", "csv"),
+			zap.Duration("total_duration", duration),
+		)
+
+		ctx.Data(http.StatusOK, utils.CSVContentType, buf.Bytes())
+		return
+	}
+
+	c.logger.Controller("GetTransactions completed successfully",
+		zap.Int("transaction_count", len(transactions)),
+		zap.Duration("total_duration", duration),
+	)
+
+	c.respondList(ctx, transactions, models.ListMeta{
+		Count:  len(transactions),
+		Limit:  filters.Limit,
+		Offset: filters.Offset,
+	})
+}
+
+// ExportTransactions streams every transaction as a CSV attachment, or as JSON lines
+// with ?format=jsonl
+func (c *TransactionController) ExportTransactions(ctx *gin.Context) {
+	format := strings.ToLower(ctx.DefaultQuery("format", "csv"))
+
+	c.logger.Controller("ExportTransactions started",
+		zap.String("format", format),
+		zap.String("client_ip", ctx.ClientIP()),
+	)
+
+	var contentType, filename string
+	var stream func(io.Writer, <-chan models.Transaction, <-chan error) error
+	switch format {
+	case "csv":
+		contentType, filename, stream = utils.CSVContentType, "transactions.csv", utils.StreamTransactionsCSV
+	case "jsonl":
+		contentType, filename, stream = utils.NDJSONContentType, "transactions.jsonl", utils.StreamTransactionsJSONL
+	default:
+		c.logger.Error("controller", "ExportTransactions - unsupported format", errors.New("unsupported export format"),
+			zap.String("format", format),
+		)
+
+		respondJSON(ctx, http.StatusBadRequest, gin.H{
+			"error":   "Bad Request",
+			"message": "format must be csv or jsonl",
+			"status":  http.StatusBadRequest,
+		})
+		return
+	}
+
+	start := time.Now()
+	transactions, errs := c.service.StreamTransactions(ctx.Request.Context())
+
+	ctx.Header("Content-Type", contentType)
+	ctx.Header("Content-Disposition", fmt.Sprintf(`attachment; filename="%s"`, filename))
+	ctx.Status(http.StatusOK)
+
+	// Headers are already sent, so a failure mid-stream can only be logged
+	if err := stream(c.exportWriter(ctx), transactions, errs); err != nil {
+		c.logger.Error("controller", "ExportTransactions - streaming failed", err,
+			zap.Duration("elapsed", time.Since(start)),
+		)
+		return
+	}
+
+	c.logger.Controller("ExportTransactions completed successfully",
+		zap.Duration("total_duration", time.Since(start)),
+	)
+}
+
+// exportWriter is the writer an export streams to. The server's WriteTimeout bounds the
+// whole response, which a large export can outlast, so with ExportWriteTimeout set each
+// write gets its own deadline instead and only a stalled client cuts the download short.
+func (c *TransactionController) exportWriter(ctx *gin.Context) io.Writer {
+	if c.config.ExportWriteTimeout <= 0 {
+		return ctx.Writer
+	}
+
+	writer := &deadlineWriter{
+		Writer:     ctx.Writer,
+		controller: http.NewResponseController(ctx.Writer),
+		timeout:    c.config.ExportWriteTimeout,
+	}
+	if err := writer.extend(); err != nil {
+		c.logger.Debug("controller", "ExportTransactions - write deadline not supported, keeping the server's",
+			zap.Error(err),
+		)
+		return ctx.Writer
+	}
+	return writer
+}
+
+// deadlineWriter moves the connection's write deadline forward before every write
+type deadlineWriter struct {
+	io.Writer
+	controller *http.ResponseController
+	timeout    time.Duration
+}
+
+func (w *deadlineWriter) extend() error {
+	return w.controller.SetWriteDeadline(time.Now().Add(w.timeout))
+}
+
+func (w *deadlineWriter) Write(p []byte) (int, error) {
+	if err := w.extend(); err != nil {
+		return 0, err
+	}
+	return w.Writer.Write(p)
+}
+
+func (c *TransactionController) GetTransactionsBatch(ctx *gin.Context) {
+	idsParam := ctx.Query("ids")
+
+	c.logger.Controller("GetTransactionsBatch started",
+		zap.String("ids", idsParam),
+	)
+
+	var ids []int
+	for _, part := range strings.Split(idsParam, ",") {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			continue
+		}
+		id, err := strconv.Atoi(part)
+		if err != nil {
+			c.logger.Error("controller", "GetTransactionsBatch - invalid ID format", err,
+				zap.String("id_param", part),
+			)
+
+			respondJSON(ctx, http.StatusBadRequest, gin.H{
+				"error":   "Bad Request",
+				"message": "ids must be a comma-separated list of integers",
+				"status":  http.StatusBadRequest,
+			})
+			return
+		}
+		ids = append(ids, id)
+	}
+
+	start := time.Now()
+	result, err := c.service.GetTransactionsByIDs(ctx.Request.Context(), ids)
+	duration := time.Since(start)
+
+	c.logger.Performance("GetTransactionsBatch service call", duration,
+		zap.Int("requested_count", len(ids)),
+		zap.Bool("success", err == nil),
+	)
+
+	if err != nil {
+		c.logger.Error("controller", "GetTransactionsBatch - service error", err)
+
+		respondJSON(ctx, http.StatusBadRequest, gin.H{
+			"error":   "Bad Request",
+			"message": err.Error(),
+			"status":  http.StatusBadRequest,
+		})
+		return
+	}
+
+	c.logger.Controller("GetTransactionsBatch completed successfully",
+		zap.Int("found_count", len(result.Transactions)),
+		zap.Int("missing_count", len(result.MissingIDs)),
+		zap.Duration("total_duration", duration),
+	)
+
+	respondJSON(ctx, http.StatusOK, result)
+}
+
+func (c *TransactionController) GetRecentTransactions(ctx *gin.Context) {
+	limitParam := ctx.Query("limit")
+
+	c.logger.Controller("GetRecentTransactions started",
+		zap.String("limit", limitParam),
+	)
+
+	limit := 0
+	if limitParam != "" {
+		var err error
+		limit, err = strconv.Atoi(limitParam)
+		if err != nil || limit <= 0 {
+			c.logger.Error("controller", "GetRecentTransactions - invalid limit", err,
+				zap.String("limit", limitParam),
+			)
+
+			respondJSON(ctx, http.StatusBadRequest, gin.H{
+				"error":   "Bad Request",
+				"message": "limit must be a positive integer",
+				"status":  http.StatusBadRequest,
+			})
+			return
+		}
+	}
+
+	start := time.Now()
+	transactions, err := c.service.GetRecentTransactions(ctx.Request.Context(), limit)
+	duration := time.Since(start)
+
+	c.logger.Performance("GetRecentTransactions service call", duration,
+		zap.Int("transaction_count", len(transactions)),
+		zap.Bool("success", err == nil),
+	)
+
+	if err != nil {
+		c.logger.Error("controller", "GetRecentTransactions - service error", err)
+
+		respondJSON(ctx, http.StatusInternalServerError, gin.H{
+			"error":   "Internal Server Error",
+			"message": "Failed to retrieve recent transactions",
+			"status":  http.StatusInternalServerError,
+		})
+		return
+	}
+
+	c.logger.Controller("GetRecentTransactions completed successfully",
+		zap.Int("transaction_count", len(transactions)),
+		zap.Duration("total_duration", duration),
+	)
+
+	c.respondList(ctx, transactions, models.ListMeta{
+		Count: len(transactions),
+		Limit: services.RecentLimit(limit),
+	})
+}
+
+// CheckIntegrity runs the storage integrity check. Issues are reported in the body with
+// ok set to false; the status is 200 either way since the check itself succeeded.
+func (c *TransactionController) CheckIntegrity(ctx *gin.Context) {
+	c.logger.Controller("CheckIntegrity started",
+		zap.String("client_ip", ctx.ClientIP()),
+	)
+
+	start := time.Now()
+	report, err := c.service.CheckIntegrity(ctx.Request.Context())
+	duration := time.Since(start)
+
+	c.logger.Performance("CheckIntegrity service call", duration,
+		zap.Bool("success", err == nil),
+	)
+
+	if err != nil {
+		c.logger.Error("controller", "CheckIntegrity - service error", err)
+
+		respondJSON(ctx, http.StatusInternalServerError, gin.H{
+			"error":   "Internal Server Error",
+			"message": "Failed to check storage integrity",
+			"status":  http.StatusInternalServerError,
+		})
+		return
+	}
+
+	c.logger.Controller("CheckIntegrity completed successfully",
+		zap.Bool("ok", report.OK),
+		zap.Int("issue_count", len(report.Issues)),
+		zap.Duration("total_duration", duration),
+	)
+
+	respondJSON(ctx, http.StatusOK, report)
+}
+
+// GetTransactionsByDay returns a month's transactions grouped by day, for calendar views
+func (c *TransactionController) GetTransactionsByDay(ctx *gin.Context) {
+	yearParam := ctx.Param("year")
+	monthParam := ctx.Param("month")
+
+	c.logger.Controller("GetTransactionsByDay started",
+		zap.String("year_param", yearParam),
+		zap.String("month_param", monthParam),
+	)
+
+	year, err := strconv.Atoi(yearParam)
+	if err != nil {
+		c.logger.Error("controller", "GetTransactionsByDay - invalid year format", err,
+			zap.String("year_param", yearParam),
+		)
+
+		respondJSON(ctx, http.StatusBadRequest, gin.H{
+			"error":   "Bad Request",
+			"message": "Invalid year format",
+			"status":  http.StatusBadRequest,
+		})
+		return
+	}
+
+	month, err := strconv.Atoi(monthParam)
+	if err != nil {
+		c.logger.Error("controller", "GetTransactionsByDay - invalid month format", err,
+			zap.String("month_param", monthParam),
+		)
+
+		respondJSON(ctx, http.StatusBadRequest, gin.H{
+			"error":   "Bad Request",
+			"message": "Invalid month format",
+			"status":  http.StatusBadRequest,
+		})
+		return
+	}
+
+	start := time.Now()
+	days, err := c.service.GetTransactionsByDay(ctx.Request.Context(), year, month)
+	duration := time.Since(start)
+
+	c.logger.Performance("GetTransactionsByDay service call", duration,
+		zap.Int("year", year),
+		zap.Int("month", month),
+		zap.Bool("success", err == nil),
+	)
+
+	if err != nil {
+		c.logger.Error("controller", "GetTransactionsByDay - service error", err,
+			zap.Int("year", year),
+			zap.Int("month", month),
+		)
+
+		respondJSON(ctx, http.StatusBadRequest, gin.H{
+			"error":   "Bad Request",
+			"message": err.Error(),
+			"status":  http.StatusBadRequest,
+		})
+		return
+	}
+
+	c.logger.Controller("GetTransactionsByDay completed successfully",
+		zap.Int("day_count", len(days)),
+		zap.Duration("total_duration", duration),
+	)
+
+	respondJSON(ctx, http.StatusOK, days)
+}
+
+// GetTopExpenses returns the largest expenses in the required ?currency, optionally
+// between ?from and ?to (YYYY-MM-DD, inclusive)
+func (c *TransactionController) GetTopExpenses(ctx *gin.Context) {
+	limitParam := ctx.Query("limit")
+
+	c.logger.Controller("GetTopExpenses started",
+		zap.String("currency", ctx.Query("currency")),
+		zap.String("limit", limitParam),
+		zap.String("from", ctx.Query("from")),
+		zap.String("to", ctx.Query("to")),
+	)
+
+	query := models.TopExpensesQuery{Currency: ctx.Query("currency")}
+
+	if limitParam != "" {
+		limit, err := strconv.Atoi(limitParam)
+		if err != nil || limit <= 0 {
+			c.logger.Error("controller", "GetTopExpenses - invalid limit", err,
+				zap.String("limit", limitParam),
+			)
+
+			respondJSON(ctx, http.StatusBadRequest, gin.H{
+				"error":   "Bad Request",
+				"message": "limit must be a positive integer",
+				"status":  http.StatusBadRequest,
+			})
+			return
+		}
+		query.Limit = limit
+	}
+
+	fromDate, err := parseOptionalDate(ctx.Query("from"))
+	if err != nil {
+		c.logger.Error("controller", "GetTopExpenses - invalid from date", err,
+			zap.String("from", ctx.Query("from")),
+		)
+
+		respondJSON(ctx, http.StatusBadRequest, gin.H{
+			"error":   "Bad Request",
+			"message": "Invalid from date format, use YYYY-MM-DD",
+			"status":  http.StatusBadRequest,
+		})
+		return
+	}
+
+	toDate, err := parseOptionalDate(ctx.Query("to"))
+	if err != nil {
+		c.logger.Error("controller", "GetTopExpenses - invalid to date", err,
+			zap.String("to", ctx.Query("to")),
+		)
+
+		respondJSON(ctx, http.StatusBadRequest, gin.H{
+			"error":   "Bad Request",
+			"message": "Invalid to date format, use YYYY-MM-DD",
+			"status":  http.StatusBadRequest,
+		})
+		return
+	}
+	// to is inclusive, so it covers the whole day
+	if toDate != nil {
+		end := endOfDay(*toDate)
+		toDate = &end
+	}
+	query.FromDate, query.ToDate = fromDate, toDate
+
+	start := time.Now()
+	transactions, err := c.service.GetTopExpenses(ctx.Request.Context(), query)
+	duration := time.Since(start)
+
+	c.logger.Performance("GetTopExpenses service call", duration,
+		zap.Int("transaction_count", len(transactions)),
+		zap.Bool("success", err == nil),
+	)
+
+	if err != nil {
+		c.logger.Error("controller", "GetTopExpenses - service error", err)
+
+		if errors.Is(err, models.ErrTopExpensesCurrency) {
+			respondJSON(ctx, http.StatusBadRequest, gin.H{
+				"error":   "Bad Request",
+				"message": err.Error(),
+				"status":  http.StatusBadRequest,
+			})
+			return
+		}
+
+		respondJSON(ctx, http.StatusInternalServerError, gin.H{
+			"error":   "Internal Server Error",
+			"message": "Failed to retrieve top expenses",
+			"status":  http.StatusInternalServerError,
+		})
+		return
+	}
+
+	c.logger.Controller("GetTopExpenses completed successfully",
+		zap.Int("transaction_count", len(transactions)),
+		zap.Duration("total_duration", duration),
+	)
+
+	c.respondList(ctx, transactions, models.ListMeta{
+		Count: len(transactions),
+		Limit: services.TopExpensesLimit(query.Limit),
+	})
+}
+
+func (c *TransactionController) GetTransaction(ctx *gin.Context) {
+	idParam := ctx.Param("id")
+	
+	c.logger.Controller("GetTransaction started",
+		zap.String("transaction_id", idParam),
+	)
+
+	id, err := strconv.Atoi(idParam)
+	if err != nil {
+		c.logger.Error("controller", "GetTransaction - invalid ID format", err,
+			zap.String("id_param", idParam),
+		)
+		
+		respondJSON(ctx, http.StatusBadRequest, gin.H{
+			"error":   "Bad Request",
+			"message": "Invalid transaction ID",
+			"status":  http.StatusBadRequest,
+		})
+		return
+	}
+
+	start := time.Now()
+	transaction, err := c.service.GetTransaction(ctx.Request.Context(), id)
+	duration := time.Since(start)
+
+	c.logger.Performance("GetTransaction service call", duration,
+		zap.Int("transaction_id", id),
+		zap.Bool("success", err == nil),
+	)
+
+	if err != nil {
+		c.logger.Error("controller", "GetTransaction - service error", err,
+			zap.Int("transaction_id", id),
+		)
+		
+		respondJSON(ctx, http.StatusNotFound, gin.H{
+			"error":   "Not Found",
+			"message": "Transaction not found",
+			"status":  http.StatusNotFound,
+		})
+		return
+	}
+
+	c.logger.Controller("GetTransaction completed successfully",
+		zap.Int("transaction_id", id),
+		zap.Duration("total_duration", duration),
+	)
+
+	respondJSON(ctx, http.StatusOK, transaction)
+}
+
+func (c *TransactionController) PinTransaction(ctx *gin.Context) {
+	c.setPinned(ctx, true)
+}
+
+func (c *TransactionController) UnpinTransaction(ctx *gin.Context) {
+	c.setPinned(ctx, false)
+}
+
+func (c *TransactionController) setPinned(ctx *gin.Context, pinned bool) {
+	idParam := ctx.Param("id")
+
+	c.logger.Controller("SetPinned started",
+		zap.String("transaction_id", idParam),
+		zap.Bool("pinned", pinned),
 	)
 
-	var req models.CreateTransactionRequest
-
-	if err := ctx.ShouldBindJSON(&req); err != nil {
-		c.logger.Error("controller", "CreateTransaction - JSON binding failed", err,
-			zap.Any("request_body", req),
+	id, err := strconv.Atoi(idParam)
+	if err != nil {
+		c.logger.Error("controller", "SetPinned - invalid ID format", err,
+			zap.String("id_param", idParam),
 		)
-		
-		ctx.JSON(http.StatusBadRequest, gin.H{
+
+		respondJSON(ctx, http.StatusBadRequest, gin.H{
 			"error":   "Bad Request",
-			"message": err.Error(),
+			"message": "Invalid transaction ID",
 			"status":  http.StatusBadRequest,
 		})
 		return
 	}
 
-	c.logger.Controller("CreateTransaction - request validated",
-		zap.String("type", req.Type),
-		zap.Float64("amount", req.Amount),
-		zap.String("currency", req.Currency),
-		zap.String("category", req.Category),
-	)
-
 	start := time.Now()
-	transaction, err := c.service.CreateTransaction(&req)
+	transaction, err := c.service.SetPinned(ctx.Request.Context(), id, pinned)
 	duration := time.Since(start)
 
-	c.logger.Performance("CreateTransaction service call", duration,
+	c.logger.Performance("SetPinned service call", duration,
+		zap.Int("transaction_id", id),
 		zap.Bool("success", err == nil),
 	)
 
 	if err != nil {
-		c.logger.Error("controller", "CreateTransaction - service error", err,
-			zap.Any("request", req),
+		c.logger.Error("controller", "SetPinned - service error", err,
+			zap.Int("transaction_id", id),
 		)
-		
-		ctx.JSON(http.StatusBadRequest, gin.H{
+
+		if errors.Is(err, models.ErrTransactionNotFound) {
+			respondJSON(ctx, http.StatusNotFound, gin.H{
+				"error":   "Not Found",
+				"message": "Transaction not found",
+				"status":  http.StatusNotFound,
+			})
+			return
+		}
+
+		respondJSON(ctx, http.StatusBadRequest, gin.H{
 			"error":   "Bad Request",
 			"message": err.Error(),
 			"status":  http.StatusBadRequest,
@@ -73,69 +1039,119 @@ func (c *TransactionController) CreateTransaction(ctx *gin.Context) {
 		return
 	}
 
-	c.logger.Controller("CreateTransaction completed successfully",
-		zap.Int("transaction_id", transaction.ID),
+	c.logger.Controller("SetPinned completed successfully",
+		zap.Int("transaction_id", id),
+		zap.Bool("pinned", transaction.Pinned),
 		zap.Duration("total_duration", duration),
 	)
 
-	ctx.JSON(http.StatusCreated, transaction)
+	respondJSON(ctx, http.StatusOK, transaction)
 }
 
-func (c *TransactionController) GetTransactions(ctx *gin.Context) {
-	c.logger.Controller("GetTransactions started",
-		zap.String("query_params", ctx.Request.URL.RawQuery),
-	)
+// CloneTransaction creates a copy of an existing transaction dated today. The body is
+// optional and takes the same fields as an update to override the copied values. The
+// response carries warnings and honors ?amount_as_string like a create.
+func (c *TransactionController) CloneTransaction(ctx *gin.Context) {
+	idParam := ctx.Param("id")
 
-	filters := c.parseFilters(ctx)
-	
-	c.logger.Controller("GetTransactions - filters parsed",
-		zap.Any("filters", filters),
+	c.logger.Controller("CloneTransaction started",
+		zap.String("transaction_id", idParam),
 	)
 
+	id, err := strconv.Atoi(idParam)
+	if err != nil {
+		c.logger.Error("controller", "CloneTransaction - invalid ID format", err,
+			zap.String("id_param", idParam),
+		)
+
+		respondJSON(ctx, http.StatusBadRequest, gin.H{
+			"error":   "Bad Request",
+			"message": "Invalid transaction ID",
+			"status":  http.StatusBadRequest,
+		})
+		return
+	}
+
+	var overrides models.UpdateTransactionRequest
+	if err := ctx.ShouldBindJSON(&overrides); err != nil && !errors.Is(err, io.EOF) {
+		c.logger.Error("controller", "CloneTransaction - JSON binding failed", err)
+
+		respondJSON(ctx, http.StatusBadRequest, gin.H{
+			"error":   "Bad Request",
+			"message": err.Error(),
+			"status":  http.StatusBadRequest,
+		})
+		return
+	}
+
 	start := time.Now()
-	transactions, err := c.service.GetTransactions(filters)
+	transaction, warnings, err := c.service.CloneTransaction(ctx.Request.Context(), id, &overrides)
 	duration := time.Since(start)
 
-	c.logger.Performance("GetTransactions service call", duration,
-		zap.Int("transaction_count", len(transactions)),
+	c.logger.Performance("CloneTransaction service call", duration,
+		zap.Int("source_id", id),
 		zap.Bool("success", err == nil),
 	)
 
 	if err != nil {
-		c.logger.Error("controller", "GetTransactions - service error", err,
-			zap.Any("filters", filters),
+		c.logger.Error("controller", "CloneTransaction - service error", err,
+			zap.Int("source_id", id),
 		)
-		
-		ctx.JSON(http.StatusInternalServerError, gin.H{
-			"error":   "Internal Server Error",
-			"message": "Failed to retrieve transactions",
-			"status":  http.StatusInternalServerError,
+
+		if errors.Is(err, models.ErrTransactionNotFound) {
+			respondJSON(ctx, http.StatusNotFound, gin.H{
+				"error":   "Not Found",
+				"message": "Transaction not found",
+				"status":  http.StatusNotFound,
+			})
+			return
+		}
+
+		if respondIfMonthClosed(ctx, err) {
+			return
+		}
+
+		if errors.Is(err, models.ErrStorageFull) {
+			respondJSON(ctx, http.StatusInsufficientStorage, gin.H{
+				"error":   "Insufficient Storage",
+				"message": err.Error(),
+				"status":  http.StatusInsufficientStorage,
+			})
+			return
+		}
+
+		respondJSON(ctx, http.StatusBadRequest, gin.H{
+			"error":   "Bad Request",
+			"message": err.Error(),
+			"status":  http.StatusBadRequest,
 		})
 		return
 	}
 
-	c.logger.Controller("GetTransactions completed successfully",
-		zap.Int("transaction_count", len(transactions)),
+	c.logger.Controller("CloneTransaction completed successfully",
+		zap.Int("source_id", id),
+		zap.Int("transaction_id", transaction.ID),
 		zap.Duration("total_duration", duration),
 	)
 
-	ctx.JSON(http.StatusOK, transactions)
+	ctx.Header("Location", transactionLocation(ctx, transaction.ID))
+	respondJSON(ctx, http.StatusCreated, writeResponse(ctx, transaction, warnings))
 }
 
-func (c *TransactionController) GetTransaction(ctx *gin.Context) {
+func (c *TransactionController) GetTransactionHistory(ctx *gin.Context) {
 	idParam := ctx.Param("id")
-	
-	c.logger.Controller("GetTransaction started",
+
+	c.logger.Controller("GetTransactionHistory started",
 		zap.String("transaction_id", idParam),
 	)
 
 	id, err := strconv.Atoi(idParam)
 	if err != nil {
-		c.logger.Error("controller", "GetTransaction - invalid ID format", err,
+		c.logger.Error("controller", "GetTransactionHistory - invalid ID format", err,
 			zap.String("id_param", idParam),
 		)
-		
-		ctx.JSON(http.StatusBadRequest, gin.H{
+
+		respondJSON(ctx, http.StatusBadRequest, gin.H{
 			"error":   "Bad Request",
 			"message": "Invalid transaction ID",
 			"status":  http.StatusBadRequest,
@@ -144,33 +1160,44 @@ func (c *TransactionController) GetTransaction(ctx *gin.Context) {
 	}
 
 	start := time.Now()
-	transaction, err := c.service.GetTransaction(id)
+	history, err := c.service.GetTransactionHistory(ctx.Request.Context(), id)
 	duration := time.Since(start)
 
-	c.logger.Performance("GetTransaction service call", duration,
+	c.logger.Performance("GetTransactionHistory service call", duration,
 		zap.Int("transaction_id", id),
+		zap.Int("entry_count", len(history)),
 		zap.Bool("success", err == nil),
 	)
 
 	if err != nil {
-		c.logger.Error("controller", "GetTransaction - service error", err,
+		c.logger.Error("controller", "GetTransactionHistory - service error", err,
 			zap.Int("transaction_id", id),
 		)
-		
-		ctx.JSON(http.StatusNotFound, gin.H{
-			"error":   "Not Found",
-			"message": "Transaction not found",
-			"status":  http.StatusNotFound,
+
+		if errors.Is(err, models.ErrTransactionNotFound) {
+			respondJSON(ctx, http.StatusNotFound, gin.H{
+				"error":   "Not Found",
+				"message": "Transaction not found",
+				"status":  http.StatusNotFound,
+			})
+			return
+		}
+
+		respondJSON(ctx, http.StatusBadRequest, gin.H{
+			"error":   "Bad Request",
+			"message": err.Error(),
+			"status":  http.StatusBadRequest,
 		})
 		return
 	}
 
-	c.logger.Controller("GetTransaction completed successfully",
+	c.logger.Controller("GetTransactionHistory completed successfully",
 		zap.Int("transaction_id", id),
+		zap.Int("entry_count", len(history)),
 		zap.Duration("total_duration", duration),
 	)
 
-	ctx.JSON(http.StatusOK, transaction)
+	respondJSON(ctx, http.StatusOK, history)
 }
 
 func (c *TransactionController) DeleteTransaction(ctx *gin.Context) {
@@ -186,7 +1213,7 @@ func (c *TransactionController) DeleteTransaction(ctx *gin.Context) {
 			zap.String("id_param", idParam),
 		)
 		
-		ctx.JSON(http.StatusBadRequest, gin.H{
+		respondJSON(ctx, http.StatusBadRequest, gin.H{
 			"error":   "Bad Request",
 			"message": "Invalid transaction ID",
 			"status":  http.StatusBadRequest,
@@ -195,7 +1222,7 @@ func (c *TransactionController) DeleteTransaction(ctx *gin.Context) {
 	}
 
 	start := time.Now()
-	err = c.service.DeleteTransaction(id)
+	err = c.service.DeleteTransaction(ctx.Request.Context(), id)
 	duration := time.Since(start)
 
 	c.logger.Performance("DeleteTransaction service call", duration,
@@ -207,8 +1234,12 @@ func (c *TransactionController) DeleteTransaction(ctx *gin.Context) {
 		c.logger.Error("controller", "DeleteTransaction - service error", err,
 			zap.Int("transaction_id", id),
 		)
-		
-		ctx.JSON(http.StatusNotFound, gin.H{
+
+		if respondIfMonthClosed(ctx, err) {
+			return
+		}
+
+		respondJSON(ctx, http.StatusNotFound, gin.H{
 			"error":   "Not Found",
 			"message": "Transaction not found",
 			"status":  http.StatusNotFound,
@@ -221,7 +1252,7 @@ func (c *TransactionController) DeleteTransaction(ctx *gin.Context) {
 		zap.Duration("total_duration", duration),
 	)
 
-	ctx.JSON(http.StatusOK, gin.H{
+	respondJSON(ctx, http.StatusOK, gin.H{
 		"message": "Transaction deleted successfully",
 	})
 }
@@ -239,7 +1270,7 @@ func (c *TransactionController) UpdateTransaction(ctx *gin.Context) {
 			zap.String("id_param", idParam),
 		)
 		
-		ctx.JSON(http.StatusBadRequest, gin.H{
+		respondJSON(ctx, http.StatusBadRequest, gin.H{
 			"error":   "Bad Request",
 			"message": "Invalid transaction ID",
 			"status":  http.StatusBadRequest,
@@ -254,7 +1285,7 @@ func (c *TransactionController) UpdateTransaction(ctx *gin.Context) {
 			zap.Any("request_body", req),
 		)
 		
-		ctx.JSON(http.StatusBadRequest, gin.H{
+		respondJSON(ctx, http.StatusBadRequest, gin.H{
 			"error":   "Bad Request",
 			"message": err.Error(),
 			"status":  http.StatusBadRequest,
@@ -267,8 +1298,16 @@ func (c *TransactionController) UpdateTransaction(ctx *gin.Context) {
 		zap.Any("update_request", req),
 	)
 
+	dryRun := isDryRun(ctx)
+
 	start := time.Now()
-	transaction, err := c.service.UpdateTransaction(id, &req)
+	var transaction *models.Transaction
+	var warnings []models.Warning
+	if dryRun {
+		transaction, warnings, err = c.service.PreviewUpdateTransaction(ctx.Request.Context(), id, &req)
+	} else {
+		transaction, warnings, err = c.service.UpdateTransactionWithWarnings(ctx.Request.Context(), id, &req)
+	}
 	duration := time.Since(start)
 
 	c.logger.Performance("UpdateTransaction service call", duration,
@@ -281,36 +1320,370 @@ func (c *TransactionController) UpdateTransaction(ctx *gin.Context) {
 			zap.Int("transaction_id", id),
 			zap.Any("request", req),
 		)
-		
-		ctx.JSON(http.StatusNotFound, gin.H{
-			"error":   "Not Found",
-			"message": "Transaction not found",
-			"status":  http.StatusNotFound,
+
+		if errors.Is(err, models.ErrTransactionNotFound) {
+			respondJSON(ctx, http.StatusNotFound, gin.H{
+				"error":   "Not Found",
+				"message": "Transaction not found",
+				"status":  http.StatusNotFound,
+			})
+			return
+		}
+
+		if respondIfMonthClosed(ctx, err) {
+			return
+		}
+
+		respondJSON(ctx, http.StatusBadRequest, gin.H{
+			"error":   "Bad Request",
+			"message": err.Error(),
+			"status":  http.StatusBadRequest,
 		})
 		return
 	}
 
 	c.logger.Controller("UpdateTransaction completed successfully",
 		zap.Int("transaction_id", id),
+		zap.Bool("dry_run", dryRun),
+		zap.Int("warnings", len(warnings)),
+		zap.Duration("total_duration", duration),
+	)
+
+	respondJSON(ctx, http.StatusOK, writeResponse(ctx, transaction, warnings))
+}
+
+// BulkUpdateTransactions applies a list of {id, ...changes} edits one by one through the
+// update service. It is best effort: an edit that fails does not stop the others, and
+// each outcome is reported in request order with the status a single update would get.
+func (c *TransactionController) BulkUpdateTransactions(ctx *gin.Context) {
+	c.logger.Controller("BulkUpdateTransactions started",
+		zap.String("client_ip", ctx.ClientIP()),
+	)
+
+	// Items are decoded one at a time so a malformed edit fails alone
+	var items []json.RawMessage
+	if err := json.NewDecoder(ctx.Request.Body).Decode(&items); err != nil {
+		c.logger.Error("controller", "BulkUpdateTransactions - JSON decoding failed", err)
+
+		respondJSON(ctx, http.StatusBadRequest, gin.H{
+			"error":   "Bad Request",
+			"message": "body must be a JSON array of {\"id\": ..., changes} objects",
+			"status":  http.StatusBadRequest,
+		})
+		return
+	}
+
+	if len(items) == 0 || len(items) > services.MaxBulkUpdateItems {
+		c.logger.Error("controller", "BulkUpdateTransactions - invalid item count", errors.New("item count out of range"),
+			zap.Int("item_count", len(items)),
+		)
+
+		respondJSON(ctx, http.StatusBadRequest, gin.H{
+			"error":   "Bad Request",
+			"message": fmt.Sprintf("between 1 and %d edits can be sent at once", services.MaxBulkUpdateItems),
+			"status":  http.StatusBadRequest,
+		})
+		return
+	}
+
+	start := time.Now()
+	result := models.BulkUpdateResult{Results: make([]models.BulkUpdateItemResult, 0, len(items))}
+	for _, raw := range items {
+		outcome := c.applyBulkUpdateItem(ctx, raw)
+		if outcome.Transaction != nil {
+			result.Updated++
+		} else {
+			result.Failed++
+		}
+		result.Results = append(result.Results, outcome)
+	}
+	duration := time.Since(start)
+
+	c.logger.Performance("BulkUpdateTransactions service calls", duration,
+		zap.Int("updated_count", result.Updated),
+		zap.Int("failed_count", result.Failed),
+	)
+
+	c.logger.Controller("BulkUpdateTransactions completed successfully",
+		zap.Int("updated_count", result.Updated),
+		zap.Int("failed_count", result.Failed),
+		zap.Duration("total_duration", duration),
+	)
+
+	respondJSON(ctx, http.StatusOK, result)
+}
+
+// applyBulkUpdateItem decodes and applies one bulk edit, mapping errors to the status
+// UpdateTransaction would answer with and keeping its warnings
+func (c *TransactionController) applyBulkUpdateItem(ctx *gin.Context, raw json.RawMessage) models.BulkUpdateItemResult {
+	var item models.BulkUpdateItem
+	if err := json.Unmarshal(raw, &item); err != nil {
+		return models.BulkUpdateItemResult{ID: item.ID, Status: http.StatusBadRequest, Error: err.Error()}
+	}
+	if item.ID <= 0 {
+		return models.BulkUpdateItemResult{Status: http.StatusBadRequest, Error: "id is required"}
+	}
+
+	transaction, warnings, err := c.service.UpdateTransactionWithWarnings(ctx.Request.Context(), item.ID, &item.Changes)
+	if err != nil {
+		c.logger.Error("controller", "BulkUpdateTransactions - item failed", err,
+			zap.Int("transaction_id", item.ID),
+		)
+
+		status := http.StatusBadRequest
+		switch {
+		case errors.Is(err, models.ErrTransactionNotFound):
+			status = http.StatusNotFound
+		case errors.Is(err, models.ErrMonthClosed):
+			status = http.StatusLocked
+		}
+		return models.BulkUpdateItemResult{ID: item.ID, Status: status, Error: err.Error()}
+	}
+
+	return models.BulkUpdateItemResult{ID: item.ID, Status: http.StatusOK, Transaction: transaction, Warnings: warnings}
+}
+
+// writeResponse is the body for create and update responses. With ?amount_as_string=true
+// the amounts are strings with their currency's decimals instead of numbers.
+func writeResponse(ctx *gin.Context, transaction *models.Transaction, warnings []models.Warning) interface{} {
+	response := models.TransactionWithWarnings{Transaction: *transaction, Warnings: warnings}
+	if asString, _ := strconv.ParseBool(ctx.Query("amount_as_string")); asString {
+		return models.TransactionAmountsAsStrings(response)
+	}
+	return response
+}
+
+// transactionLocation is the Location of a transaction created through the current
+// route: the transactions collection the route is under, whatever its prefix, plus id
+func transactionLocation(ctx *gin.Context, id int) string {
+	collection, _, _ := strings.Cut(ctx.FullPath(), "/:id")
+	return fmt.Sprintf("%s/%d", collection, id)
+}
+
+// respondIfMonthClosed answers 423 Locked when err reports a closed month
+func respondIfMonthClosed(ctx *gin.Context, err error) bool {
+	if !errors.Is(err, models.ErrMonthClosed) {
+		return false
+	}
+
+	respondJSON(ctx, http.StatusLocked, gin.H{
+		"error":   "Locked",
+		"message": err.Error(),
+		"status":  http.StatusLocked,
+	})
+	return true
+}
+
+// applyPagination reads limit and offset into filters. A limit above MaxPageSize is
+// clamped and the X-Limit-Clamped header tells the client what was requested.
+// Omitting limit applies DefaultPageSize when one is configured; otherwise, or with
+// an explicit limit=0, every matching transaction is returned.
+func (c *TransactionController) applyPagination(ctx *gin.Context, filters *models.TransactionFilters) error {
+	if limitParam := ctx.Query("limit"); limitParam == "" && c.config.DefaultPageSize > 0 {
+		limit := c.config.DefaultPageSize
+		if c.config.MaxPageSize > 0 && limit > c.config.MaxPageSize {
+			limit = c.config.MaxPageSize
+		}
+		filters.Limit = limit
+		ctx.Header("X-Limit", strconv.Itoa(limit))
+	} else if limitParam != "" {
+		limit, err := strconv.Atoi(limitParam)
+		if err != nil || limit < 0 {
+			return errors.New("limit must be a non-negative integer")
+		}
+
+		if c.config.MaxPageSize > 0 && limit > c.config.MaxPageSize {
+			c.logger.Controller("GetTransactions - limit clamped",
+				zap.Int("requested_limit", limit),
+				zap.Int("max_page_size", c.config.MaxPageSize),
+			)
+			ctx.Header("X-Limit-Clamped", fmt.Sprintf("requested %d, max %d", limit, c.config.MaxPageSize))
+			limit = c.config.MaxPageSize
+		}
+
+		filters.Limit = limit
+		if limit > 0 {
+			ctx.Header("X-Limit", strconv.Itoa(limit))
+		}
+	}
+
+	if offsetParam := ctx.Query("offset"); offsetParam != "" {
+		offset, err := strconv.Atoi(offsetParam)
+		if err != nil || offset < 0 {
+			return errors.New("offset must be a non-negative integer")
+		}
+		filters.Offset = offset
+	}
+
+	return nil
+}
+
+// isDryRun reports whether the request asks to validate without persisting
+func isDryRun(ctx *gin.Context) bool {
+	dryRun, err := strconv.ParseBool(ctx.Query("dry_run"))
+	return err == nil && dryRun
+}
+
+// notModified reports whether the client's cached list is current. If-None-Match is
+// compared with the ETag, which tracks every write, and takes precedence over
+// If-Modified-Since when sent. HTTP dates only have second precision, so lastModified
+// is truncated before comparing; a later write within that same second is only caught
+// through the ETag.
+func (c *TransactionController) notModified(ctx *gin.Context, lastModified time.Time, etag string) bool {
+	if match := ctx.GetHeader("If-None-Match"); match != "" {
+		for _, candidate := range strings.Split(match, ",") {
+			candidate = strings.TrimPrefix(strings.TrimSpace(candidate), "W/")
+			if candidate == "*" || candidate == etag {
+				return true
+			}
+		}
+		return false
+	}
+
+	header := ctx.GetHeader("If-Modified-Since")
+	if header == "" {
+		return false
+	}
+
+	since, err := http.ParseTime(header)
+	if err != nil {
+		c.logger.Debug("controller", "Ignoring invalid If-Modified-Since header",
+			zap.String("if_modified_since", header),
+		)
+		return false
+	}
+
+	return !lastModified.Truncate(time.Second).After(since)
+}
+
+// getLedger responds with the filtered transactions oldest first, each carrying the
+// running balance
+func (c *TransactionController) getLedger(ctx *gin.Context, filters models.TransactionFilters) {
+	start := time.Now()
+	entries, err := c.service.GetLedger(ctx.Request.Context(), filters)
+	duration := time.Since(start)
+
+	c.logger.Performance("GetLedger service call", duration,
+		zap.Int("entry_count", len(entries)),
+		zap.Bool("success", err == nil),
+	)
+
+	if err != nil {
+		c.logger.Error("controller", "GetTransactions - ledger service error", err,
+			zap.Any("filters", filters),
+		)
+
+		respondJSON(ctx, http.StatusInternalServerError, gin.H{
+			"error":   "Internal Server Error",
+			"message": "Failed to retrieve transactions",
+			"status":  http.StatusInternalServerError,
+		})
+		return
+	}
+
+	c.logger.Controller("GetTransactions completed successfully",
+		zap.Int("transaction_count", len(entries)),
+		zap.Bool("running_balance", true),
 		zap.Duration("total_duration", duration),
 	)
 
-	ctx.JSON(http.StatusOK, transaction)
+	c.respondList(ctx, entries, models.ListMeta{
+		Count:  len(entries),
+		Limit:  filters.Limit,
+		Offset: filters.Offset,
+	})
+}
+
+// respondList writes a list as a bare array, or inside the data/meta envelope when
+// the controller is configured for it
+func (c *TransactionController) respondList(ctx *gin.Context, items interface{}, meta models.ListMeta) {
+	if c.config.Envelope {
+		respondJSON(ctx, http.StatusOK, models.ListResponse{Data: items, Meta: meta})
+		return
+	}
+	respondJSON(ctx, http.StatusOK, items)
+}
+
+// emptyListStatus returns the status for an empty list: the ?empty= override when
+// given, otherwise the configured default
+func (c *TransactionController) emptyListStatus(ctx *gin.Context) (int, error) {
+	switch ctx.Query("empty") {
+	case "":
+		if c.config.EmptyListStatus == http.StatusNoContent {
+			return http.StatusNoContent, nil
+		}
+		return http.StatusOK, nil
+	case "200":
+		return http.StatusOK, nil
+	case "204":
+		return http.StatusNoContent, nil
+	default:
+		return 0, errors.New("empty must be 200 or 204")
+	}
 }
 
-func (c *TransactionController) parseFilters(ctx *gin.Context) models.TransactionFilters {
+func (c *TransactionController) parseFilters(ctx *gin.Context) (models.TransactionFilters, error) {
 	filters := models.TransactionFilters{
-		Type:     ctx.Query("type"),
-		Category: ctx.Query("category"),
-		Currency: ctx.Query("currency"),
+		Type:              ctx.Query("type"),
+		Category:          ctx.Query("category"),
+		Currency:          ctx.Query("currency"),
+		Account:           ctx.Query("account"),
+		Status:            ctx.Query("status"),
+		ExcludeCategories: ctx.QueryArray("exclude_category"),
 	}
 
 	c.logger.Debug("controller", "Parsing query filters",
 		zap.String("type", filters.Type),
 		zap.String("category", filters.Category),
 		zap.String("currency", filters.Currency),
+		zap.String("account", filters.Account),
+		zap.String("status", filters.Status),
+		zap.Strings("exclude_categories", filters.ExcludeCategories),
 	)
 
+	// Metadata filters use one parameter per key, e.g. ?meta.source=bank_sync
+	for param, values := range ctx.Request.URL.Query() {
+		key, ok := strings.CutPrefix(param, "meta.")
+		if !ok || len(values) == 0 {
+			continue
+		}
+		if key == "" {
+			return filters, errors.New("metadata filters must name a key, e.g. meta.source=bank_sync")
+		}
+		if filters.Metadata == nil {
+			filters.Metadata = make(map[string]string)
+		}
+		filters.Metadata[key] = values[0]
+	}
+
+	if pinnedStr := ctx.Query("pinned"); pinnedStr != "" {
+		if pinned, err := strconv.ParseBool(pinnedStr); err == nil {
+			filters.Pinned = &pinned
+		} else {
+			c.logger.Error("controller", "Invalid pinned filter", err,
+				zap.String("pinned_str", pinnedStr),
+			)
+		}
+	}
+
+	// Amount filters carry an optional operator, e.g. ?amount=>1000
+	if amountStr := ctx.Query("amount"); amountStr != "" {
+		amount, err := models.ParseAmountFilter(amountStr)
+		if err != nil {
+			return filters, err
+		}
+		filters.Amount = amount
+	}
+
+	// updated_since is a full timestamp so polling clients can resume exactly where they stopped
+	if updatedSinceStr := ctx.Query("updated_since"); updatedSinceStr != "" {
+		updatedSince, err := time.Parse(time.RFC3339, updatedSinceStr)
+		if err != nil {
+			return filters, errors.New("updated_since must be an RFC3339 timestamp")
+		}
+		filters.UpdatedSince = &updatedSince
+	}
+
 	// Parse date filters if provided
 	if fromDateStr := ctx.Query("from_date"); fromDateStr != "" {
 		if fromDate, err := time.Parse("2006-01-02", fromDateStr); err == nil {
@@ -338,5 +1711,23 @@ func (c *TransactionController) parseFilters(ctx *gin.Context) models.Transactio
 		}
 	}
 
-	return filters
+	// month=YYYY-MM is a shortcut for that month's date range, in UTC like the reports
+	if monthStr := ctx.Query("month"); monthStr != "" {
+		if ctx.Query("from_date") != "" || ctx.Query("to_date") != "" {
+			return filters, errors.New("month cannot be combined with from_date or to_date")
+		}
+		fromDate, err := time.Parse("2006-01", monthStr)
+		if err != nil {
+			return filters, errors.New("month must be in YYYY-MM format, e.g. 2024-06")
+		}
+		toDate := fromDate.AddDate(0, 1, 0).Add(-time.Nanosecond)
+		filters.FromDate = &fromDate
+		filters.ToDate = &toDate
+		c.logger.Debug("controller", "Parsed month filter",
+			zap.Time("from_date", fromDate),
+			zap.Time("to_date", toDate),
+		)
+	}
+
+	return filters, nil
 }
\ No newline at end of file
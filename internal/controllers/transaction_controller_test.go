@@ -1,11 +1,21 @@
 package controllers_test
 
 import (
+	"context"
+	"encoding/csv"
 	"encoding/json"
+	"fmt"
 	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
 	"testing"
+	"time"
 
+	"github.com/gin-gonic/gin"
+	"github.com/maximicciullo/personal-finance-api/internal/controllers"
 	"github.com/maximicciullo/personal-finance-api/internal/models"
+	"github.com/maximicciullo/personal-finance-api/internal/services"
 	"github.com/maximicciullo/personal-finance-api/internal/test"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/suite"
@@ -48,6 +58,128 @@ func (suite *TransactionControllerTestSuite) TestCreateTransaction_Success() {
 	assert.Contains(suite.T(), response, "updated_at")
 }
 
+func (suite *TransactionControllerTestSuite) TestCreateTransaction_LocationHeader() {
+	// Given
+	request := models.CreateTransactionRequest{
+		Type:        "expense",
+		Amount:      1500,
+		Description: "Coffee",
+		Category:    "food",
+	}
+	suite.server.MakeRequest("POST", "/api/v1/transactions", request)
+
+	// When
+	w := suite.server.MakeRequest("POST", "/api/v1/transactions", request)
+
+	// Then
+	assert.Equal(suite.T(), http.StatusCreated, w.Code)
+
+	response := test.GetResponseJSON(suite.T(), w)
+	assert.Equal(suite.T(), float64(2), response["id"])
+	assert.Equal(suite.T(), "/api/v1/transactions/2", w.Header().Get("Location"))
+
+	follow := suite.server.MakeRequest("GET", w.Header().Get("Location"), nil)
+	assert.Equal(suite.T(), http.StatusOK, follow.Code)
+}
+
+func (suite *TransactionControllerTestSuite) TestCreateTransaction_OptionalFieldsOmittedWhenUnset() {
+	// Given
+	request := models.CreateTransactionRequest{
+		Type:        "expense",
+		Amount:      1500,
+		Description: "Coffee",
+		Category:    "food",
+	}
+
+	// When
+	w := suite.server.MakeRequest("POST", "/api/v1/transactions", request)
+
+	// Then
+	assert.Equal(suite.T(), http.StatusCreated, w.Code)
+
+	response := test.GetResponseJSON(suite.T(), w)
+	assert.NotContains(suite.T(), response, "account")
+	assert.NotContains(suite.T(), response, "pinned")
+
+	// Core fields are always present
+	for _, field := range []string{"id", "type", "amount", "currency", "description", "category", "date"} {
+		assert.Contains(suite.T(), response, field)
+	}
+
+	// Set optional fields appear
+	request.Account = "cash"
+	withAccount := test.GetResponseJSON(suite.T(), suite.server.MakeRequest("POST", "/api/v1/transactions", request))
+	assert.Equal(suite.T(), "cash", withAccount["account"])
+}
+
+func (suite *TransactionControllerTestSuite) TestCreateTransaction_AmountsHaveTwoDecimals() {
+	testCases := []struct {
+		amount   float64
+		expected string
+	}{
+		{15000, `"amount":15000.00`},
+		{1500.5, `"amount":1500.50`},
+		{99.99, `"amount":99.99`},
+	}
+
+	for _, tc := range testCases {
+		// Given
+		request := models.CreateTransactionRequest{
+			Type:        "expense",
+			Amount:      tc.amount,
+			Description: "Formatting",
+			Category:    "food",
+		}
+
+		// When
+		w := suite.server.MakeRequest("POST", "/api/v1/transactions", request)
+
+		// Then
+		assert.Equal(suite.T(), http.StatusCreated, w.Code)
+		assert.Contains(suite.T(), w.Body.String(), tc.expected)
+	}
+
+	// Report totals use the same format
+	report := suite.server.MakeRequest("GET", "/api/v1/reports/current-month", nil)
+	assert.Contains(suite.T(), report.Body.String(), `"total_expense":{"ARS":16600.49}`)
+}
+
+func (suite *TransactionControllerTestSuite) TestCreateTransaction_AmountAsString() {
+	testCases := []struct {
+		query    string
+		amount   float64
+		expected string
+	}{
+		{"", 15000, `"amount":15000.00`},
+		{"?amount_as_string=false", 1500.5, `"amount":1500.50`},
+		{"?amount_as_string=true", 15000, `"amount":"15000.00"`},
+		{"?amount_as_string=true", 1500.5, `"amount":"1500.50"`},
+	}
+
+	for _, tc := range testCases {
+		// Given
+		request := models.CreateTransactionRequest{
+			Type:        "expense",
+			Amount:      tc.amount,
+			Description: "Formatting",
+			Category:    "food",
+		}
+
+		// When
+		w := suite.server.MakeRequest("POST", "/api/v1/transactions"+tc.query, request)
+
+		// Then
+		assert.Equal(suite.T(), http.StatusCreated, w.Code, tc.query)
+		assert.Contains(suite.T(), w.Body.String(), tc.expected, tc.query)
+	}
+
+	// An unset base_amount is still omitted
+	plain := suite.server.MakeRequest("POST", "/api/v1/transactions?amount_as_string=true", models.CreateTransactionRequest{
+		Type: "expense", Amount: 1000, Description: "Plain", Category: "food",
+	})
+	assert.NotContains(suite.T(), test.GetResponseJSON(suite.T(), plain), "base_amount")
+}
+
 func (suite *TransactionControllerTestSuite) TestCreateTransaction_WithDate() {
 	// Given
 	date := "2024-06-19"
@@ -70,6 +202,65 @@ func (suite *TransactionControllerTestSuite) TestCreateTransaction_WithDate() {
 	assert.Contains(suite.T(), response["date"], "2024-06-19")
 }
 
+func (suite *TransactionControllerTestSuite) TestCreateTransaction_FarDateWarning() {
+	// Given
+	today := time.Now().Format("2006-01-02")
+	typo := time.Now().AddDate(-10, 0, 0).Format("2006-01-02")
+	request := models.CreateTransactionRequest{
+		Type:        "expense",
+		Amount:      1500,
+		Description: "Coffee",
+		Category:    "food",
+		Date:        &today,
+	}
+
+	// When
+	recent := suite.server.MakeRequest("POST", "/api/v1/transactions", request)
+	request.Date = &typo
+	far := suite.server.MakeRequest("POST", "/api/v1/transactions", request)
+
+	// Then - both are stored, only the far one carries a warning
+	assert.Equal(suite.T(), http.StatusCreated, recent.Code)
+	assert.NotContains(suite.T(), test.GetResponseJSON(suite.T(), recent), "warnings")
+
+	assert.Equal(suite.T(), http.StatusCreated, far.Code)
+	response := test.GetResponseJSON(suite.T(), far)
+	warnings, ok := response["warnings"].([]interface{})
+	suite.Require().True(ok, "expected a warnings array, got %v", response["warnings"])
+	suite.Require().Len(warnings, 1)
+	warning := warnings[0].(map[string]interface{})
+	assert.Equal(suite.T(), models.WarningFarDate, warning["code"])
+	assert.Contains(suite.T(), warning["message"], typo)
+	assert.Contains(suite.T(), response["date"], typo)
+}
+
+func (suite *TransactionControllerTestSuite) TestUpdateTransaction_WarningsOnlyWhenPresent() {
+	// Given
+	request := models.CreateTransactionRequest{
+		Type:        "expense",
+		Amount:      1500,
+		Description: "Coffee",
+		Category:    "food",
+	}
+	suite.server.MakeRequest("POST", "/api/v1/transactions", request)
+	newAmount := 1800.0
+	typo := time.Now().AddDate(10, 0, 0).Format("2006-01-02")
+
+	// When
+	amountOnly := suite.server.MakeRequest("PUT", "/api/v1/transactions/1", models.UpdateTransactionRequest{Amount: &newAmount})
+	preview := suite.server.MakeRequest("PUT", "/api/v1/transactions/1?dry_run=true", models.UpdateTransactionRequest{Date: &typo})
+	farDate := suite.server.MakeRequest("PUT", "/api/v1/transactions/1", models.UpdateTransactionRequest{Date: &typo})
+
+	// Then - only the far date carries warnings, previewed or stored
+	assert.Equal(suite.T(), http.StatusOK, amountOnly.Code)
+	assert.NotContains(suite.T(), test.GetResponseJSON(suite.T(), amountOnly), "warnings")
+
+	assert.Equal(suite.T(), http.StatusOK, preview.Code)
+	assert.Contains(suite.T(), preview.Body.String(), `"code":"far_date"`)
+	assert.Equal(suite.T(), http.StatusOK, farDate.Code)
+	assert.Contains(suite.T(), farDate.Body.String(), `"code":"far_date"`)
+}
+
 func (suite *TransactionControllerTestSuite) TestCreateTransaction_DefaultCurrency() {
 	// Given
 	request := models.CreateTransactionRequest{
@@ -188,7 +379,149 @@ func (suite *TransactionControllerTestSuite) TestCreateTransaction_InvalidDate()
 	assert.Contains(suite.T(), response["message"], "date format")
 }
 
+// Test dry-run mode
+func (suite *TransactionControllerTestSuite) TestCreateTransaction_DryRun() {
+	// Given
+	request := models.CreateTransactionRequest{
+		Type:        "expense",
+		Amount:      1500,
+		Currency:    "ARS",
+		Description: "Coffee",
+		Category:    "food",
+	}
+
+	// When
+	w := suite.server.MakeRequest("POST", "/api/v1/transactions?dry_run=true", request)
+
+	// Then
+	assert.Equal(suite.T(), http.StatusOK, w.Code)
+
+	response := test.GetResponseJSON(suite.T(), w)
+	assert.Equal(suite.T(), float64(0), response["id"])
+	assert.Equal(suite.T(), float64(1500), response["amount"])
+	assert.Equal(suite.T(), "food", response["category"])
+
+	// Nothing was stored
+	list := suite.server.MakeRequest("GET", "/api/v1/transactions", nil)
+	var transactions []interface{}
+	json.Unmarshal(list.Body.Bytes(), &transactions)
+	assert.Empty(suite.T(), transactions)
+}
+
+func (suite *TransactionControllerTestSuite) TestCreateTransaction_DryRunValidationError() {
+	// Given
+	request := models.CreateTransactionRequest{
+		Type:        "expense",
+		Amount:      1500,
+		Currency:    "DOLLARS",
+		Description: "Coffee",
+		Category:    "food",
+	}
+
+	// When
+	w := suite.server.MakeRequest("POST", "/api/v1/transactions?dry_run=true", request)
+
+	// Then
+	assert.Equal(suite.T(), http.StatusBadRequest, w.Code)
+
+	response := test.GetResponseJSON(suite.T(), w)
+	assert.Equal(suite.T(), "Bad Request", response["error"])
+	assert.Contains(suite.T(), response["message"], "currency")
+}
+
+func (suite *TransactionControllerTestSuite) TestUpdateTransaction_DryRun() {
+	// Given
+	request := models.CreateTransactionRequest{Type: "expense", Amount: 100, Description: "Coffee", Category: "food"}
+	suite.server.MakeRequest("POST", "/api/v1/transactions", request)
+
+	// When
+	w := suite.server.MakeRequest("PATCH", "/api/v1/transactions/1?dry_run=true", map[string]interface{}{"amount": 250})
+
+	// Then
+	assert.Equal(suite.T(), http.StatusOK, w.Code)
+	response := test.GetResponseJSON(suite.T(), w)
+	assert.Equal(suite.T(), float64(250), response["amount"])
+
+	// Stored transaction is unchanged
+	stored := test.GetResponseJSON(suite.T(), suite.server.MakeRequest("GET", "/api/v1/transactions/1", nil))
+	assert.Equal(suite.T(), float64(100), stored["amount"])
+}
+
+func (suite *TransactionControllerTestSuite) TestUpdateTransaction_DryRunValidationError() {
+	// Given
+	request := models.CreateTransactionRequest{Type: "expense", Amount: 100, Description: "Coffee", Category: "food"}
+	suite.server.MakeRequest("POST", "/api/v1/transactions", request)
+
+	// When
+	w := suite.server.MakeRequest("PUT", "/api/v1/transactions/1?dry_run=true", map[string]interface{}{"category": ""})
+
+	// Then
+	assert.Equal(suite.T(), http.StatusBadRequest, w.Code)
+	response := test.GetResponseJSON(suite.T(), w)
+	assert.Contains(suite.T(), response["message"], "category cannot be empty")
+}
+
 // Test GetTransactions
+const importOFX = `<OFX>
+<STMTRS>
+<CURDEF>USD
+<BANKTRANLIST>
+<STMTTRN><TRNTYPE>DEBIT<DTPOSTED>20240210<TRNAMT>-42.50<MEMO>Bookstore</STMTTRN>
+<STMTTRN><TRNTYPE>CREDIT<DTPOSTED>20240201<TRNAMT>1000<NAME>Payroll</STMTTRN>
+<STMTTRN><TRNTYPE>DEBIT<DTPOSTED>bad<TRNAMT>-1<MEMO>Broken</STMTTRN>
+</BANKTRANLIST>
+</STMTRS>
+</OFX>`
+
+func (suite *TransactionControllerTestSuite) TestImportTransactions_OFX() {
+	// When
+	w := suite.server.MakeRawRequest("POST", "/api/v1/transactions/import?format=ofx", "application/x-ofx", importOFX)
+
+	// Then
+	assert.Equal(suite.T(), http.StatusCreated, w.Code)
+
+	var result models.ImportResult
+	assert.NoError(suite.T(), json.Unmarshal(w.Body.Bytes(), &result))
+	assert.Len(suite.T(), result.Imported, 2)
+	assert.Equal(suite.T(), []models.ImportError{{Record: 3, Message: `invalid DTPOSTED "bad"`}}, result.Errors)
+
+	assert.Equal(suite.T(), "expense", result.Imported[0].Type)
+	assert.Equal(suite.T(), models.Money(42.50), result.Imported[0].Amount)
+	assert.Equal(suite.T(), "Bookstore", result.Imported[0].Description)
+	assert.Equal(suite.T(), "uncategorized", result.Imported[0].Category)
+	assert.Equal(suite.T(), "income", result.Imported[1].Type)
+
+	// The imported transactions are stored
+	list := suite.server.MakeRequest("GET", "/api/v1/transactions?currency=USD", nil)
+	var stored []models.Transaction
+	assert.NoError(suite.T(), json.Unmarshal(list.Body.Bytes(), &stored))
+	assert.Len(suite.T(), stored, 2)
+}
+
+func (suite *TransactionControllerTestSuite) TestImportTransactions_InvalidRequests() {
+	testCases := []struct {
+		name string
+		url  string
+		body string
+	}{
+		{"missing format", "/api/v1/transactions/import", importOFX},
+		{"unsupported format", "/api/v1/transactions/import?format=qif", importOFX},
+		{"malformed file", "/api/v1/transactions/import?format=ofx", "not an ofx file"},
+		{"every record invalid", "/api/v1/transactions/import?format=ofx",
+			"<OFX><BANKTRANLIST><STMTTRN><DTPOSTED>20240101<TRNAMT>0<MEMO>Zero</STMTTRN></BANKTRANLIST></OFX>"},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			// When
+			w := suite.server.MakeRawRequest("POST", tc.url, "application/x-ofx", tc.body)
+
+			// Then
+			assert.Equal(suite.T(), http.StatusBadRequest, w.Code)
+		})
+	}
+}
+
 func (suite *TransactionControllerTestSuite) TestGetTransactions_EmptyList() {
 	// When
 	w := suite.server.MakeRequest("GET", "/api/v1/transactions", nil)
@@ -202,6 +535,92 @@ func (suite *TransactionControllerTestSuite) TestGetTransactions_EmptyList() {
 	assert.Empty(suite.T(), transactions)
 }
 
+func (suite *TransactionControllerTestSuite) TestGetTransactions_EmptyListNoContentOverride() {
+	// When
+	w := suite.server.MakeRequest("GET", "/api/v1/transactions?empty=204", nil)
+
+	// Then
+	assert.Equal(suite.T(), http.StatusNoContent, w.Code)
+	assert.Empty(suite.T(), w.Body.String())
+
+	// Non-empty results are unaffected
+	suite.server.MakeRequest("POST", "/api/v1/transactions", models.CreateTransactionRequest{
+		Type: "expense", Amount: 100, Description: "Coffee", Category: "food",
+	})
+	withData := suite.server.MakeRequest("GET", "/api/v1/transactions?empty=204", nil)
+	assert.Equal(suite.T(), http.StatusOK, withData.Code)
+
+	// Unknown values are rejected
+	invalid := suite.server.MakeRequest("GET", "/api/v1/transactions?empty=404", nil)
+	assert.Equal(suite.T(), http.StatusBadRequest, invalid.Code)
+}
+
+func (suite *TransactionControllerTestSuite) TestGetTransactions_DefaultPageSize() {
+	// Given
+	for i := 0; i < 5; i++ {
+		suite.server.MakeRequest("POST", "/api/v1/transactions", models.CreateTransactionRequest{
+			Type: "expense", Amount: 100, Description: "Item", Category: "food",
+		})
+	}
+	config := controllers.DefaultTransactionControllerConfig()
+	config.DefaultPageSize = 2
+	config.Envelope = true
+	controller := controllers.NewTransactionControllerWithConfig(suite.server.TransactionService, config)
+
+	router := gin.New()
+	router.GET("/api/v1/transactions", controller.GetTransactions)
+
+	list := func(query string) (*httptest.ResponseRecorder, models.ListMeta) {
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, httptest.NewRequest("GET", "/api/v1/transactions"+query, nil))
+		var response struct {
+			Meta models.ListMeta `json:"meta"`
+		}
+		suite.Require().NoError(json.Unmarshal(w.Body.Bytes(), &response))
+		return w, response.Meta
+	}
+
+	// When
+	defaulted, defaultedMeta := list("")
+	explicit, explicitMeta := list("?limit=3")
+	_, everythingMeta := list("?limit=0")
+
+	// Then
+	assert.Equal(suite.T(), "2", defaulted.Header().Get("X-Limit"))
+	assert.Equal(suite.T(), models.ListMeta{Count: 2, Limit: 2}, defaultedMeta)
+	assert.Equal(suite.T(), "3", explicit.Header().Get("X-Limit"))
+	assert.Equal(suite.T(), models.ListMeta{Count: 3, Limit: 3}, explicitMeta)
+	assert.Equal(suite.T(), models.ListMeta{Count: 5}, everythingMeta)
+
+	// Unset keeps returning every transaction
+	unset := suite.server.MakeRequest("GET", "/api/v1/transactions", nil)
+	var all []models.Transaction
+	assert.NoError(suite.T(), json.Unmarshal(unset.Body.Bytes(), &all))
+	assert.Len(suite.T(), all, 5)
+	assert.Empty(suite.T(), unset.Header().Get("X-Limit"))
+}
+
+func (suite *TransactionControllerTestSuite) TestGetTransactions_EmptyListNoContentConfigured() {
+	// Given
+	config := controllers.DefaultTransactionControllerConfig()
+	config.EmptyListStatus = http.StatusNoContent
+	controller := controllers.NewTransactionControllerWithConfig(suite.server.TransactionService, config)
+
+	router := gin.New()
+	router.GET("/api/v1/transactions", controller.GetTransactions)
+
+	// When
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, httptest.NewRequest("GET", "/api/v1/transactions", nil))
+	explicit := httptest.NewRecorder()
+	router.ServeHTTP(explicit, httptest.NewRequest("GET", "/api/v1/transactions?empty=200", nil))
+
+	// Then
+	assert.Equal(suite.T(), http.StatusNoContent, w.Code)
+	assert.Equal(suite.T(), http.StatusOK, explicit.Code)
+	assert.JSONEq(suite.T(), "[]", explicit.Body.String())
+}
+
 func (suite *TransactionControllerTestSuite) TestGetTransactions_WithData() {
 	// Given - create some transactions
 	transactions := []models.CreateTransactionRequest{
@@ -241,7 +660,7 @@ func (suite *TransactionControllerTestSuite) TestGetTransactions_WithFilters() {
 	// Given - create transactions with different types
 	transactions := []models.CreateTransactionRequest{
 		{Type: "expense", Amount: 100, Description: "Coffee", Category: "food", Currency: "ARS"},
-		{Type: "income", Amount: 1000, Description: "Salary", Category: "work", Currency: "ARS"},
+		{Type: "income", Amount: 1000, Description: "Salary", Category: "work", Currency: "ARS", Account: "bank"},
 		{Type: "expense", Amount: 50, Description: "Transport", Category: "transport", Currency: "USD"},
 	}
 
@@ -285,6 +704,12 @@ func (suite *TransactionControllerTestSuite) TestGetTransactions_WithFilters() {
 			expectedCount: 1,
 			expectedType:  "expense",
 		},
+		{
+			name:          "filter by account",
+			query:         "?account=bank",
+			expectedCount: 1,
+			expectedType:  "income",
+		},
 	}
 
 	for _, tc := range testCases {
@@ -308,80 +733,1057 @@ func (suite *TransactionControllerTestSuite) TestGetTransactions_WithFilters() {
 	}
 }
 
-// Test GetTransaction
-func (suite *TransactionControllerTestSuite) TestGetTransaction_Success() {
-	// Given - create a transaction
-	request := models.CreateTransactionRequest{
-		Type:        "expense",
-		Amount:      100,
-		Description: "Test",
-		Category:    "test",
-	}
+func (suite *TransactionControllerTestSuite) TestGetTransactions_LastModifiedHeader() {
+	// Given - nothing stored yet
+	empty := suite.server.MakeRequest("GET", "/api/v1/transactions", nil)
+	assert.Equal(suite.T(), http.StatusOK, empty.Code)
+	assert.Empty(suite.T(), empty.Header().Get("Last-Modified"))
 
-	createResponse := suite.server.MakeRequest("POST", "/api/v1/transactions", request)
-	assert.Equal(suite.T(), http.StatusCreated, createResponse.Code)
+	request := models.CreateTransactionRequest{Type: "expense", Amount: 100, Description: "Coffee", Category: "food"}
+	suite.server.MakeRequest("POST", "/api/v1/transactions", request)
 
 	// When
-	w := suite.server.MakeRequest("GET", "/api/v1/transactions/1", nil)
+	w := suite.server.MakeRequest("GET", "/api/v1/transactions", nil)
 
 	// Then
 	assert.Equal(suite.T(), http.StatusOK, w.Code)
-
-	response := test.GetResponseJSON(suite.T(), w)
-	assert.Equal(suite.T(), float64(1), response["id"])
-	assert.Equal(suite.T(), "expense", response["type"])
-	assert.Equal(suite.T(), float64(100), response["amount"])
+	lastModified, err := http.ParseTime(w.Header().Get("Last-Modified"))
+	assert.NoError(suite.T(), err)
+	assert.WithinDuration(suite.T(), time.Now(), lastModified, 2*time.Second)
 }
 
-func (suite *TransactionControllerTestSuite) TestGetTransaction_NotFound() {
-	// When
-	w := suite.server.MakeRequest("GET", "/api/v1/transactions/999", nil)
+func (suite *TransactionControllerTestSuite) TestGetTransactions_NotModified() {
+	// Given
+	request := models.CreateTransactionRequest{Type: "expense", Amount: 100, Description: "Coffee", Category: "food"}
+	suite.server.MakeRequest("POST", "/api/v1/transactions", request)
 
-	// Then
-	assert.Equal(suite.T(), http.StatusNotFound, w.Code)
+	first := suite.server.MakeRequest("GET", "/api/v1/transactions", nil)
+	etag := first.Header().Get("ETag")
+	assert.NotEmpty(suite.T(), etag)
 
-	response := test.GetResponseJSON(suite.T(), w)
-	assert.Equal(suite.T(), "Not Found", response["error"])
-	assert.Contains(suite.T(), response["message"], "not found")
+	// When - nothing changed since the previous poll
+	w := suite.server.MakeRequestWithHeaders("GET", "/api/v1/transactions", nil, map[string]string{
+		"If-None-Match":     etag,
+		"If-Modified-Since": first.Header().Get("Last-Modified"),
+	})
+
+	// Then
+	assert.Equal(suite.T(), http.StatusNotModified, w.Code)
+	assert.Empty(suite.T(), w.Body.String())
+	assert.Equal(suite.T(), etag, w.Header().Get("ETag"))
+	assert.Equal(suite.T(), "Accept", first.Header().Get("Vary"))
+	assert.Equal(suite.T(), "Accept", w.Header().Get("Vary"))
 }
 
-func (suite *TransactionControllerTestSuite) TestGetTransaction_InvalidID() {
-	// When
-	w := suite.server.MakeRequest("GET", "/api/v1/transactions/invalid", nil)
+func (suite *TransactionControllerTestSuite) TestGetTransactions_NotModifiedSinceCachedDate() {
+	// Given
+	request := models.CreateTransactionRequest{Type: "expense", Amount: 100, Description: "Coffee", Category: "food"}
+	suite.server.MakeRequest("POST", "/api/v1/transactions", request)
+
+	first := suite.server.MakeRequest("GET", "/api/v1/transactions", nil)
+	lastModified := first.Header().Get("Last-Modified")
+	assert.NotEmpty(suite.T(), lastModified)
+
+	// When - a client that only sends back its cached Last-Modified
+	w := suite.server.MakeRequestWithHeaders("GET", "/api/v1/transactions", nil, map[string]string{
+		"If-Modified-Since": lastModified,
+	})
 
 	// Then
-	assert.Equal(suite.T(), http.StatusBadRequest, w.Code)
+	assert.Equal(suite.T(), http.StatusNotModified, w.Code)
+	assert.Empty(suite.T(), w.Body.String())
+}
 
-	response := test.GetResponseJSON(suite.T(), w)
-	assert.Equal(suite.T(), "Bad Request", response["error"])
-	assert.Contains(suite.T(), response["message"], "Invalid transaction ID")
+func (suite *TransactionControllerTestSuite) TestGetTransactions_ModifiedInSameSecond() {
+	// Given - a poll, then a second write within the same second as the first
+	request := models.CreateTransactionRequest{Type: "expense", Amount: 100, Description: "Coffee", Category: "food"}
+	suite.server.MakeRequest("POST", "/api/v1/transactions", request)
+	first := suite.server.MakeRequest("GET", "/api/v1/transactions", nil)
+	time.Sleep(10 * time.Millisecond) // Ensure timestamp difference
+	suite.server.MakeRequest("POST", "/api/v1/transactions", request)
+
+	// When - a browser-style request sending both validators
+	byETag := suite.server.MakeRequestWithHeaders("GET", "/api/v1/transactions", nil, map[string]string{
+		"If-None-Match":     first.Header().Get("ETag"),
+		"If-Modified-Since": first.Header().Get("Last-Modified"),
+	})
+
+	// Then - the ETag catches the write the second-precision date cannot
+	assert.Equal(suite.T(), http.StatusOK, byETag.Code)
+	assert.NotEqual(suite.T(), first.Header().Get("ETag"), byETag.Header().Get("ETag"))
 }
 
-// Test DeleteTransaction
-func (suite *TransactionControllerTestSuite) TestDeleteTransaction_Success() {
-	// Given - create a transaction
-	request := models.CreateTransactionRequest{
-		Type:        "expense",
-		Amount:      100,
-		Description: "Test",
-		Category:    "test",
-	}
+func (suite *TransactionControllerTestSuite) TestGetTransactions_ModifiedAfterCreate() {
+	// Given
+	request := models.CreateTransactionRequest{Type: "expense", Amount: 100, Description: "Coffee", Category: "food"}
+	suite.server.MakeRequest("POST", "/api/v1/transactions", request)
 
-	createResponse := suite.server.MakeRequest("POST", "/api/v1/transactions", request)
-	assert.Equal(suite.T(), http.StatusCreated, createResponse.Code)
+	first := suite.server.MakeRequest("GET", "/api/v1/transactions", nil)
+	lastModified := first.Header().Get("Last-Modified")
+	parsed, _ := http.ParseTime(lastModified)
+
+	// HTTP dates have second precision, so wait for the next second before writing again
+	time.Sleep(time.Until(parsed.Add(time.Second)))
+	suite.server.MakeRequest("POST", "/api/v1/transactions", request)
 
 	// When
-	w := suite.server.MakeRequest("DELETE", "/api/v1/transactions/1", nil)
+	w := suite.server.MakeRequestWithHeaders("GET", "/api/v1/transactions", nil, map[string]string{
+		"If-Modified-Since": lastModified,
+	})
 
 	// Then
 	assert.Equal(suite.T(), http.StatusOK, w.Code)
 
-	response := test.GetResponseJSON(suite.T(), w)
-	assert.Contains(suite.T(), response["message"], "deleted successfully")
-
-	// Verify transaction is deleted
-	getResponse := suite.server.MakeRequest("GET", "/api/v1/transactions/1", nil)
-	assert.Equal(suite.T(), http.StatusNotFound, getResponse.Code)
+	var response []map[string]interface{}
+	err := json.Unmarshal(w.Body.Bytes(), &response)
+	assert.NoError(suite.T(), err)
+	assert.Len(suite.T(), response, 2)
+	assert.NotEqual(suite.T(), lastModified, w.Header().Get("Last-Modified"))
+}
+
+// Test GetTransaction
+func (suite *TransactionControllerTestSuite) seedTransactions(count int) {
+	for i := 0; i < count; i++ {
+		suite.server.TransactionRepo.Create(context.Background(), &models.Transaction{
+			Type:        "expense",
+			Amount:      10,
+			Currency:    "ARS",
+			Description: fmt.Sprintf("Purchase %d", i+1),
+			Category:    "food",
+			Date:        time.Now(),
+		})
+	}
+}
+
+func (suite *TransactionControllerTestSuite) TestGetTransactions_Pagination() {
+	// Given
+	maxPageSize := controllers.DefaultTransactionControllerConfig().MaxPageSize
+	suite.seedTransactions(maxPageSize + 10)
+
+	testCases := []struct {
+		name          string
+		query         string
+		expectedCount int
+		expectedFirst int
+		clamped       bool
+	}{
+		{"within range", "limit=5&offset=10", 5, 11, false},
+		{"over cap is clamped", "limit=1000", maxPageSize, 1, true},
+		{"limit zero returns all", "limit=0", maxPageSize + 10, 1, false},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			// When
+			w := suite.server.MakeRequest("GET", "/api/v1/transactions?"+tc.query, nil)
+
+			// Then
+			assert.Equal(suite.T(), http.StatusOK, w.Code)
+
+			var response []models.Transaction
+			assert.NoError(suite.T(), json.Unmarshal(w.Body.Bytes(), &response))
+			assert.Len(suite.T(), response, tc.expectedCount)
+			assert.Equal(suite.T(), tc.expectedFirst, response[0].ID)
+
+			if tc.clamped {
+				assert.Equal(suite.T(), fmt.Sprintf("requested 1000, max %d", maxPageSize), w.Header().Get("X-Limit-Clamped"))
+				assert.Equal(suite.T(), fmt.Sprint(maxPageSize), w.Header().Get("X-Limit"))
+			} else {
+				assert.Empty(suite.T(), w.Header().Get("X-Limit-Clamped"))
+			}
+		})
+	}
+}
+
+func (suite *TransactionControllerTestSuite) TestGetTransactions_InvalidPagination() {
+	testCases := []string{"limit=abc", "limit=-1", "offset=-5", "offset=x"}
+
+	for _, query := range testCases {
+		suite.Run(query, func() {
+			// When
+			w := suite.server.MakeRequest("GET", "/api/v1/transactions?"+query, nil)
+
+			// Then
+			assert.Equal(suite.T(), http.StatusBadRequest, w.Code)
+		})
+	}
+}
+
+func (suite *TransactionControllerTestSuite) TestGetTransactions_InvertedDateRange() {
+	// When
+	w := suite.server.MakeRequest("GET", "/api/v1/transactions?from_date=2024-03-01&to_date=2024-02-01", nil)
+
+	// Then
+	assert.Equal(suite.T(), http.StatusBadRequest, w.Code)
+
+	response := test.GetResponseJSON(suite.T(), w)
+	assert.Equal(suite.T(), "from_date must be on or before to_date", response["message"])
+}
+
+func (suite *TransactionControllerTestSuite) TestGetTransactions_ValidDateRange() {
+	// Given
+	for _, date := range []string{"2024-01-15", "2024-02-10", "2024-03-20"} {
+		date := date
+		suite.server.MakeRequest("POST", "/api/v1/transactions", models.CreateTransactionRequest{
+			Type:        "expense",
+			Amount:      100,
+			Description: "Purchase " + date,
+			Category:    "food",
+			Date:        &date,
+		})
+	}
+
+	// When
+	w := suite.server.MakeRequest("GET", "/api/v1/transactions?from_date=2024-02-01&to_date=2024-02-29", nil)
+
+	// Then
+	assert.Equal(suite.T(), http.StatusOK, w.Code)
+
+	var response []map[string]interface{}
+	assert.NoError(suite.T(), json.Unmarshal(w.Body.Bytes(), &response))
+	assert.Len(suite.T(), response, 1)
+	assert.Equal(suite.T(), "Purchase 2024-02-10", response[0]["description"])
+
+	// A single-day range is valid
+	sameDay := suite.server.MakeRequest("GET", "/api/v1/transactions?from_date=2024-02-10&to_date=2024-02-10", nil)
+	assert.Equal(suite.T(), http.StatusOK, sameDay.Code)
+}
+
+func (suite *TransactionControllerTestSuite) TestGetTransactions_V1ArrayV2Envelope() {
+	// Given
+	for _, description := range []string{"Coffee", "Lunch"} {
+		suite.server.MakeRequest("POST", "/api/v1/transactions", models.CreateTransactionRequest{
+			Type: "expense", Amount: 100, Description: description, Category: "food",
+		})
+	}
+
+	// When
+	v1 := suite.server.MakeRequest("GET", "/api/v1/transactions?limit=10", nil)
+	v2 := suite.server.MakeRequest("GET", "/api/v2/transactions?limit=10", nil)
+
+	// Then
+	assert.Equal(suite.T(), http.StatusOK, v1.Code)
+	var array []models.Transaction
+	assert.NoError(suite.T(), json.Unmarshal(v1.Body.Bytes(), &array))
+	assert.Len(suite.T(), array, 2)
+
+	assert.Equal(suite.T(), http.StatusOK, v2.Code)
+	var envelope struct {
+		Data []models.Transaction `json:"data"`
+		Meta models.ListMeta      `json:"meta"`
+	}
+	assert.NoError(suite.T(), json.Unmarshal(v2.Body.Bytes(), &envelope))
+	assert.Equal(suite.T(), array, envelope.Data)
+	assert.Equal(suite.T(), models.ListMeta{Count: 2, Limit: 10, Offset: 0}, envelope.Meta)
+}
+
+func (suite *TransactionControllerTestSuite) TestGetRecentTransactions_V2MetaReportsEffectiveLimit() {
+	// Given
+	suite.seedTransactions(3)
+
+	testCases := []struct {
+		query string
+		limit int
+	}{
+		{"", services.DefaultRecentLimit},
+		{"?limit=2", 2},
+		{"?limit=5000", services.MaxRecentLimit},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.query, func() {
+			// When
+			w := suite.server.MakeRequest("GET", "/api/v2/transactions/recent"+tc.query, nil)
+
+			// Then
+			assert.Equal(suite.T(), http.StatusOK, w.Code)
+			var envelope struct {
+				Meta models.ListMeta `json:"meta"`
+			}
+			suite.Require().NoError(json.Unmarshal(w.Body.Bytes(), &envelope))
+			assert.Equal(suite.T(), tc.limit, envelope.Meta.Limit)
+		})
+	}
+}
+
+func (suite *TransactionControllerTestSuite) TestGetTransactions_V2EnvelopesEmptyList() {
+	// When
+	w := suite.server.MakeRequest("GET", "/api/v2/transactions?empty=204", nil)
+
+	// Then
+	assert.Equal(suite.T(), http.StatusOK, w.Code)
+	assert.JSONEq(suite.T(), `{"data":[],"meta":{"count":0,"offset":0}}`, w.Body.String())
+}
+
+func (suite *TransactionControllerTestSuite) TestGetTransactions_StatusFilter() {
+	// Given
+	suite.server.MakeRequest("POST", "/api/v1/transactions", models.CreateTransactionRequest{
+		Type: "expense", Amount: 100, Description: "Coffee", Category: "food",
+	})
+	suite.server.MakeRequest("POST", "/api/v1/transactions", models.CreateTransactionRequest{
+		Type: "expense", Amount: 250, Description: "Card hold", Category: "food", Status: "pending",
+	})
+
+	// When
+	pending := suite.server.MakeRequest("GET", "/api/v1/transactions?status=pending", nil)
+	cleared := suite.server.MakeRequest("GET", "/api/v1/transactions?status=cleared", nil)
+
+	// Then
+	var pendingList, clearedList []map[string]interface{}
+	assert.NoError(suite.T(), json.Unmarshal(pending.Body.Bytes(), &pendingList))
+	assert.NoError(suite.T(), json.Unmarshal(cleared.Body.Bytes(), &clearedList))
+
+	assert.Len(suite.T(), pendingList, 1)
+	assert.Equal(suite.T(), "Card hold", pendingList[0]["description"])
+	assert.Len(suite.T(), clearedList, 1)
+	assert.Equal(suite.T(), "cleared", clearedList[0]["status"])
+
+	// Unknown statuses are rejected on create
+	invalid := suite.server.MakeRequest("POST", "/api/v1/transactions", models.CreateTransactionRequest{
+		Type: "expense", Amount: 100, Description: "Coffee", Category: "food", Status: "settled",
+	})
+	assert.Equal(suite.T(), http.StatusBadRequest, invalid.Code)
+}
+
+func (suite *TransactionControllerTestSuite) TestGetTransactions_AmountOperators() {
+	// Given
+	for _, amount := range []float64{250, 500, 1000, 1500} {
+		suite.server.MakeRequest("POST", "/api/v1/transactions", models.CreateTransactionRequest{
+			Type: "expense", Amount: amount, Description: "Item", Category: "food",
+		})
+	}
+
+	testCases := []struct {
+		expr     string
+		expected []float64
+	}{
+		{">1000", []float64{1500}},
+		{">=1000", []float64{1000, 1500}},
+		{"<500", []float64{250}},
+		{"<=500", []float64{250, 500}},
+		{"=1000", []float64{1000}},
+		{"1000", []float64{1000}},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.expr, func() {
+			// When
+			w := suite.server.MakeRequest("GET", "/api/v1/transactions?amount="+url.QueryEscape(tc.expr), nil)
+
+			// Then
+			assert.Equal(suite.T(), http.StatusOK, w.Code)
+
+			var list []models.Transaction
+			assert.NoError(suite.T(), json.Unmarshal(w.Body.Bytes(), &list))
+			amounts := make([]float64, 0, len(list))
+			for _, tx := range list {
+				amounts = append(amounts, float64(tx.Amount))
+			}
+			assert.Equal(suite.T(), tc.expected, amounts)
+		})
+	}
+}
+
+func (suite *TransactionControllerTestSuite) TestGetTransactions_InvalidAmountFilter() {
+	for _, expr := range []string{">", "=>100", ">>100", "abc", "<=1e400", "<>5"} {
+		suite.Run(expr, func() {
+			// When
+			w := suite.server.MakeRequest("GET", "/api/v1/transactions?amount="+url.QueryEscape(expr), nil)
+
+			// Then
+			assert.Equal(suite.T(), http.StatusBadRequest, w.Code)
+			test.AssertJSONContains(suite.T(), w, map[string]interface{}{
+				"message": models.ErrInvalidAmountFilter.Error(),
+			})
+		})
+	}
+}
+
+func (suite *TransactionControllerTestSuite) TestAmountAcceptsNumericStrings() {
+	testCases := []struct {
+		name     string
+		amount   interface{}
+		status   int
+		expected models.Money
+	}{
+		{"number", 1500.5, http.StatusCreated, 1500.5},
+		{"numeric string", "1500.50", http.StatusCreated, 1500.5},
+		{"padded numeric string", " 42 ", http.StatusCreated, 42},
+		{"non-numeric string", "abc", http.StatusBadRequest, 0},
+		{"not a finite number", "NaN", http.StatusBadRequest, 0},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			// When
+			created := suite.server.MakeRequest("POST", "/api/v1/transactions", map[string]interface{}{
+				"type": "expense", "amount": tc.amount, "description": "Lunch", "category": "food",
+			})
+
+			// Then
+			assert.Equal(suite.T(), tc.status, created.Code)
+			if tc.status != http.StatusCreated {
+				test.AssertJSONContains(suite.T(), created, map[string]interface{}{
+					"message": models.ErrInvalidAmountInput.Error(),
+				})
+				return
+			}
+			var transaction models.Transaction
+			assert.NoError(suite.T(), json.Unmarshal(created.Body.Bytes(), &transaction))
+			assert.Equal(suite.T(), tc.expected, transaction.Amount)
+
+			// When - the same amount as an update
+			path := fmt.Sprintf("/api/v1/transactions/%d", transaction.ID)
+			updated := suite.server.MakeRequest("PATCH", path, map[string]interface{}{"amount": "99.90"})
+			invalid := suite.server.MakeRequest("PATCH", path, map[string]interface{}{"amount": "ninety"})
+
+			// Then
+			assert.Equal(suite.T(), http.StatusOK, updated.Code)
+			assert.NoError(suite.T(), json.Unmarshal(updated.Body.Bytes(), &transaction))
+			assert.Equal(suite.T(), models.Money(99.9), transaction.Amount)
+			assert.Equal(suite.T(), http.StatusBadRequest, invalid.Code)
+		})
+	}
+}
+
+func (suite *TransactionControllerTestSuite) TestGetTransactions_UpdatedSince() {
+	// Given - a client that last synced right after the first transaction
+	create := func(description string) models.Transaction {
+		w := suite.server.MakeRequest("POST", "/api/v1/transactions", models.CreateTransactionRequest{
+			Type: "expense", Amount: 100, Description: description, Category: "food",
+		})
+		suite.Require().Equal(http.StatusCreated, w.Code)
+		var transaction models.Transaction
+		suite.Require().NoError(json.Unmarshal(w.Body.Bytes(), &transaction))
+		return transaction
+	}
+	synced := create("Synced")
+	untouched := create("Untouched")
+	lastPoll := untouched.UpdatedAt
+
+	time.Sleep(time.Millisecond)
+	created := create("Created after poll")
+	time.Sleep(time.Millisecond)
+	updated := suite.server.MakeRequest("PATCH", fmt.Sprintf("/api/v1/transactions/%d", synced.ID), map[string]interface{}{"amount": 150})
+	suite.Require().Equal(http.StatusOK, updated.Code)
+
+	// When
+	w := suite.server.MakeRequest("GET", "/api/v1/transactions?updated_since="+url.QueryEscape(lastPoll.Format(time.RFC3339Nano)), nil)
+
+	// Then - the new and the updated transaction in the order they changed, after the
+	// one stored at lastPoll itself, which the client already has and dedupes
+	assert.Equal(suite.T(), http.StatusOK, w.Code)
+	var changes []models.Transaction
+	assert.NoError(suite.T(), json.Unmarshal(w.Body.Bytes(), &changes))
+	if assert.Len(suite.T(), changes, 3) {
+		assert.Equal(suite.T(), untouched.ID, changes[0].ID)
+		assert.Equal(suite.T(), created.ID, changes[1].ID)
+		assert.Equal(suite.T(), synced.ID, changes[2].ID)
+		assert.Equal(suite.T(), models.Money(150), changes[2].Amount)
+	}
+
+	invalid := suite.server.MakeRequest("GET", "/api/v1/transactions?updated_since=2024-06-01", nil)
+	assert.Equal(suite.T(), http.StatusBadRequest, invalid.Code)
+}
+
+func (suite *TransactionControllerTestSuite) TestRecategorizeTransactions() {
+	// Given
+	transactions := []models.CreateTransactionRequest{
+		{Type: "expense", Amount: 100, Description: "Imported 1", Category: "uncategorized", Date: stringPtr("2024-06-01")},
+		{Type: "expense", Amount: 200, Description: "Imported 2", Category: "uncategorized", Date: stringPtr("2024-07-01")},
+		{Type: "expense", Amount: 300, Description: "Lunch", Category: "food", Date: stringPtr("2024-06-01")},
+	}
+	for _, req := range transactions {
+		suite.server.MakeRequest("POST", "/api/v1/transactions", req)
+	}
+
+	// When
+	w := suite.server.MakeRequest("POST", "/api/v1/transactions/recategorize", map[string]interface{}{
+		"from":    "uncategorized",
+		"to":      "misc",
+		"filters": map[string]interface{}{"to_date": "2024-06-30"},
+	})
+
+	// Then
+	assert.Equal(suite.T(), http.StatusOK, w.Code)
+	test.AssertJSONContains(suite.T(), w, map[string]interface{}{
+		"from":    "uncategorized",
+		"to":      "misc",
+		"updated": float64(1),
+	})
+
+	list := suite.server.MakeRequest("GET", "/api/v1/transactions", nil)
+	var all []models.Transaction
+	assert.NoError(suite.T(), json.Unmarshal(list.Body.Bytes(), &all))
+	categories := map[string]string{}
+	for _, tx := range all {
+		categories[tx.Description] = tx.Category
+	}
+	assert.Equal(suite.T(), map[string]string{"Imported 1": "misc", "Imported 2": "uncategorized", "Lunch": "food"}, categories)
+
+	for _, body := range []map[string]interface{}{
+		{"from": "uncategorized"},
+		{"from": "food", "to": "food"},
+		{"from": "food", "to": "misc", "filters": map[string]interface{}{"type": "transfer"}},
+	} {
+		invalid := suite.server.MakeRequest("POST", "/api/v1/transactions/recategorize", body)
+		assert.Equal(suite.T(), http.StatusBadRequest, invalid.Code)
+	}
+}
+
+func (suite *TransactionControllerTestSuite) TestGetTransactions_RunningBalance() {
+	// Given - created out of date order, plus one in another currency
+	requests := []struct {
+		txType   string
+		amount   float64
+		currency string
+		date     string
+	}{
+		{"expense", 300, "ARS", "2024-03-10"},
+		{"income", 1000, "ARS", "2024-01-05"},
+		{"expense", 250, "ARS", "2024-02-15"},
+		{"income", 500, "USD", "2024-02-01"},
+	}
+	for _, r := range requests {
+		date := r.date
+		suite.server.MakeRequest("POST", "/api/v1/transactions", models.CreateTransactionRequest{
+			Type:        r.txType,
+			Amount:      r.amount,
+			Currency:    r.currency,
+			Description: "Entry " + r.date,
+			Category:    "misc",
+			Date:        &date,
+		})
+	}
+
+	// When
+	w := suite.server.MakeRequest("GET", "/api/v1/transactions?running_balance=true&currency=ARS", nil)
+
+	// Then
+	assert.Equal(suite.T(), http.StatusOK, w.Code)
+
+	var response []map[string]interface{}
+	assert.NoError(suite.T(), json.Unmarshal(w.Body.Bytes(), &response))
+	assert.Len(suite.T(), response, 3)
+
+	expected := []struct {
+		description string
+		balance     float64
+	}{
+		{"Entry 2024-01-05", 1000},
+		{"Entry 2024-02-15", 750},
+		{"Entry 2024-03-10", 450},
+	}
+	for i, e := range expected {
+		assert.Equal(suite.T(), e.description, response[i]["description"])
+		assert.Equal(suite.T(), e.balance, response[i]["running_balance"])
+	}
+
+	// Pagination slices the ledger without resetting the balance
+	paged := suite.server.MakeRequest("GET", "/api/v1/transactions?running_balance=true&currency=ARS&limit=1&offset=2", nil)
+	assert.Equal(suite.T(), http.StatusOK, paged.Code)
+
+	var page []map[string]interface{}
+	assert.NoError(suite.T(), json.Unmarshal(paged.Body.Bytes(), &page))
+	assert.Len(suite.T(), page, 1)
+	assert.Equal(suite.T(), float64(450), page[0]["running_balance"])
+}
+
+func (suite *TransactionControllerTestSuite) TestGetTransactions_RunningBalanceRequiresCurrency() {
+	// When
+	w := suite.server.MakeRequest("GET", "/api/v1/transactions?running_balance=true", nil)
+
+	// Then
+	assert.Equal(suite.T(), http.StatusBadRequest, w.Code)
+
+	response := test.GetResponseJSON(suite.T(), w)
+	assert.Equal(suite.T(), "running balance requires a currency filter", response["message"])
+
+	invalid := suite.server.MakeRequest("GET", "/api/v1/transactions?running_balance=maybe&currency=ARS", nil)
+	assert.Equal(suite.T(), http.StatusBadRequest, invalid.Code)
+}
+
+func (suite *TransactionControllerTestSuite) TestGetRecentTransactions_NewestFirst() {
+	// Given
+	dates := []string{"2024-01-10", "2024-03-05", "2024-02-20"}
+	for _, date := range dates {
+		date := date
+		req := models.CreateTransactionRequest{
+			Type:        "expense",
+			Amount:      100,
+			Description: "Purchase " + date,
+			Category:    "food",
+			Date:        &date,
+		}
+		suite.server.MakeRequest("POST", "/api/v1/transactions", req)
+	}
+
+	// When
+	w := suite.server.MakeRequest("GET", "/api/v1/transactions/recent?limit=2", nil)
+
+	// Then
+	assert.Equal(suite.T(), http.StatusOK, w.Code)
+
+	var response []models.Transaction
+	err := json.Unmarshal(w.Body.Bytes(), &response)
+	assert.NoError(suite.T(), err)
+	assert.Len(suite.T(), response, 2)
+	assert.Equal(suite.T(), "Purchase 2024-03-05", response[0].Description)
+	assert.Equal(suite.T(), "Purchase 2024-02-20", response[1].Description)
+}
+
+func (suite *TransactionControllerTestSuite) TestGetRecentTransactions_DefaultAndCappedLimit() {
+	// Given - more transactions than the cap
+	for i := 0; i < services.MaxRecentLimit+5; i++ {
+		req := models.CreateTransactionRequest{
+			Type:        "expense",
+			Amount:      10,
+			Description: "Purchase",
+			Category:    "food",
+		}
+		suite.server.MakeRequest("POST", "/api/v1/transactions", req)
+	}
+
+	// When
+	defaultResp := suite.server.MakeRequest("GET", "/api/v1/transactions/recent", nil)
+	cappedResp := suite.server.MakeRequest("GET", "/api/v1/transactions/recent?limit=1000", nil)
+
+	// Then
+	var defaultList, cappedList []models.Transaction
+	assert.NoError(suite.T(), json.Unmarshal(defaultResp.Body.Bytes(), &defaultList))
+	assert.NoError(suite.T(), json.Unmarshal(cappedResp.Body.Bytes(), &cappedList))
+	assert.Len(suite.T(), defaultList, services.DefaultRecentLimit)
+	assert.Len(suite.T(), cappedList, services.MaxRecentLimit)
+}
+
+func (suite *TransactionControllerTestSuite) TestGetRecentTransactions_InvalidLimit() {
+	testCases := []string{"abc", "0", "-3"}
+
+	for _, limit := range testCases {
+		suite.Run(limit, func() {
+			// When
+			w := suite.server.MakeRequest("GET", "/api/v1/transactions/recent?limit="+limit, nil)
+
+			// Then
+			assert.Equal(suite.T(), http.StatusBadRequest, w.Code)
+		})
+	}
+}
+
+func (suite *TransactionControllerTestSuite) TestGetTopExpenses_LargestFirst() {
+	// Given
+	transactions := []models.CreateTransactionRequest{
+		{Type: "expense", Amount: 1200, Currency: "ARS", Description: "Groceries", Category: "food", Date: stringPtr("2024-06-10")},
+		{Type: "expense", Amount: 9000, Currency: "ARS", Description: "Rent", Category: "rent", Date: stringPtr("2024-06-01")},
+		{Type: "expense", Amount: 300, Currency: "ARS", Description: "Coffee", Category: "food", Date: stringPtr("2024-06-12")},
+		{Type: "expense", Amount: 50000, Currency: "USD", Description: "Car", Category: "car", Date: stringPtr("2024-06-05")},
+		{Type: "income", Amount: 80000, Currency: "ARS", Description: "Salary", Category: "salary", Date: stringPtr("2024-06-01")},
+		{Type: "expense", Amount: 20000, Currency: "ARS", Description: "Last year", Category: "travel", Date: stringPtr("2023-12-20")},
+	}
+	for _, req := range transactions {
+		suite.server.MakeRequest("POST", "/api/v1/transactions", req)
+	}
+
+	// When
+	w := suite.server.MakeRequest("GET", "/api/v1/transactions/top-expenses?currency=ARS&limit=2&from=2024-01-01&to=2024-12-31", nil)
+
+	// Then
+	assert.Equal(suite.T(), http.StatusOK, w.Code)
+	var response []models.Transaction
+	suite.Require().NoError(json.Unmarshal(w.Body.Bytes(), &response))
+	if assert.Len(suite.T(), response, 2) {
+		assert.Equal(suite.T(), "Rent", response[0].Description)
+		assert.Equal(suite.T(), "Groceries", response[1].Description)
+	}
+}
+
+func (suite *TransactionControllerTestSuite) TestGetTopExpenses_ToCoversTheWholeDay() {
+	// Given - an expense recorded in the afternoon of the to date
+	afternoon := time.Date(2024, 6, 30, 15, 0, 0, 0, time.UTC)
+	suite.server.Seed([]models.Transaction{
+		{ID: 1, Type: "expense", Amount: 500, Currency: "ARS", Description: "Dinner", Category: "food", Date: afternoon, CreatedAt: afternoon, UpdatedAt: afternoon},
+	}, 2)
+
+	// When
+	w := suite.server.MakeRequest("GET", "/api/v1/transactions/top-expenses?currency=ARS&from=2024-06-01&to=2024-06-30", nil)
+
+	// Then
+	assert.Equal(suite.T(), http.StatusOK, w.Code)
+	var response []models.Transaction
+	suite.Require().NoError(json.Unmarshal(w.Body.Bytes(), &response))
+	if assert.Len(suite.T(), response, 1) {
+		assert.Equal(suite.T(), "Dinner", response[0].Description)
+	}
+}
+
+func (suite *TransactionControllerTestSuite) TestGetTopExpenses_InvalidParameters() {
+	testCases := []struct {
+		name    string
+		query   string
+		message string
+	}{
+		{"missing currency", "", models.ErrTopExpensesCurrency.Error()},
+		{"invalid limit", "?currency=ARS&limit=0", "limit must be a positive integer"},
+		{"invalid from", "?currency=ARS&from=June", "Invalid from date format, use YYYY-MM-DD"},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			// When
+			w := suite.server.MakeRequest("GET", "/api/v1/transactions/top-expenses"+tc.query, nil)
+
+			// Then
+			assert.Equal(suite.T(), http.StatusBadRequest, w.Code)
+			test.AssertJSONContains(suite.T(), w, map[string]interface{}{"message": tc.message})
+		})
+	}
+}
+
+func (suite *TransactionControllerTestSuite) TestGetTransactionsByDay_GroupsByDate() {
+	// Given
+	transactions := []models.CreateTransactionRequest{
+		{Type: "expense", Amount: 300, Description: "Lunch", Category: "food", Date: stringPtr("2024-06-03")},
+		{Type: "expense", Amount: 100, Description: "Coffee", Category: "food", Date: stringPtr("2024-06-03")},
+		{Type: "income", Amount: 5000, Description: "Salary", Category: "salary", Date: stringPtr("2024-06-30")},
+		{Type: "expense", Amount: 999, Description: "Next month", Category: "food", Date: stringPtr("2024-07-01")},
+	}
+	for _, req := range transactions {
+		suite.server.MakeRequest("POST", "/api/v1/transactions", req)
+	}
+
+	// When
+	w := suite.server.MakeRequest("GET", "/api/v1/transactions/by-day/2024/6", nil)
+
+	// Then - only days with transactions, each in creation order
+	assert.Equal(suite.T(), http.StatusOK, w.Code)
+	var days map[string][]models.Transaction
+	suite.Require().NoError(json.Unmarshal(w.Body.Bytes(), &days))
+	descriptions := make(map[string][]string)
+	for day, dayTransactions := range days {
+		for _, transaction := range dayTransactions {
+			descriptions[day] = append(descriptions[day], transaction.Description)
+		}
+	}
+	assert.Equal(suite.T(), map[string][]string{
+		"2024-06-03": {"Lunch", "Coffee"},
+		"2024-06-30": {"Salary"},
+	}, descriptions)
+}
+
+func (suite *TransactionControllerTestSuite) TestGetTransactionsByDay_EmptyAndInvalidMonth() {
+	// When
+	empty := suite.server.MakeRequest("GET", "/api/v1/transactions/by-day/2024/2", nil)
+	invalid := suite.server.MakeRequest("GET", "/api/v1/transactions/by-day/2024/13", nil)
+
+	// Then
+	assert.Equal(suite.T(), http.StatusOK, empty.Code)
+	assert.JSONEq(suite.T(), `{}`, empty.Body.String())
+	assert.Equal(suite.T(), http.StatusBadRequest, invalid.Code)
+}
+
+func (suite *TransactionControllerTestSuite) TestGetTransactionsByDay_IncludesLastSubSecondOfMonth() {
+	// Given - dated like a create that defaulted to the clock, half a second before July
+	date := time.Date(2024, 6, 30, 23, 59, 59, 500000000, time.UTC)
+	suite.server.Seed([]models.Transaction{
+		{ID: 1, Type: "expense", Amount: 100, Currency: "ARS", Description: "Late", Category: "food", Date: date, CreatedAt: date},
+	}, 2)
+
+	// When
+	w := suite.server.MakeRequest("GET", "/api/v1/transactions/by-day/2024/6", nil)
+
+	// Then
+	assert.Equal(suite.T(), http.StatusOK, w.Code)
+	var days map[string][]models.Transaction
+	suite.Require().NoError(json.Unmarshal(w.Body.Bytes(), &days))
+	if assert.Len(suite.T(), days["2024-06-30"], 1) {
+		assert.Equal(suite.T(), "Late", days["2024-06-30"][0].Description)
+	}
+}
+
+func (suite *TransactionControllerTestSuite) TestGetTransactions_PrettyJSON() {
+	// Given
+	req := models.CreateTransactionRequest{
+		Type:        "expense",
+		Amount:      100,
+		Description: "Coffee",
+		Category:    "food",
+	}
+	suite.server.MakeRequest("POST", "/api/v1/transactions", req)
+
+	// When
+	compact := suite.server.MakeRequest("GET", "/api/v1/transactions", nil)
+	pretty := suite.server.MakeRequest("GET", "/api/v1/transactions?pretty=true", nil)
+
+	// Then
+	assert.NotContains(suite.T(), compact.Body.String(), "\n")
+	assert.Contains(suite.T(), pretty.Body.String(), "\n        \"description\": \"Coffee\"")
+
+	var compactList, prettyList []models.Transaction
+	assert.NoError(suite.T(), json.Unmarshal(compact.Body.Bytes(), &compactList))
+	assert.NoError(suite.T(), json.Unmarshal(pretty.Body.Bytes(), &prettyList))
+	assert.Equal(suite.T(), compactList, prettyList)
+}
+
+func (suite *TransactionControllerTestSuite) TestGetTransactions_ContentNegotiation() {
+	// Given
+	date := "2024-06-15"
+	req := models.CreateTransactionRequest{
+		Type:        "expense",
+		Amount:      1500.5,
+		Currency:    "ARS",
+		Description: "Coffee, large",
+		Category:    "food",
+		Account:     "cash",
+		Date:        &date,
+	}
+	suite.server.MakeRequest("POST", "/api/v1/transactions", req)
+
+	suite.Run("text/csv returns CSV", func() {
+		// When
+		w := suite.server.MakeRequestWithHeaders("GET", "/api/v1/transactions", nil, map[string]string{"Accept": "text/csv"})
+
+		// Then
+		assert.Equal(suite.T(), http.StatusOK, w.Code)
+		assert.Equal(suite.T(), "text/csv; charset=utf-8", w.Header().Get("Content-Type"))
+
+		records, err := csv.NewReader(w.Body).ReadAll()
+		assert.NoError(suite.T(), err)
+		assert.Len(suite.T(), records, 2)
+		assert.Equal(suite.T(), []string{"id", "type", "amount", "currency", "description", "category", "account", "date", "created_at", "updated_at"}, records[0])
+		assert.Equal(suite.T(), []string{"1", "expense", "1500.5", "ARS", "Coffee, large", "food", "cash"}, records[1][:7])
+		assert.Equal(suite.T(), "2024-06-15T00:00:00Z", records[1][7])
+	})
+
+	suite.Run("application/json returns JSON", func() {
+		// When
+		w := suite.server.MakeRequestWithHeaders("GET", "/api/v1/transactions", nil, map[string]string{"Accept": "application/json"})
+
+		// Then
+		assert.Equal(suite.T(), http.StatusOK, w.Code)
+		assert.Equal(suite.T(), "application/json; charset=utf-8", w.Header().Get("Content-Type"))
+
+		var response []models.Transaction
+		assert.NoError(suite.T(), json.Unmarshal(w.Body.Bytes(), &response))
+		assert.Len(suite.T(), response, 1)
+	})
+
+	suite.Run("missing Accept defaults to JSON", func() {
+		// When
+		w := suite.server.MakeRequest("GET", "/api/v1/transactions", nil)
+
+		// Then
+		assert.Equal(suite.T(), "application/json; charset=utf-8", w.Header().Get("Content-Type"))
+		assert.Equal(suite.T(), "Accept", w.Header().Get("Vary"))
+	})
+}
+
+func (suite *TransactionControllerTestSuite) TestExportTransactions_StreamsCSV() {
+	// Given
+	suite.seedTransactions(250)
+
+	// When
+	w := suite.server.MakeRequest("GET", "/api/v1/transactions/export", nil)
+
+	// Then
+	assert.Equal(suite.T(), http.StatusOK, w.Code)
+	assert.Equal(suite.T(), "text/csv; charset=utf-8", w.Header().Get("Content-Type"))
+	assert.Contains(suite.T(), w.Header().Get("Content-Disposition"), "transactions.csv")
+
+	records, err := csv.NewReader(w.Body).ReadAll()
+	assert.NoError(suite.T(), err)
+	assert.Len(suite.T(), records, 251)
+	assert.Equal(suite.T(), "id", records[0][0])
+	assert.Equal(suite.T(), "Purchase 250", records[250][4])
+}
+
+func (suite *TransactionControllerTestSuite) TestExportTransactions_StreamsJSONLines() {
+	// Given
+	suite.seedTransactions(150)
+
+	// When
+	w := suite.server.MakeRequest("GET", "/api/v1/transactions/export?format=jsonl", nil)
+
+	// Then
+	assert.Equal(suite.T(), http.StatusOK, w.Code)
+	assert.Equal(suite.T(), "application/x-ndjson", w.Header().Get("Content-Type"))
+	assert.Contains(suite.T(), w.Header().Get("Content-Disposition"), "transactions.jsonl")
+
+	lines := strings.Split(strings.TrimSuffix(w.Body.String(), "\n"), "\n")
+	assert.Len(suite.T(), lines, 150)
+	for i, line := range lines {
+		var transaction models.Transaction
+		assert.NoError(suite.T(), json.Unmarshal([]byte(line), &transaction), "line %d", i+1)
+		assert.Equal(suite.T(), i+1, transaction.ID)
+	}
+}
+
+func (suite *TransactionControllerTestSuite) TestExportTransactions_OutlastsServerWriteTimeout() {
+	// Given - a server whose whole-response write deadline has passed before any row is sent
+	suite.seedTransactions(250)
+	config := controllers.DefaultTransactionControllerConfig()
+	config.ExportWriteTimeout = 5 * time.Second
+	controller := controllers.NewTransactionControllerWithConfig(suite.server.TransactionService, config)
+
+	router := gin.New()
+	router.GET("/export", controller.ExportTransactions)
+	server := httptest.NewUnstartedServer(router)
+	server.Config.WriteTimeout = time.Nanosecond
+	server.Start()
+	defer server.Close()
+
+	// When
+	resp, err := http.Get(server.URL + "/export")
+	suite.Require().NoError(err)
+	defer resp.Body.Close()
+	records, err := csv.NewReader(resp.Body).ReadAll()
+
+	// Then
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), http.StatusOK, resp.StatusCode)
+	assert.Len(suite.T(), records, 251)
+}
+
+func (suite *TransactionControllerTestSuite) TestExportTransactions_UnsupportedFormat() {
+	// When
+	w := suite.server.MakeRequest("GET", "/api/v1/transactions/export?format=xml", nil)
+
+	// Then
+	assert.Equal(suite.T(), http.StatusBadRequest, w.Code)
+}
+
+func (suite *TransactionControllerTestSuite) TestGetTransactionsBatch() {
+	// Given
+	for _, description := range []string{"Coffee", "Lunch", "Dinner"} {
+		req := models.CreateTransactionRequest{
+			Type:        "expense",
+			Amount:      100,
+			Description: description,
+			Category:    "food",
+		}
+		suite.server.MakeRequest("POST", "/api/v1/transactions", req)
+	}
+
+	testCases := []struct {
+		name            string
+		ids             string
+		expectedIDs     []int
+		expectedMissing []int
+	}{
+		{"all found", "1,2,3", []int{1, 2, 3}, []int{}},
+		{"some missing", "3,42,1,77", []int{3, 1}, []int{42, 77}},
+		{"duplicate ids", "2,2,1,2,42,42", []int{2, 1}, []int{42}},
+		{"duplicates of found ids are not missing", "1,1,2", []int{1, 2}, []int{}},
+		{"duplicate and out of order", "3,77,1,3,2,77,1", []int{3, 1, 2}, []int{77}},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			// When
+			w := suite.server.MakeRequest("GET", "/api/v1/transactions/batch?ids="+tc.ids, nil)
+
+			// Then
+			assert.Equal(suite.T(), http.StatusOK, w.Code)
+
+			var response models.BatchTransactionsResponse
+			assert.NoError(suite.T(), json.Unmarshal(w.Body.Bytes(), &response))
+
+			ids := make([]int, len(response.Transactions))
+			for i, transaction := range response.Transactions {
+				ids[i] = transaction.ID
+			}
+			assert.Equal(suite.T(), tc.expectedIDs, ids)
+			assert.Equal(suite.T(), tc.expectedMissing, response.MissingIDs)
+		})
+	}
+}
+
+func (suite *TransactionControllerTestSuite) TestGetTransactionsBatch_InvalidIDs() {
+	testCases := []string{"", "1,abc", ","}
+
+	for _, ids := range testCases {
+		suite.Run("ids="+ids, func() {
+			// When
+			w := suite.server.MakeRequest("GET", "/api/v1/transactions/batch?ids="+ids, nil)
+
+			// Then
+			assert.Equal(suite.T(), http.StatusBadRequest, w.Code)
+		})
+	}
+}
+
+func (suite *TransactionControllerTestSuite) TestGetTransaction_Success() {
+	// Given - create a transaction
+	request := models.CreateTransactionRequest{
+		Type:        "expense",
+		Amount:      100,
+		Description: "Test",
+		Category:    "test",
+	}
+
+	createResponse := suite.server.MakeRequest("POST", "/api/v1/transactions", request)
+	assert.Equal(suite.T(), http.StatusCreated, createResponse.Code)
+
+	// When
+	w := suite.server.MakeRequest("GET", "/api/v1/transactions/1", nil)
+
+	// Then
+	assert.Equal(suite.T(), http.StatusOK, w.Code)
+
+	response := test.GetResponseJSON(suite.T(), w)
+	assert.Equal(suite.T(), float64(1), response["id"])
+	assert.Equal(suite.T(), "expense", response["type"])
+	assert.Equal(suite.T(), float64(100), response["amount"])
+}
+
+func (suite *TransactionControllerTestSuite) TestGetTransaction_NotFound() {
+	// When
+	w := suite.server.MakeRequest("GET", "/api/v1/transactions/999", nil)
+
+	// Then
+	assert.Equal(suite.T(), http.StatusNotFound, w.Code)
+
+	response := test.GetResponseJSON(suite.T(), w)
+	assert.Equal(suite.T(), "Not Found", response["error"])
+	assert.Contains(suite.T(), response["message"], "not found")
+}
+
+func (suite *TransactionControllerTestSuite) TestGetTransaction_InvalidID() {
+	// When
+	w := suite.server.MakeRequest("GET", "/api/v1/transactions/invalid", nil)
+
+	// Then
+	assert.Equal(suite.T(), http.StatusBadRequest, w.Code)
+
+	response := test.GetResponseJSON(suite.T(), w)
+	assert.Equal(suite.T(), "Bad Request", response["error"])
+	assert.Contains(suite.T(), response["message"], "Invalid transaction ID")
+}
+
+// Test DeleteTransaction
+func (suite *TransactionControllerTestSuite) TestDeleteTransaction_Success() {
+	// Given - create a transaction
+	request := models.CreateTransactionRequest{
+		Type:        "expense",
+		Amount:      100,
+		Description: "Test",
+		Category:    "test",
+	}
+
+	createResponse := suite.server.MakeRequest("POST", "/api/v1/transactions", request)
+	assert.Equal(suite.T(), http.StatusCreated, createResponse.Code)
+
+	// When
+	w := suite.server.MakeRequest("DELETE", "/api/v1/transactions/1", nil)
+
+	// Then
+	assert.Equal(suite.T(), http.StatusOK, w.Code)
+
+	response := test.GetResponseJSON(suite.T(), w)
+	assert.Contains(suite.T(), response["message"], "deleted successfully")
+
+	// Verify transaction is deleted
+	getResponse := suite.server.MakeRequest("GET", "/api/v1/transactions/1", nil)
+	assert.Equal(suite.T(), http.StatusNotFound, getResponse.Code)
 }
 
 func (suite *TransactionControllerTestSuite) TestDeleteTransaction_NotFound() {
@@ -406,6 +1808,487 @@ func (suite *TransactionControllerTestSuite) TestDeleteTransaction_InvalidID() {
 	assert.Equal(suite.T(), "Bad Request", response["error"])
 }
 
+func (suite *TransactionControllerTestSuite) TestPinTransaction_PinFilterAndUnpin() {
+	// Given
+	for _, description := range []string{"Rent", "Coffee"} {
+		req := models.CreateTransactionRequest{
+			Type:        "expense",
+			Amount:      100,
+			Description: description,
+			Category:    "misc",
+		}
+		suite.server.MakeRequest("POST", "/api/v1/transactions", req)
+	}
+
+	// When - pin
+	pinResp := suite.server.MakeRequest("POST", "/api/v1/transactions/1/pin", nil)
+
+	// Then
+	assert.Equal(suite.T(), http.StatusOK, pinResp.Code)
+	assert.Equal(suite.T(), true, test.GetResponseJSON(suite.T(), pinResp)["pinned"])
+
+	// When - filter
+	filtered := suite.server.MakeRequest("GET", "/api/v1/transactions?pinned=true", nil)
+
+	// Then
+	var pinned []models.Transaction
+	assert.NoError(suite.T(), json.Unmarshal(filtered.Body.Bytes(), &pinned))
+	assert.Len(suite.T(), pinned, 1)
+	assert.Equal(suite.T(), "Rent", pinned[0].Description)
+
+	// When - unpin
+	unpinResp := suite.server.MakeRequest("POST", "/api/v1/transactions/1/unpin", nil)
+
+	// Then
+	assert.Equal(suite.T(), http.StatusOK, unpinResp.Code)
+	assert.NotContains(suite.T(), test.GetResponseJSON(suite.T(), unpinResp), "pinned")
+
+	afterUnpin := suite.server.MakeRequest("GET", "/api/v1/transactions?pinned=true", nil)
+	var none []models.Transaction
+	assert.NoError(suite.T(), json.Unmarshal(afterUnpin.Body.Bytes(), &none))
+	assert.Empty(suite.T(), none)
+}
+
+func (suite *TransactionControllerTestSuite) TestPinTransaction_NotFound() {
+	// When
+	w := suite.server.MakeRequest("POST", "/api/v1/transactions/999/pin", nil)
+
+	// Then
+	assert.Equal(suite.T(), http.StatusNotFound, w.Code)
+}
+
+func (suite *TransactionControllerTestSuite) TestCloneTransaction_CopiesFieldsWithNewIDAndDate() {
+	// Given
+	created := suite.server.MakeRequest("POST", "/api/v1/transactions", models.CreateTransactionRequest{
+		Type: "expense", Amount: 1500, Currency: "USD", Description: "Gym membership", Category: "health",
+		Account: "credit_card", Tags: []string{"monthly"}, Metadata: map[string]string{"source": "manual"},
+		Date: stringPtr("2024-05-03"),
+	})
+	suite.Require().Equal(http.StatusCreated, created.Code)
+	var source models.Transaction
+	suite.Require().NoError(json.Unmarshal(created.Body.Bytes(), &source))
+
+	// When
+	before := time.Now()
+	w := suite.server.MakeRequest("POST", fmt.Sprintf("/api/v1/transactions/%d/clone", source.ID), nil)
+
+	// Then
+	assert.Equal(suite.T(), http.StatusCreated, w.Code)
+	var clone models.Transaction
+	suite.Require().NoError(json.Unmarshal(w.Body.Bytes(), &clone))
+	assert.NotEqual(suite.T(), source.ID, clone.ID)
+	assert.Equal(suite.T(), fmt.Sprintf("/api/v1/transactions/%d", clone.ID), w.Header().Get("Location"))
+	assert.WithinDuration(suite.T(), before, clone.Date, 2*time.Second)
+
+	expected := source.Clone()
+	expected.ID, expected.Date, expected.CreatedAt, expected.UpdatedAt = clone.ID, clone.Date, clone.CreatedAt, clone.UpdatedAt
+	assert.True(suite.T(), expected.Equal(clone), "clone %+v differs from source %+v", clone, source)
+}
+
+func (suite *TransactionControllerTestSuite) TestCloneTransaction_WithOverrides() {
+	// Given
+	created := suite.server.MakeRequest("POST", "/api/v1/transactions", models.CreateTransactionRequest{
+		Type: "expense", Amount: 1500, Description: "Electricity", Category: "utilities", Date: stringPtr("2024-05-03"),
+	})
+	suite.Require().Equal(http.StatusCreated, created.Code)
+	var source models.Transaction
+	suite.Require().NoError(json.Unmarshal(created.Body.Bytes(), &source))
+
+	// When
+	w := suite.server.MakeRequest("POST", fmt.Sprintf("/api/v1/transactions/%d/clone", source.ID),
+		map[string]interface{}{"amount": 1725.5, "date": "2024-06-03"})
+
+	// Then
+	assert.Equal(suite.T(), http.StatusCreated, w.Code)
+	test.AssertJSONContains(suite.T(), w, map[string]interface{}{
+		"amount":      1725.5,
+		"description": "Electricity",
+		"category":    "utilities",
+		"date":        "2024-06-03T00:00:00Z",
+	})
+
+	// Then - the source is unchanged
+	stored := suite.server.MakeRequest("GET", fmt.Sprintf("/api/v1/transactions/%d", source.ID), nil)
+	test.AssertJSONContains(suite.T(), stored, map[string]interface{}{"amount": float64(1500)})
+}
+
+func (suite *TransactionControllerTestSuite) TestCloneTransaction_WarningsAndAmountAsString() {
+	// Given
+	created := suite.server.MakeRequest("POST", "/api/v1/transactions", models.CreateTransactionRequest{
+		Type: "expense", Amount: 1500, Description: "Rent", Category: "housing", Date: stringPtr("2024-05-03"),
+	})
+	suite.Require().Equal(http.StatusCreated, created.Code)
+	var source models.Transaction
+	suite.Require().NoError(json.Unmarshal(created.Body.Bytes(), &source))
+	typo := time.Now().AddDate(-10, 0, 0).Format("2006-01-02")
+
+	// When
+	w := suite.server.MakeRequest("POST", fmt.Sprintf("/api/v1/transactions/%d/clone?amount_as_string=true", source.ID),
+		map[string]interface{}{"date": typo})
+
+	// Then
+	assert.Equal(suite.T(), http.StatusCreated, w.Code)
+	assert.Contains(suite.T(), w.Body.String(), `"amount":"1500.00"`)
+	assert.Contains(suite.T(), w.Body.String(), `"code":"far_date"`)
+}
+
+func (suite *TransactionControllerTestSuite) TestCloneTransaction_NotFound() {
+	// When
+	w := suite.server.MakeRequest("POST", "/api/v1/transactions/9999/clone", nil)
+
+	// Then
+	assert.Equal(suite.T(), http.StatusNotFound, w.Code)
+}
+
+func (suite *TransactionControllerTestSuite) TestGetTransactionHistory_Success() {
+	// Given
+	createReq := models.CreateTransactionRequest{
+		Type:        "expense",
+		Amount:      100,
+		Description: "Coffee",
+		Category:    "food",
+	}
+	created := test.GetResponseJSON(suite.T(), suite.server.MakeRequest("POST", "/api/v1/transactions", createReq))
+	id := int(created["id"].(float64))
+
+	newAmount := 250.0
+	path := fmt.Sprintf("/api/v1/transactions/%d", id)
+	suite.server.MakeRequest("PATCH", path, models.UpdateTransactionRequest{Amount: &newAmount})
+	suite.server.MakeRequest("DELETE", path, nil)
+
+	// When
+	w := suite.server.MakeRequest("GET", path+"/history", nil)
+
+	// Then
+	assert.Equal(suite.T(), http.StatusOK, w.Code)
+
+	var history []models.AuditEntry
+	err := json.Unmarshal(w.Body.Bytes(), &history)
+	assert.NoError(suite.T(), err)
+	assert.Len(suite.T(), history, 3)
+	assert.Equal(suite.T(), models.AuditActionCreate, history[0].Action)
+	assert.Equal(suite.T(), models.AuditActionUpdate, history[1].Action)
+	assert.Equal(suite.T(), models.AuditActionDelete, history[2].Action)
+	assert.Equal(suite.T(), models.Money(100.0), history[1].Before.Amount)
+	assert.Equal(suite.T(), models.Money(250.0), history[1].After.Amount)
+	assert.Nil(suite.T(), history[2].After)
+}
+
+func (suite *TransactionControllerTestSuite) TestGetTransactionHistory_NotFound() {
+	// When
+	w := suite.server.MakeRequest("GET", "/api/v1/transactions/999/history", nil)
+
+	// Then
+	assert.Equal(suite.T(), http.StatusNotFound, w.Code)
+}
+
+func (suite *TransactionControllerTestSuite) TestValidateTransaction() {
+	invalidAmount, _ := json.Marshal(models.ValidationResult{
+		Errors: map[string]string{"amount": models.ErrInvalidAmountInput.Error()},
+	})
+
+	testCases := []struct {
+		name     string
+		body     string
+		expected string
+	}{
+		{
+			"valid payload",
+			`{"type": "expense", "amount": "12.50", "description": "Lunch", "category": "food", "date": "2024-06-10"}`,
+			`{"valid": true}`,
+		},
+		{
+			"every invalid field",
+			`{"type": "transfer", "amount": 0, "category": "food", "currency": "usd1", "date": "10/06/2024"}`,
+			`{"valid": false, "errors": {
+				"type": "type must be 'expense' or 'income'",
+				"amount": "amount must be positive",
+				"description": "description is required",
+				"currency": "currency must be a valid 3-letter ISO code (e.g., USD, ARS, EUR)",
+				"date": "invalid date format, use YYYY-MM-DD"
+			}}`,
+		},
+		{
+			"non-numeric amount",
+			`{"type": "expense", "amount": "abc", "description": "Lunch", "category": "food"}`,
+			string(invalidAmount),
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			// When
+			w := suite.server.MakeRawRequest("POST", "/api/v1/transactions/validate", "application/json", tc.body)
+
+			// Then
+			assert.Equal(suite.T(), http.StatusOK, w.Code)
+			assert.JSONEq(suite.T(), tc.expected, w.Body.String())
+		})
+	}
+
+	// Then - nothing was stored
+	list := suite.server.MakeRequest("GET", "/api/v1/transactions", nil)
+	assert.JSONEq(suite.T(), `[]`, list.Body.String())
+}
+
+func (suite *TransactionControllerTestSuite) TestGetTransactions_MonthShortcut() {
+	// Given - transactions on both sides of June's boundaries
+	for _, date := range []string{"2024-05-31", "2024-06-01", "2024-06-30", "2024-07-01"} {
+		w := suite.server.MakeRequest("POST", "/api/v1/transactions", models.CreateTransactionRequest{
+			Type: "expense", Amount: 100, Description: date, Category: "food", Date: stringPtr(date),
+		})
+		suite.Require().Equal(http.StatusCreated, w.Code)
+	}
+
+	// When
+	w := suite.server.MakeRequest("GET", "/api/v1/transactions?month=2024-06", nil)
+
+	// Then
+	assert.Equal(suite.T(), http.StatusOK, w.Code)
+	var transactions []models.Transaction
+	assert.NoError(suite.T(), json.Unmarshal(w.Body.Bytes(), &transactions))
+	descriptions := make([]string, 0, len(transactions))
+	for _, transaction := range transactions {
+		descriptions = append(descriptions, transaction.Description)
+	}
+	assert.ElementsMatch(suite.T(), []string{"2024-06-01", "2024-06-30"}, descriptions)
+}
+
+func (suite *TransactionControllerTestSuite) TestGetTransactions_MonthShortcutRejectsBadInput() {
+	testCases := []struct {
+		name    string
+		query   string
+		message string
+	}{
+		{"month out of range", "month=2024-13", "month must be in YYYY-MM format, e.g. 2024-06"},
+		{"full date", "month=2024-06-01", "month must be in YYYY-MM format, e.g. 2024-06"},
+		{"combined with from_date", "month=2024-06&from_date=2024-06-10", "month cannot be combined with from_date or to_date"},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			// When
+			w := suite.server.MakeRequest("GET", "/api/v1/transactions?"+tc.query, nil)
+
+			// Then
+			assert.Equal(suite.T(), http.StatusBadRequest, w.Code)
+			test.AssertJSONContains(suite.T(), w, map[string]interface{}{"message": tc.message})
+		})
+	}
+}
+
+func (suite *TransactionControllerTestSuite) TestBulkUpdateTransactions() {
+	// Given
+	created := suite.server.MakeRequest("POST", "/api/v1/transactions", models.CreateTransactionRequest{
+		Type: "expense", Amount: 100, Description: "Groceries", Category: "misc",
+	})
+	suite.Require().Equal(http.StatusCreated, created.Code)
+	var transaction models.Transaction
+	suite.Require().NoError(json.Unmarshal(created.Body.Bytes(), &transaction))
+
+	body := fmt.Sprintf(`[
+		{"id": %[1]d, "category": "food"},
+		{"id": 9999, "category": "food"},
+		{"id": %[1]d, "status": "done"},
+		{"id": %[1]d, "amount": "abc"},
+		{"category": "food"}
+	]`, transaction.ID)
+
+	// When
+	w := suite.server.MakeRawRequest("PATCH", "/api/v1/transactions", "application/json", body)
+
+	// Then
+	assert.Equal(suite.T(), http.StatusOK, w.Code)
+	var result models.BulkUpdateResult
+	suite.Require().NoError(json.Unmarshal(w.Body.Bytes(), &result))
+	assert.Equal(suite.T(), 1, result.Updated)
+	assert.Equal(suite.T(), 4, result.Failed)
+	suite.Require().Len(result.Results, 5)
+
+	assert.Equal(suite.T(), http.StatusOK, result.Results[0].Status)
+	if assert.NotNil(suite.T(), result.Results[0].Transaction) {
+		assert.Equal(suite.T(), "food", result.Results[0].Transaction.Category)
+	}
+	assert.Equal(suite.T(), models.BulkUpdateItemResult{ID: 9999, Status: http.StatusNotFound, Error: models.ErrTransactionNotFound.Error()}, result.Results[1])
+	assert.Equal(suite.T(), models.BulkUpdateItemResult{ID: transaction.ID, Status: http.StatusBadRequest, Error: "status must be 'pending' or 'cleared'"}, result.Results[2])
+	assert.Equal(suite.T(), models.BulkUpdateItemResult{ID: transaction.ID, Status: http.StatusBadRequest, Error: models.ErrInvalidAmountInput.Error()}, result.Results[3])
+	assert.Equal(suite.T(), models.BulkUpdateItemResult{Status: http.StatusBadRequest, Error: "id is required"}, result.Results[4])
+
+	// Then - the valid edit was stored despite the failures after it
+	stored := suite.server.MakeRequest("GET", fmt.Sprintf("/api/v1/transactions/%d", transaction.ID), nil)
+	test.AssertJSONContains(suite.T(), stored, map[string]interface{}{"category": "food", "status": "cleared"})
+}
+
+func (suite *TransactionControllerTestSuite) TestBulkUpdateTransactions_ReportsWarningsPerItem() {
+	// Given
+	created := suite.server.MakeRequest("POST", "/api/v1/transactions", models.CreateTransactionRequest{
+		Type: "expense", Amount: 100, Description: "Groceries", Category: "food",
+	})
+	suite.Require().Equal(http.StatusCreated, created.Code)
+	var transaction models.Transaction
+	suite.Require().NoError(json.Unmarshal(created.Body.Bytes(), &transaction))
+	typo := time.Now().AddDate(-10, 0, 0).Format("2006-01-02")
+
+	body := fmt.Sprintf(`[{"id": %[1]d, "category": "misc"}, {"id": %[1]d, "date": %[2]q}]`, transaction.ID, typo)
+
+	// When
+	w := suite.server.MakeRawRequest("PATCH", "/api/v1/transactions", "application/json", body)
+
+	// Then
+	assert.Equal(suite.T(), http.StatusOK, w.Code)
+	var result models.BulkUpdateResult
+	suite.Require().NoError(json.Unmarshal(w.Body.Bytes(), &result))
+	suite.Require().Len(result.Results, 2)
+	assert.Empty(suite.T(), result.Results[0].Warnings)
+	suite.Require().Len(result.Results[1].Warnings, 1)
+	assert.Equal(suite.T(), models.WarningFarDate, result.Results[1].Warnings[0].Code)
+	assert.Contains(suite.T(), result.Results[1].Warnings[0].Message, typo)
+}
+
+func (suite *TransactionControllerTestSuite) TestBulkUpdateTransactions_RejectsInvalidBody() {
+	testCases := []struct {
+		name string
+		body string
+	}{
+		{"not an array", `{"id": 1, "category": "food"}`},
+		{"empty array", `[]`},
+		{"too many edits", "[" + strings.Repeat(`{"id": 1},`, services.MaxBulkUpdateItems) + `{"id": 1}]`},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			// When
+			w := suite.server.MakeRawRequest("PATCH", "/api/v1/transactions", "application/json", tc.body)
+
+			// Then
+			assert.Equal(suite.T(), http.StatusBadRequest, w.Code)
+		})
+	}
+}
+
+func (suite *TransactionControllerTestSuite) TestServerReset() {
+	// Given
+	for _, description := range []string{"First", "Second"} {
+		w := suite.server.MakeRequest("POST", "/api/v1/transactions", models.CreateTransactionRequest{
+			Type: "expense", Amount: 100, Description: description, Category: "food",
+		})
+		suite.Require().Equal(http.StatusCreated, w.Code)
+	}
+
+	// When
+	suite.server.Reset()
+
+	// Then
+	list := suite.server.MakeRequest("GET", "/api/v1/transactions", nil)
+	assert.JSONEq(suite.T(), `[]`, list.Body.String())
+
+	created := suite.server.MakeRequest("POST", "/api/v1/transactions", models.CreateTransactionRequest{
+		Type: "expense", Amount: 100, Description: "After reset", Category: "food",
+	})
+	test.AssertJSONContains(suite.T(), created, map[string]interface{}{"id": float64(1)})
+}
+
+func (suite *TransactionControllerTestSuite) TestCheckIntegrity() {
+	// Given
+	suite.server.MakeRequest("POST", "/api/v1/transactions", models.CreateTransactionRequest{
+		Type: "expense", Amount: 100, Description: "Coffee", Category: "food",
+	})
+
+	// When
+	healthy := suite.server.MakeRequest("GET", "/api/v1/admin/integrity", nil)
+	date := time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)
+	suite.server.Seed([]models.Transaction{
+		{ID: 7, Type: "expense", Amount: 10, Currency: "ARS", Category: "food", Date: date, CreatedAt: date},
+		{ID: 7, Type: "income", Amount: 20, Currency: "ARS", Category: "work", Date: date, CreatedAt: date},
+	}, 7)
+	broken := suite.server.MakeRequest("GET", "/api/v1/admin/integrity", nil)
+
+	// Then
+	assert.Equal(suite.T(), http.StatusOK, healthy.Code)
+	assert.JSONEq(suite.T(), `{"ok": true, "transaction_count": 1, "next_id": 2, "issues": []}`, healthy.Body.String())
+
+	assert.Equal(suite.T(), http.StatusOK, broken.Code)
+	var report models.IntegrityReport
+	suite.Require().NoError(json.Unmarshal(broken.Body.Bytes(), &report))
+	assert.False(suite.T(), report.OK)
+	codes := make(map[string]bool)
+	for _, issue := range report.Issues {
+		codes[issue.Code] = true
+	}
+	assert.True(suite.T(), codes[models.IntegrityDuplicateID])
+	assert.True(suite.T(), codes[models.IntegrityNextIDTooLow])
+}
+
+func (suite *TransactionControllerTestSuite) TestTransactionMetadata_StoreUpdateAndFilter() {
+	// Given
+	created := suite.server.MakeRequest("POST", "/api/v1/transactions", models.CreateTransactionRequest{
+		Type: "expense", Amount: 100, Description: "Synced", Category: "food",
+		Metadata: map[string]string{"source": "bank_sync", "external_id": "tx-42"},
+	})
+	suite.Require().Equal(http.StatusCreated, created.Code)
+	var transaction models.Transaction
+	suite.Require().NoError(json.Unmarshal(created.Body.Bytes(), &transaction))
+	assert.Equal(suite.T(), map[string]string{"source": "bank_sync", "external_id": "tx-42"}, transaction.Metadata)
+
+	manual := suite.server.MakeRequest("POST", "/api/v1/transactions", models.CreateTransactionRequest{
+		Type: "expense", Amount: 200, Description: "Manual", Category: "food",
+		Metadata: map[string]string{"source": "manual"},
+	})
+	suite.Require().Equal(http.StatusCreated, manual.Code)
+
+	// When
+	filtered := suite.server.MakeRequest("GET", "/api/v1/transactions?meta.source=bank_sync", nil)
+
+	// Then
+	var result []models.Transaction
+	suite.Require().NoError(json.Unmarshal(filtered.Body.Bytes(), &result))
+	if assert.Len(suite.T(), result, 1) {
+		assert.Equal(suite.T(), transaction.ID, result[0].ID)
+	}
+
+	// When - an update replaces the metadata
+	updated := suite.server.MakeRequest("PUT", fmt.Sprintf("/api/v1/transactions/%d", transaction.ID),
+		models.UpdateTransactionRequest{Metadata: &map[string]string{"source": "manual"}})
+
+	// Then
+	assert.Equal(suite.T(), http.StatusOK, updated.Code)
+	test.AssertJSONContains(suite.T(), updated, map[string]interface{}{
+		"metadata": map[string]interface{}{"source": "manual"},
+	})
+	filtered = suite.server.MakeRequest("GET", "/api/v1/transactions?meta.source=bank_sync", nil)
+	assert.JSONEq(suite.T(), `[]`, filtered.Body.String())
+}
+
+func (suite *TransactionControllerTestSuite) TestTransactionMetadata_RejectsOversizedMetadata() {
+	tooManyKeys := make(map[string]string)
+	for i := 0; i <= services.MaxMetadataKeys; i++ {
+		tooManyKeys[fmt.Sprintf("key%d", i)] = "value"
+	}
+
+	testCases := []struct {
+		name     string
+		metadata map[string]string
+	}{
+		{"too many keys", tooManyKeys},
+		{"key too long", map[string]string{strings.Repeat("k", services.MaxMetadataKeyLength+1): "value"}},
+		{"value too long", map[string]string{"note": strings.Repeat("v", services.MaxMetadataValueLength+1)}},
+		{"empty key", map[string]string{"": "value"}},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			// When
+			w := suite.server.MakeRequest("POST", "/api/v1/transactions", models.CreateTransactionRequest{
+				Type: "expense", Amount: 100, Description: "Too much metadata", Category: "food",
+				Metadata: tc.metadata,
+			})
+
+			// Then
+			assert.Equal(suite.T(), http.StatusBadRequest, w.Code)
+		})
+	}
+}
+
 func TestTransactionControllerTestSuite(t *testing.T) {
 	suite.Run(t, new(TransactionControllerTestSuite))
 }
\ No newline at end of file
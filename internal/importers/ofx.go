@@ -0,0 +1,193 @@
+// Package importers turns bank export files into transaction requests.
+package importers
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/maximicciullo/personal-finance-api/internal/models"
+)
+
+// FormatOFX identifies OFX/QFX statement files
+const FormatOFX = "ofx"
+
+// DefaultCategory is assigned to imported transactions, since statements carry none
+const DefaultCategory = "uncategorized"
+
+// ErrMalformedOFX is returned when the input is not an OFX statement
+var ErrMalformedOFX = errors.New("malformed OFX file")
+
+// Record is a statement transaction mapped to a create request, numbered from 1 in
+// file order so errors can point back at it
+type Record struct {
+	Number  int
+	Request models.CreateTransactionRequest
+}
+
+// ParseOFX reads the STMTTRN records of an OFX 1.x (SGML) or 2.x (XML) statement.
+// Negative amounts become expenses and positive ones income; the memo, or the payee
+// name when there is no memo, becomes the description. Records that cannot be mapped
+// are reported in the returned errors rather than failing the whole file.
+func ParseOFX(r io.Reader) ([]Record, []models.ImportError, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, nil, err
+	}
+	content := string(data)
+
+	if !strings.Contains(strings.ToUpper(content), "<OFX>") {
+		return nil, nil, fmt.Errorf("%w: missing <OFX> root element", ErrMalformedOFX)
+	}
+
+	records := make([]Record, 0)
+	importErrors := make([]models.ImportError, 0)
+
+	number := 0
+	currency := ""
+	for _, element := range splitElements(content) {
+		switch element.tag {
+		case "CURDEF":
+			currency = strings.ToUpper(element.value)
+		case "STMTTRN":
+			number++
+			if element.unterminated {
+				importErrors = append(importErrors, models.ImportError{Record: number, Message: "unterminated STMTTRN record"})
+				continue
+			}
+			request, err := mapTransaction(element.children, currency)
+			if err != nil {
+				importErrors = append(importErrors, models.ImportError{Record: number, Message: err.Error()})
+				continue
+			}
+			records = append(records, Record{Number: number, Request: request})
+		}
+	}
+
+	if number == 0 && !strings.Contains(strings.ToUpper(content), "<BANKTRANLIST>") {
+		return nil, nil, fmt.Errorf("%w: no transaction list found", ErrMalformedOFX)
+	}
+
+	return records, importErrors, nil
+}
+
+// mapTransaction builds a create request from the fields of one STMTTRN record
+func mapTransaction(fields map[string]string, currency string) (models.CreateTransactionRequest, error) {
+	var request models.CreateTransactionRequest
+
+	amountStr := strings.Replace(fields["TRNAMT"], ",", ".", 1)
+	if amountStr == "" {
+		return request, errors.New("missing TRNAMT")
+	}
+	amount, err := strconv.ParseFloat(amountStr, 64)
+	if err != nil {
+		return request, fmt.Errorf("invalid TRNAMT %q", fields["TRNAMT"])
+	}
+	if amount == 0 {
+		return request, errors.New("TRNAMT must not be zero")
+	}
+
+	date, err := parseOFXDate(fields["DTPOSTED"])
+	if err != nil {
+		return request, err
+	}
+
+	description := fields["MEMO"]
+	if description == "" {
+		description = fields["NAME"]
+	}
+	if description == "" {
+		return request, errors.New("missing MEMO and NAME")
+	}
+
+	request.Type = models.TransactionTypeIncome
+	request.Amount = amount
+	if amount < 0 {
+		request.Type = models.TransactionTypeExpense
+		request.Amount = -amount
+	}
+	request.Currency = currency
+	request.Description = description
+	request.Category = DefaultCategory
+	request.Date = &date
+
+	return request, nil
+}
+
+// parseOFXDate converts an OFX datetime such as 20240115120000[-3:ART] to YYYY-MM-DD
+func parseOFXDate(value string) (string, error) {
+	if value == "" {
+		return "", errors.New("missing DTPOSTED")
+	}
+	if len(value) < 8 {
+		return "", fmt.Errorf("invalid DTPOSTED %q", value)
+	}
+	for _, c := range value[:8] {
+		if c < '0' || c > '9' {
+			return "", fmt.Errorf("invalid DTPOSTED %q", value)
+		}
+	}
+	return value[0:4] + "-" + value[4:6] + "-" + value[6:8], nil
+}
+
+// element is a top-level tag of interest: a leaf with its value, or an aggregate
+// with the leaf values it contains
+type element struct {
+	tag          string
+	value        string
+	children     map[string]string
+	unterminated bool
+}
+
+// splitElements walks the tags of an OFX document, which in SGML form may leave leaf
+// elements unclosed, and returns CURDEF values and STMTTRN aggregates in order
+func splitElements(content string) []element {
+	elements := make([]element, 0)
+	var current map[string]string
+
+	for rest := content; ; {
+		open := strings.IndexByte(rest, '<')
+		if open < 0 {
+			break
+		}
+		end := strings.IndexByte(rest[open:], '>')
+		if end < 0 {
+			break
+		}
+		tag := strings.ToUpper(strings.TrimSpace(rest[open+1 : open+end]))
+		rest = rest[open+end+1:]
+
+		value := rest
+		if next := strings.IndexByte(rest, '<'); next >= 0 {
+			value = rest[:next]
+		}
+		value = strings.TrimSpace(value)
+
+		switch {
+		case tag == "STMTTRN":
+			if current != nil {
+				elements = append(elements, element{tag: "STMTTRN", children: current, unterminated: true})
+			}
+			current = make(map[string]string)
+		case tag == "/STMTTRN":
+			if current != nil {
+				elements = append(elements, element{tag: "STMTTRN", children: current})
+				current = nil
+			}
+		case strings.HasPrefix(tag, "/"):
+			// Closing tags of leaves (OFX 2.x) carry nothing new
+		case current != nil:
+			current[tag] = value
+		case tag == "CURDEF":
+			elements = append(elements, element{tag: tag, value: value})
+		}
+	}
+
+	if current != nil {
+		elements = append(elements, element{tag: "STMTTRN", children: current, unterminated: true})
+	}
+
+	return elements
+}
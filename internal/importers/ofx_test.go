@@ -0,0 +1,138 @@
+package importers_test
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/maximicciullo/personal-finance-api/internal/importers"
+	"github.com/maximicciullo/personal-finance-api/internal/models"
+	"github.com/stretchr/testify/assert"
+)
+
+const sampleOFX = `OFXHEADER:100
+DATA:OFXSGML
+VERSION:102
+
+<OFX>
+<BANKMSGSRSV1>
+<STMTTRNRS>
+<STMTRS>
+<CURDEF>ARS
+<BANKTRANLIST>
+<DTSTART>20240101
+<DTEND>20240131
+<STMTTRN>
+<TRNTYPE>DEBIT
+<DTPOSTED>20240115120000[-3:ART]
+<TRNAMT>-1500.50
+<FITID>1001
+<NAME>SUPERMERCADO
+<MEMO>Groceries
+</STMTTRN>
+<STMTTRN>
+<TRNTYPE>CREDIT
+<DTPOSTED>20240101
+<TRNAMT>250000.00
+<FITID>1002
+<NAME>ACME PAYROLL
+</STMTTRN>
+<STMTTRN>
+<TRNTYPE>DEBIT
+<DTPOSTED>20240120
+<TRNAMT>abc
+<FITID>1003
+<MEMO>Broken amount
+</STMTTRN>
+</BANKTRANLIST>
+</STMTRS>
+</STMTTRNRS>
+</BANKMSGSRSV1>
+</OFX>
+`
+
+func TestParseOFX_MapsRecords(t *testing.T) {
+	// When
+	records, importErrors, err := importers.ParseOFX(strings.NewReader(sampleOFX))
+
+	// Then
+	assert.NoError(t, err)
+	assert.Len(t, records, 2)
+
+	expense := records[0]
+	assert.Equal(t, 1, expense.Number)
+	assert.Equal(t, models.TransactionTypeExpense, expense.Request.Type)
+	assert.Equal(t, 1500.50, expense.Request.Amount)
+	assert.Equal(t, "ARS", expense.Request.Currency)
+	assert.Equal(t, "Groceries", expense.Request.Description)
+	assert.Equal(t, importers.DefaultCategory, expense.Request.Category)
+	assert.Equal(t, "2024-01-15", *expense.Request.Date)
+
+	income := records[1]
+	assert.Equal(t, models.TransactionTypeIncome, income.Request.Type)
+	assert.Equal(t, 250000.0, income.Request.Amount)
+	assert.Equal(t, "ACME PAYROLL", income.Request.Description, "NAME is used when there is no MEMO")
+
+	assert.Equal(t, []models.ImportError{{Record: 3, Message: `invalid TRNAMT "abc"`}}, importErrors)
+}
+
+func TestParseOFX_XMLClosingTags(t *testing.T) {
+	// Given
+	payload := `<?xml version="1.0"?><OFX><CURDEF>USD</CURDEF><BANKTRANLIST>` +
+		`<STMTTRN><DTPOSTED>20240301</DTPOSTED><TRNAMT>-20</TRNAMT><MEMO>Coffee</MEMO></STMTTRN>` +
+		`</BANKTRANLIST></OFX>`
+
+	// When
+	records, importErrors, err := importers.ParseOFX(strings.NewReader(payload))
+
+	// Then
+	assert.NoError(t, err)
+	assert.Empty(t, importErrors)
+	assert.Len(t, records, 1)
+	assert.Equal(t, "USD", records[0].Request.Currency)
+	assert.Equal(t, 20.0, records[0].Request.Amount)
+}
+
+func TestParseOFX_RecordErrors(t *testing.T) {
+	// Given
+	payload := `<OFX><BANKTRANLIST>
+<STMTTRN><DTPOSTED>20240301<TRNAMT>0<MEMO>Zero</STMTTRN>
+<STMTTRN><DTPOSTED>2024<TRNAMT>-5<MEMO>Short date</STMTTRN>
+<STMTTRN><DTPOSTED>20240301<TRNAMT>-5</STMTTRN>
+<STMTTRN><DTPOSTED>20240301<TRNAMT>-5<MEMO>Never closed
+</BANKTRANLIST></OFX>`
+
+	// When
+	records, importErrors, err := importers.ParseOFX(strings.NewReader(payload))
+
+	// Then
+	assert.NoError(t, err)
+	assert.Empty(t, records)
+	assert.Equal(t, []models.ImportError{
+		{Record: 1, Message: "TRNAMT must not be zero"},
+		{Record: 2, Message: `invalid DTPOSTED "2024"`},
+		{Record: 3, Message: "missing MEMO and NAME"},
+		{Record: 4, Message: "unterminated STMTTRN record"},
+	}, importErrors)
+}
+
+func TestParseOFX_MalformedFile(t *testing.T) {
+	testCases := []struct {
+		name    string
+		payload string
+	}{
+		{"not OFX", "id,amount\n1,100\n"},
+		{"no transaction list", "<OFX><SIGNONMSGSRSV1></SIGNONMSGSRSV1></OFX>"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// When
+			records, _, err := importers.ParseOFX(strings.NewReader(tc.payload))
+
+			// Then
+			assert.True(t, errors.Is(err, importers.ErrMalformedOFX))
+			assert.Nil(t, records)
+		})
+	}
+}
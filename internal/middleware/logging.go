@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"io"
 	"strings"
+	"sync/atomic"
 	"time"
 
 	"github.com/gin-gonic/gin"
@@ -14,6 +15,15 @@ import (
 
 var Logger *zap.Logger
 
+// logEmoji keeps the emoji prefixes on log messages; see SetLogEmoji
+var logEmoji = true
+
+// slowThreshold is the duration above which Performance warns; see SetSlowThreshold
+var slowThreshold = 500 * time.Millisecond
+
+// businessSink receives business events when set; otherwise they go to Logger
+var businessSink *zap.Logger
+
 // InitLogger initializes the global zap logger
 func InitLogger(environment string) error {
 	var config zap.Config
@@ -50,6 +60,54 @@ func InitLogger(environment string) error {
 	return nil
 }
 
+// SetLogEmoji turns the emoji prefixes on log messages on or off, for log pipelines and
+// terminals that cannot handle them
+func SetLogEmoji(enabled bool) {
+	logEmoji = enabled
+}
+
+// SetSlowThreshold sets the duration above which performance entries are logged as
+// warnings. Zero keeps every entry at Debug.
+func SetSlowThreshold(threshold time.Duration) {
+	slowThreshold = threshold
+}
+
+// EmojiMessage prefixes message with emoji unless emoji output is disabled
+func EmojiMessage(emoji, message string) string {
+	if !logEmoji {
+		return message
+	}
+	return emoji + " " + message
+}
+
+// InitBusinessLogger sends business events to their own JSON output at the given level,
+// so operators can ingest them apart from request logs. An empty output keeps them on Logger.
+func InitBusinessLogger(output, level string) error {
+	if output == "" {
+		businessSink = nil
+		return nil
+	}
+
+	parsedLevel, err := zapcore.ParseLevel(level)
+	if err != nil {
+		return err
+	}
+
+	config := zap.NewProductionConfig()
+	config.Level = zap.NewAtomicLevelAt(parsedLevel)
+	config.OutputPaths = []string{output}
+	config.EncoderConfig.TimeKey = "timestamp"
+	config.EncoderConfig.EncodeTime = zapcore.ISO8601TimeEncoder
+	config.EncoderConfig.MessageKey = "message"
+
+	sink, err := config.Build()
+	if err != nil {
+		return err
+	}
+	businessSink = sink
+	return nil
+}
+
 // LogConfig holds logging configuration
 type LogConfig struct {
 	ShowRequest  bool
@@ -57,6 +115,7 @@ type LogConfig struct {
 	ShowHeaders  bool
 	SkipPaths    []string
 	MaxBodySize  int64
+	SampleEvery  int // Log 1 in N successful requests; 4xx/5xx are always logged. 0 or 1 logs all.
 }
 
 // DefaultLogConfig returns a default logging configuration
@@ -97,8 +156,12 @@ func ZapLogger() gin.HandlerFunc {
 	return ZapLoggerWithConfig(DefaultLogConfig())
 }
 
-// ZapLoggerWithConfig returns a gin middleware that uses zap with custom config
+// ZapLoggerWithConfig returns a gin middleware that uses zap with custom config.
+// With sampling on, the request is logged after the handler runs, once the status
+// decides whether it is kept.
 func ZapLoggerWithConfig(config LogConfig) gin.HandlerFunc {
+	var successCount atomic.Uint64
+
 	return func(c *gin.Context) {
 		// Skip paths if configured
 		for _, path := range config.SkipPaths {
@@ -111,6 +174,7 @@ func ZapLoggerWithConfig(config LogConfig) gin.HandlerFunc {
 		start := time.Now()
 		path := c.Request.URL.Path
 		raw := c.Request.URL.RawQuery
+		sampling := config.SampleEvery > 1
 
 		// Read request body if needed
 		var requestBody []byte
@@ -120,7 +184,9 @@ func ZapLoggerWithConfig(config LogConfig) gin.HandlerFunc {
 		}
 
 		// Log request
-		logRequest(c, requestBody, config)
+		if !sampling {
+			logRequest(c, requestBody, config)
+		}
 
 		// Create response body writer
 		blw := &bodyLogWriter{body: bytes.NewBufferString(""), ResponseWriter: c.Writer}
@@ -132,6 +198,15 @@ func ZapLoggerWithConfig(config LogConfig) gin.HandlerFunc {
 		// Calculate latency
 		latency := time.Since(start)
 
+		// Successful requests are sampled; failures are always kept
+		if sampling {
+			if c.Writer.Status() < 400 && len(c.Errors) == 0 &&
+				(successCount.Add(1)-1)%uint64(config.SampleEvery) != 0 {
+				return
+			}
+			logRequest(c, requestBody, config)
+		}
+
 		// Get final path
 		if raw != "" {
 			path = path + "?" + raw
@@ -197,7 +272,7 @@ func logRequest(c *gin.Context, body []byte, config LogConfig) {
 		}
 	}
 
-	Logger.Info("📥 HTTP Request", fields...)
+	Logger.Info(EmojiMessage("📥", "HTTP Request"), fields...)
 }
 
 // logResponse logs response details using zap
@@ -238,18 +313,18 @@ func logResponse(c *gin.Context, body []byte, latency time.Duration, path string
 	// Choose log level based on status code
 	switch {
 	case statusCode >= 500:
-		Logger.Error("📤 HTTP Response", fields...)
+		Logger.Error(EmojiMessage("📤", "HTTP Response"), fields...)
 	case statusCode >= 400:
-		Logger.Warn("📤 HTTP Response", fields...)
+		Logger.Warn(EmojiMessage("📤", "HTTP Response"), fields...)
 	default:
-		Logger.Info("📤 HTTP Response", fields...)
+		Logger.Info(EmojiMessage("📤", "HTTP Response"), fields...)
 	}
 }
 
 // logErrors logs any errors that occurred during request processing
 func logErrors(c *gin.Context) {
 	for _, err := range c.Errors {
-		Logger.Error("❌ Request Error",
+		Logger.Error(EmojiMessage("❌", "Request Error"),
 			zap.String("method", c.Request.Method),
 			zap.String("path", c.Request.URL.Path),
 			zap.String("error_type", getErrorTypeString(err.Type)),
@@ -326,9 +401,18 @@ func ProductionLogger() gin.HandlerFunc {
 	return ZapLoggerWithConfig(ProductionLogConfig())
 }
 
-// BusinessLogger logs business logic events in different layers
+// BusinessLogger logs business logic events in different layers, to the business sink
+// when InitBusinessLogger configured one
 func BusinessLogger() *BusinessLoggerInstance {
-	return &BusinessLoggerInstance{logger: Logger}
+	if businessSink != nil {
+		return BusinessLoggerWithSink(businessSink)
+	}
+	return BusinessLoggerWithSink(Logger)
+}
+
+// BusinessLoggerWithSink logs business events to the given logger
+func BusinessLoggerWithSink(logger *zap.Logger) *BusinessLoggerInstance {
+	return &BusinessLoggerInstance{logger: logger}
 }
 
 type BusinessLoggerInstance struct {
@@ -338,19 +422,19 @@ type BusinessLoggerInstance struct {
 // Controller logs controller layer events
 func (bl *BusinessLoggerInstance) Controller(operation string, fields ...zap.Field) {
 	allFields := append([]zap.Field{zap.String("layer", "controller")}, fields...)
-	bl.logger.Info("🎛️ "+operation, allFields...)
+	bl.logger.Info(EmojiMessage("🎛️", operation), allFields...)
 }
 
 // Service logs service layer events
 func (bl *BusinessLoggerInstance) Service(operation string, fields ...zap.Field) {
 	allFields := append([]zap.Field{zap.String("layer", "service")}, fields...)
-	bl.logger.Info("⚙️ "+operation, allFields...)
+	bl.logger.Info(EmojiMessage("⚙️", operation), allFields...)
 }
 
 // Repository logs repository layer events
 func (bl *BusinessLoggerInstance) Repository(operation string, fields ...zap.Field) {
 	allFields := append([]zap.Field{zap.String("layer", "repository")}, fields...)
-	bl.logger.Info("🗄️ "+operation, allFields...)
+	bl.logger.Info(EmojiMessage("🗄️", operation), allFields...)
 }
 
 // Error logs error events in any layer
@@ -359,17 +443,30 @@ func (bl *BusinessLoggerInstance) Error(layer, operation string, err error, fiel
 		zap.String("layer", layer),
 		zap.Error(err),
 	}, fields...)
-	bl.logger.Error("❌ "+operation, allFields...)
+	bl.logger.Error(EmojiMessage("❌", operation), allFields...)
 }
 
-// Performance logs performance metrics
+// Performance logs performance metrics at Debug, escalating to Warn once the duration
+// exceeds the slow threshold
 func (bl *BusinessLoggerInstance) Performance(operation string, duration time.Duration, fields ...zap.Field) {
-	allFields := append([]zap.Field{zap.Duration("duration", duration)}, fields...)
-	bl.logger.Info("⚡ "+operation, allFields...)
+	allFields := append([]zap.Field{
+		zap.String("layer", "performance"),
+		zap.Duration("duration", duration),
+	}, fields...)
+	if slowThreshold > 0 && duration > slowThreshold {
+		bl.logger.Warn(EmojiMessage("🐢", "Slow "+operation), append(allFields, zap.Duration("threshold", slowThreshold))...)
+		return
+	}
+	bl.logger.Debug(EmojiMessage("⚡", operation), allFields...)
+}
+
+// Sync flushes buffered business events
+func (bl *BusinessLoggerInstance) Sync() error {
+	return bl.logger.Sync()
 }
 
 // Debug logs debug information
 func (bl *BusinessLoggerInstance) Debug(layer, message string, fields ...zap.Field) {
 	allFields := append([]zap.Field{zap.String("layer", layer)}, fields...)
-	bl.logger.Debug("🔍 "+message, allFields...)
+	bl.logger.Debug(EmojiMessage("🔍", message), allFields...)
 }
\ No newline at end of file
@@ -0,0 +1,200 @@
+package middleware_test
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+	"time"
+	"unicode/utf8"
+
+	"github.com/gin-gonic/gin"
+	"github.com/maximicciullo/personal-finance-api/internal/middleware"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+	"go.uber.org/zap/zaptest/observer"
+)
+
+func TestBusinessLoggerWithSink_EventsCarryLayer(t *testing.T) {
+	// Given
+	core, logs := observer.New(zapcore.DebugLevel)
+	logger := middleware.BusinessLoggerWithSink(zap.New(core))
+
+	// When
+	logger.Controller("CreateTransaction started")
+	logger.Service("CreateTransaction started")
+	logger.Repository("Create started")
+	logger.Performance("CreateTransaction service call", 5*time.Millisecond)
+	logger.Error("service", "CreateTransaction failed", errors.New("boom"))
+	logger.Debug("repository", "Filtering transactions")
+
+	// Then
+	entries := logs.All()
+	if !assert.Len(t, entries, 6) {
+		return
+	}
+	expected := []string{"controller", "service", "repository", "performance", "service", "repository"}
+	for i, entry := range entries {
+		assert.Equal(t, expected[i], entry.ContextMap()["layer"], "entry %q", entry.Message)
+	}
+	assert.Equal(t, 5*time.Millisecond, entries[3].ContextMap()["duration"])
+}
+
+func TestBusinessLogger_SeparateSinkLeavesRequestLogger(t *testing.T) {
+	// Given
+	assert.NoError(t, middleware.InitLogger("test"))
+	path := t.TempDir() + "/business.log"
+	assert.NoError(t, middleware.InitBusinessLogger(path, "warn"))
+	t.Cleanup(func() { middleware.InitBusinessLogger("", "") })
+
+	// When
+	logger := middleware.BusinessLogger()
+	logger.Service("filtered by level")
+	logger.Error("service", "kept", errors.New("boom"))
+	assert.NoError(t, logger.Sync())
+
+	// Then
+	data, err := os.ReadFile(path)
+	assert.NoError(t, err)
+	content := string(data)
+	assert.NotContains(t, content, "filtered by level")
+	assert.Contains(t, content, `"layer":"service"`)
+	assert.Contains(t, content, "kept")
+}
+
+func TestInitBusinessLogger_InvalidLevel(t *testing.T) {
+	// When
+	err := middleware.InitBusinessLogger(t.TempDir()+"/business.log", "loud")
+
+	// Then
+	assert.Error(t, err)
+}
+
+func TestSetLogEmoji_DisabledKeepsMessagesASCII(t *testing.T) {
+	// Given
+	core, logs := observer.New(zapcore.DebugLevel)
+	middleware.Logger = zap.New(core)
+	middleware.SetLogEmoji(false)
+	t.Cleanup(func() { middleware.SetLogEmoji(true) })
+
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	router.Use(middleware.ZapLogger())
+	router.GET("/ping", func(c *gin.Context) { c.JSON(http.StatusOK, gin.H{"ok": true}) })
+
+	// When
+	logger := middleware.BusinessLoggerWithSink(middleware.Logger)
+	logger.Controller("CreateTransaction started")
+	logger.Service("CreateTransaction started")
+	logger.Repository("Create started")
+	logger.Performance("CreateTransaction service call", time.Millisecond)
+	logger.Error("service", "CreateTransaction failed", errors.New("boom"))
+	logger.Debug("repository", "Filtering transactions")
+	router.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/ping", nil))
+
+	// Then
+	entries := logs.All()
+	assert.Len(t, entries, 8)
+	for _, entry := range entries {
+		for _, r := range entry.Message {
+			assert.Less(t, r, rune(utf8.RuneSelf), "message %q has non-ASCII characters", entry.Message)
+		}
+	}
+	assert.Equal(t, "CreateTransaction started", entries[0].Message)
+	assert.Equal(t, "HTTP Response", entries[7].Message)
+}
+
+func TestEmojiMessage_EnabledByDefault(t *testing.T) {
+	assert.Equal(t, "🗄️ Create started", middleware.EmojiMessage("🗄️", "Create started"))
+}
+
+func TestPerformance_EscalatesAboveSlowThreshold(t *testing.T) {
+	// Given
+	core, logs := observer.New(zapcore.DebugLevel)
+	logger := middleware.BusinessLoggerWithSink(zap.New(core))
+	middleware.SetSlowThreshold(100 * time.Millisecond)
+	t.Cleanup(func() { middleware.SetSlowThreshold(500 * time.Millisecond) })
+
+	// When
+	logger.Performance("GetTransactions service call", 20*time.Millisecond)
+	logger.Performance("GetTransactions service call", 250*time.Millisecond)
+
+	// Then
+	entries := logs.All()
+	if !assert.Len(t, entries, 2) {
+		return
+	}
+	assert.Equal(t, zapcore.DebugLevel, entries[0].Level)
+	assert.Equal(t, zapcore.WarnLevel, entries[1].Level)
+	assert.Equal(t, 100*time.Millisecond, entries[1].ContextMap()["threshold"])
+}
+
+func TestPerformance_ZeroThresholdNeverWarns(t *testing.T) {
+	// Given
+	core, logs := observer.New(zapcore.DebugLevel)
+	logger := middleware.BusinessLoggerWithSink(zap.New(core))
+	middleware.SetSlowThreshold(0)
+	t.Cleanup(func() { middleware.SetSlowThreshold(500 * time.Millisecond) })
+
+	// When
+	logger.Performance("ImportTransactions service calls", time.Minute)
+
+	// Then
+	if assert.Len(t, logs.All(), 1) {
+		assert.Equal(t, zapcore.DebugLevel, logs.All()[0].Level)
+	}
+}
+
+func TestZapLoggerWithConfig_SamplesSuccessesButKeepsErrors(t *testing.T) {
+	// Given
+	core, logs := observer.New(zapcore.DebugLevel)
+	middleware.Logger = zap.New(core)
+
+	config := middleware.ProductionLogConfig()
+	config.SampleEvery = 3
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	router.Use(middleware.ZapLoggerWithConfig(config))
+	router.GET("/ok", func(c *gin.Context) { c.Status(http.StatusOK) })
+	router.GET("/missing", func(c *gin.Context) { c.Status(http.StatusNotFound) })
+	router.GET("/broken", func(c *gin.Context) { c.Status(http.StatusInternalServerError) })
+
+	// When
+	for i := 0; i < 6; i++ {
+		router.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/ok", nil))
+	}
+	for i := 0; i < 2; i++ {
+		router.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/missing", nil))
+		router.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/broken", nil))
+	}
+
+	// Then - 2 of 6 successes and every failure, each as a request and a response entry
+	responses := logs.FilterMessageSnippet("HTTP Response").All()
+	statuses := make(map[int64]int)
+	for _, entry := range responses {
+		statuses[entry.ContextMap()["status_code"].(int64)]++
+	}
+	assert.Equal(t, map[int64]int{200: 2, 404: 2, 500: 2}, statuses)
+	assert.Len(t, logs.FilterMessageSnippet("HTTP Request").All(), 6)
+}
+
+func TestZapLoggerWithConfig_NoSamplingLogsEverything(t *testing.T) {
+	// Given
+	core, logs := observer.New(zapcore.DebugLevel)
+	middleware.Logger = zap.New(core)
+
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	router.Use(middleware.ZapLoggerWithConfig(middleware.ProductionLogConfig()))
+	router.GET("/ok", func(c *gin.Context) { c.Status(http.StatusOK) })
+
+	// When
+	for i := 0; i < 4; i++ {
+		router.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/ok", nil))
+	}
+
+	// Then
+	assert.Len(t, logs.FilterMessageSnippet("HTTP Response").All(), 4)
+}
@@ -0,0 +1,205 @@
+package middleware
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+)
+
+// TimeoutConfig holds request timeout configuration
+type TimeoutConfig struct {
+	Timeout     time.Duration
+	ExemptPaths []string // Streaming endpoints that must not be buffered
+}
+
+// DefaultTimeoutConfig returns a default timeout configuration
+func DefaultTimeoutConfig() TimeoutConfig {
+	return TimeoutConfig{
+		Timeout:     30 * time.Second,
+		ExemptPaths: []string{},
+	}
+}
+
+// Timeout returns a timeout middleware with the given duration
+func Timeout(timeout time.Duration) gin.HandlerFunc {
+	config := DefaultTimeoutConfig()
+	config.Timeout = timeout
+	return TimeoutWithConfig(config)
+}
+
+// TimeoutWithConfig returns a middleware that bounds each request with a deadline.
+// The handler chain runs with a buffered writer; if the deadline passes first, a 503
+// is sent to the client and anything the handler writes afterwards is discarded.
+func TimeoutWithConfig(config TimeoutConfig) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if config.Timeout <= 0 || isTimeoutExempt(c, config) {
+			c.Next()
+			return
+		}
+
+		ctx, cancel := context.WithTimeout(c.Request.Context(), config.Timeout)
+		defer cancel()
+		c.Request = c.Request.WithContext(ctx)
+
+		original := c.Writer
+		tw := &timeoutWriter{
+			ResponseWriter: original,
+			header:         make(http.Header),
+			body:           &bytes.Buffer{},
+			status:         http.StatusOK,
+		}
+		c.Writer = tw
+
+		done := make(chan struct{})
+		var panicValue interface{}
+
+		go func() {
+			defer func() {
+				panicValue = recover()
+				close(done)
+			}()
+			c.Next()
+		}()
+
+		select {
+		case <-done:
+			tw.flush()
+		case <-ctx.Done():
+			tw.timeout(c)
+			// Wait for the handler so the context is not reused while still in use
+			<-done
+		}
+
+		c.Writer = original
+
+		if panicValue != nil {
+			panic(panicValue)
+		}
+	}
+}
+
+// isTimeoutExempt checks if the request targets a streaming endpoint. Only the path
+// counts: a client asking for text/event-stream must not lift the deadline anywhere.
+func isTimeoutExempt(c *gin.Context, config TimeoutConfig) bool {
+	for _, path := range config.ExemptPaths {
+		if c.Request.URL.Path == path {
+			return true
+		}
+	}
+	return false
+}
+
+// timeoutWriter buffers the response until the handler finishes or times out
+type timeoutWriter struct {
+	gin.ResponseWriter
+	mu          sync.Mutex
+	header      http.Header
+	body        *bytes.Buffer
+	status      int
+	wroteHeader bool
+	timedOut    bool
+}
+
+func (w *timeoutWriter) Header() http.Header {
+	return w.header
+}
+
+func (w *timeoutWriter) WriteHeader(code int) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if w.timedOut || w.wroteHeader {
+		return
+	}
+	w.status = code
+	w.wroteHeader = true
+}
+
+func (w *timeoutWriter) WriteHeaderNow() {
+	w.WriteHeader(w.status)
+}
+
+func (w *timeoutWriter) Write(b []byte) (int, error) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if w.timedOut {
+		return 0, http.ErrHandlerTimeout
+	}
+	w.wroteHeader = true
+	return w.body.Write(b)
+}
+
+func (w *timeoutWriter) WriteString(s string) (int, error) {
+	return w.Write([]byte(s))
+}
+
+func (w *timeoutWriter) Status() int {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	return w.status
+}
+
+func (w *timeoutWriter) Size() int {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if !w.wroteHeader {
+		return -1
+	}
+	return w.body.Len()
+}
+
+func (w *timeoutWriter) Written() bool {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	return w.wroteHeader
+}
+
+// Flush is a no-op while buffering; the body is sent once the handler completes
+func (w *timeoutWriter) Flush() {}
+
+// flush copies the buffered response to the underlying writer
+func (w *timeoutWriter) flush() {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	dst := w.ResponseWriter.Header()
+	for key, values := range w.header {
+		dst[key] = values
+	}
+	if w.wroteHeader {
+		w.ResponseWriter.WriteHeader(w.status)
+	}
+	w.ResponseWriter.Write(w.body.Bytes())
+}
+
+// timeout sends the timeout response and discards any later handler output
+func (w *timeoutWriter) timeout(c *gin.Context) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	w.timedOut = true
+	w.status = http.StatusServiceUnavailable
+	w.wroteHeader = true
+
+	Logger.Warn(EmojiMessage("⏱️", "Request timed out"),
+		zap.String("method", c.Request.Method),
+		zap.String("path", c.Request.URL.Path),
+	)
+
+	body, _ := json.Marshal(gin.H{
+		"error":   "Service Unavailable",
+		"message": "request timed out",
+		"status":  http.StatusServiceUnavailable,
+	})
+	w.ResponseWriter.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.ResponseWriter.WriteHeader(http.StatusServiceUnavailable)
+	w.ResponseWriter.Write(body)
+	w.ResponseWriter.Flush()
+}
@@ -0,0 +1,107 @@
+package middleware_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/maximicciullo/personal-finance-api/internal/middleware"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+type TimeoutMiddlewareTestSuite struct {
+	suite.Suite
+	router *gin.Engine
+}
+
+func (suite *TimeoutMiddlewareTestSuite) SetupTest() {
+	gin.SetMode(gin.TestMode)
+	middleware.InitLogger("test")
+
+	config := middleware.DefaultTimeoutConfig()
+	config.Timeout = 20 * time.Millisecond
+	config.ExemptPaths = []string{"/stream"}
+
+	suite.router = gin.New()
+	suite.router.Use(middleware.TimeoutWithConfig(config))
+
+	slowHandler := func(c *gin.Context) {
+		time.Sleep(100 * time.Millisecond)
+		c.JSON(http.StatusOK, gin.H{"message": "too late"})
+	}
+
+	suite.router.GET("/fast", func(c *gin.Context) {
+		c.Header("X-Custom", "value")
+		c.JSON(http.StatusCreated, gin.H{"message": "ok"})
+	})
+	suite.router.GET("/slow", slowHandler)
+	suite.router.GET("/stream", slowHandler)
+	suite.router.GET("/deadline", func(c *gin.Context) {
+		<-c.Request.Context().Done()
+		c.JSON(http.StatusOK, gin.H{"message": "too late"})
+	})
+}
+
+func (suite *TimeoutMiddlewareTestSuite) serve(path string, headers map[string]string) *httptest.ResponseRecorder {
+	req, _ := http.NewRequest("GET", path, nil)
+	for key, value := range headers {
+		req.Header.Set(key, value)
+	}
+	w := httptest.NewRecorder()
+	suite.router.ServeHTTP(w, req)
+	return w
+}
+
+func (suite *TimeoutMiddlewareTestSuite) TestTimeout_FastHandlerPassesThrough() {
+	// When
+	w := suite.serve("/fast", nil)
+
+	// Then
+	assert.Equal(suite.T(), http.StatusCreated, w.Code)
+	assert.Equal(suite.T(), "value", w.Header().Get("X-Custom"))
+	assert.JSONEq(suite.T(), `{"message":"ok"}`, w.Body.String())
+}
+
+func (suite *TimeoutMiddlewareTestSuite) TestTimeout_SlowHandlerTimesOut() {
+	// When
+	w := suite.serve("/slow", nil)
+
+	// Then
+	assert.Equal(suite.T(), http.StatusServiceUnavailable, w.Code)
+	assert.JSONEq(suite.T(), `{"error":"Service Unavailable","message":"request timed out","status":503}`, w.Body.String())
+	assert.NotContains(suite.T(), w.Body.String(), "too late")
+}
+
+func (suite *TimeoutMiddlewareTestSuite) TestTimeout_ContextDeadlineIsSet() {
+	// When
+	w := suite.serve("/deadline", nil)
+
+	// Then
+	assert.Equal(suite.T(), http.StatusServiceUnavailable, w.Code)
+	assert.NotContains(suite.T(), w.Body.String(), "too late")
+}
+
+func (suite *TimeoutMiddlewareTestSuite) TestTimeout_ExemptPath() {
+	// When
+	w := suite.serve("/stream", nil)
+
+	// Then
+	assert.Equal(suite.T(), http.StatusOK, w.Code)
+	assert.Contains(suite.T(), w.Body.String(), "too late")
+}
+
+func (suite *TimeoutMiddlewareTestSuite) TestTimeout_EventStreamAcceptNotExempt() {
+	// When
+	w := suite.serve("/slow", map[string]string{"Accept": "text/event-stream"})
+
+	// Then
+	assert.Equal(suite.T(), http.StatusServiceUnavailable, w.Code)
+	assert.NotContains(suite.T(), w.Body.String(), "too late")
+}
+
+func TestTimeoutMiddlewareTestSuite(t *testing.T) {
+	suite.Run(t, new(TimeoutMiddlewareTestSuite))
+}
@@ -0,0 +1,64 @@
+package models
+
+import (
+	"errors"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// Amount filter operators
+const (
+	AmountOpEqual          = "="
+	AmountOpGreater        = ">"
+	AmountOpGreaterOrEqual = ">="
+	AmountOpLess           = "<"
+	AmountOpLessOrEqual    = "<="
+)
+
+var ErrInvalidAmountFilter = errors.New("amount must be a number optionally prefixed by >, >=, <, <= or =")
+
+// AmountFilter compares transaction amounts against a value, e.g. ?amount=>=500
+type AmountFilter struct {
+	Operator string
+	Value    Money
+}
+
+// ParseAmountFilter parses an operator-prefixed amount. A bare number means equal.
+func ParseAmountFilter(expr string) (*AmountFilter, error) {
+	expr = strings.TrimSpace(expr)
+
+	operator := AmountOpEqual
+	// Two-character operators first so ">=" is not read as ">" followed by "=500"
+	for _, op := range []string{AmountOpGreaterOrEqual, AmountOpLessOrEqual, AmountOpGreater, AmountOpLess, AmountOpEqual} {
+		if strings.HasPrefix(expr, op) {
+			operator, expr = op, strings.TrimSpace(strings.TrimPrefix(expr, op))
+			break
+		}
+	}
+
+	value, err := strconv.ParseFloat(expr, 64)
+	if err != nil || math.IsNaN(value) || math.IsInf(value, 0) {
+		return nil, ErrInvalidAmountFilter
+	}
+
+	return &AmountFilter{Operator: operator, Value: Money(value)}, nil
+}
+
+// Matches reports whether amount satisfies the filter. Amounts are compared in cents.
+func (f AmountFilter) Matches(amount Money) bool {
+	a, v := math.Round(float64(amount)*100), math.Round(float64(f.Value)*100)
+
+	switch f.Operator {
+	case AmountOpGreater:
+		return a > v
+	case AmountOpGreaterOrEqual:
+		return a >= v
+	case AmountOpLess:
+		return a < v
+	case AmountOpLessOrEqual:
+		return a <= v
+	default:
+		return a == v
+	}
+}
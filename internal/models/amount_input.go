@@ -0,0 +1,83 @@
+package models
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// ErrInvalidAmountInput is returned when a request amount is neither a JSON number
+// nor a string holding one
+var ErrInvalidAmountInput = errors.New(`amount must be a number or a numeric string, e.g. 1500.50 or "1500.50"`)
+
+// UnmarshalJSON accepts the amount as a JSON number or a numeric string
+func (r *CreateTransactionRequest) UnmarshalJSON(data []byte) error {
+	type alias CreateTransactionRequest
+	aux := struct {
+		*alias
+		Amount json.RawMessage `json:"amount"`
+	}{alias: (*alias)(r)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+
+	amount, err := decodeAmount(aux.Amount)
+	if err != nil {
+		return err
+	}
+	r.Amount = 0
+	if amount != nil {
+		r.Amount = *amount
+	}
+	return nil
+}
+
+// UnmarshalJSON accepts the amount as a JSON number or a numeric string
+func (r *UpdateTransactionRequest) UnmarshalJSON(data []byte) error {
+	type alias UpdateTransactionRequest
+	aux := struct {
+		*alias
+		Amount json.RawMessage `json:"amount"`
+	}{alias: (*alias)(r)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+
+	amount, err := decodeAmount(aux.Amount)
+	if err != nil {
+		return err
+	}
+	r.Amount = amount
+	return nil
+}
+
+// decodeAmount returns nil for an absent or null amount
+func decodeAmount(raw json.RawMessage) (*float64, error) {
+	if len(raw) == 0 || bytes.Equal(raw, []byte("null")) {
+		return nil, nil
+	}
+
+	var amount float64
+	if raw[0] == '"' {
+		var text string
+		if err := json.Unmarshal(raw, &text); err != nil {
+			return nil, ErrInvalidAmountInput
+		}
+		parsed, err := strconv.ParseFloat(strings.TrimSpace(text), 64)
+		if err != nil {
+			return nil, ErrInvalidAmountInput
+		}
+		amount = parsed
+	} else if err := json.Unmarshal(raw, &amount); err != nil {
+		return nil, ErrInvalidAmountInput
+	}
+
+	// ParseFloat accepts "NaN" and "Inf", which are not amounts
+	if math.IsNaN(amount) || math.IsInf(amount, 0) {
+		return nil, ErrInvalidAmountInput
+	}
+	return &amount, nil
+}
@@ -0,0 +1,19 @@
+package models
+
+import "time"
+
+const (
+	AuditActionCreate = "create"
+	AuditActionUpdate = "update"
+	AuditActionDelete = "delete"
+)
+
+// AuditEntry records a single change to a transaction. Before is nil for creates
+// and After is nil for deletes.
+type AuditEntry struct {
+	TransactionID int          `json:"transaction_id"`
+	Action        string       `json:"action"` // "create", "update" or "delete"
+	Timestamp     time.Time    `json:"timestamp"`
+	Before        *Transaction `json:"before"`
+	After         *Transaction `json:"after"`
+}
@@ -0,0 +1,39 @@
+package models
+
+import "encoding/json"
+
+// BulkUpdateItem is one edit in a bulk update: the transaction id next to the fields
+// to change, e.g. {"id": 1, "category": "food"}
+type BulkUpdateItem struct {
+	ID      int
+	Changes UpdateTransactionRequest
+}
+
+// UnmarshalJSON reads the id and the changes from the same flat object
+func (i *BulkUpdateItem) UnmarshalJSON(data []byte) error {
+	var id struct {
+		ID int `json:"id"`
+	}
+	if err := json.Unmarshal(data, &id); err != nil {
+		return err
+	}
+	i.ID = id.ID
+	return json.Unmarshal(data, &i.Changes)
+}
+
+// BulkUpdateItemResult is the outcome of one edit, with the HTTP status and warnings
+// the same edit would have received from PATCH /transactions/:id
+type BulkUpdateItemResult struct {
+	ID          int          `json:"id"`
+	Status      int          `json:"status"`
+	Transaction *Transaction `json:"transaction,omitempty"`
+	Warnings    []Warning    `json:"warnings,omitempty"`
+	Error       string       `json:"error,omitempty"`
+}
+
+// BulkUpdateResult lists each edit's outcome in request order with a summary
+type BulkUpdateResult struct {
+	Updated int                    `json:"updated"`
+	Failed  int                    `json:"failed"`
+	Results []BulkUpdateItemResult `json:"results"`
+}
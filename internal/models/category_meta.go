@@ -0,0 +1,17 @@
+package models
+
+import "time"
+
+// CategoryMeta holds display hints for a category so front-ends can render it consistently
+type CategoryMeta struct {
+	Category  string    `json:"category"`
+	Color     string    `json:"color,omitempty"`
+	Icon      string    `json:"icon,omitempty"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// CategoryMetaRequest sets or replaces a category's display metadata
+type CategoryMetaRequest struct {
+	Color string `json:"color"`
+	Icon  string `json:"icon"`
+}
@@ -0,0 +1,27 @@
+package models
+
+import (
+	"fmt"
+	"time"
+)
+
+// ClosedMonth marks a month as closed; transactions dated in it can no longer change
+type ClosedMonth struct {
+	Year     int       `json:"year"`
+	Month    int       `json:"month"`
+	ClosedAt time.Time `json:"closed_at"`
+}
+
+// MonthClosedError is returned when a write touches a transaction dated in a closed month
+type MonthClosedError struct {
+	Year  int
+	Month int
+}
+
+func (e *MonthClosedError) Error() string {
+	return fmt.Sprintf("month %04d-%02d is closed", e.Year, e.Month)
+}
+
+func (e *MonthClosedError) Is(target error) bool {
+	return target == ErrMonthClosed
+}
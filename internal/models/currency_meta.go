@@ -0,0 +1,96 @@
+package models
+
+import (
+	"fmt"
+	"strings"
+	"sync/atomic"
+)
+
+// MaxCurrencyDecimals bounds the decimal places a currency may be configured with
+const MaxCurrencyDecimals = 4
+
+// CurrencyMeta describes how amounts in a currency are displayed
+type CurrencyMeta struct {
+	Symbol   string `json:"symbol"`
+	Decimals int    `json:"decimals"` // Minor unit digits; report totals are rounded to them
+}
+
+// builtinCurrencyMeta covers common ISO 4217 currencies. Others default to their code
+// as symbol and two decimals.
+var builtinCurrencyMeta = map[string]CurrencyMeta{
+	"ARS": {Symbol: "$", Decimals: 2},
+	"AUD": {Symbol: "A$", Decimals: 2},
+	"BRL": {Symbol: "R$", Decimals: 2},
+	"CAD": {Symbol: "CA$", Decimals: 2},
+	"CHF": {Symbol: "CHF", Decimals: 2},
+	"CLP": {Symbol: "$", Decimals: 0},
+	"CNY": {Symbol: "¥", Decimals: 2},
+	"COP": {Symbol: "$", Decimals: 2},
+	"EUR": {Symbol: "€", Decimals: 2},
+	"GBP": {Symbol: "£", Decimals: 2},
+	"JPY": {Symbol: "¥", Decimals: 0},
+	"KRW": {Symbol: "₩", Decimals: 0},
+	"MXN": {Symbol: "$", Decimals: 2},
+	"PYG": {Symbol: "₲", Decimals: 0},
+	"USD": {Symbol: "$", Decimals: 2},
+	"UYU": {Symbol: "$U", Decimals: 2},
+}
+
+var currencyMeta atomic.Value
+
+// SetCurrencyMeta applies symbol and decimal overrides on top of the built-in table,
+// keyed by currency code. It is meant to be called once at startup.
+func SetCurrencyMeta(symbols map[string]string, decimals map[string]int) error {
+	table := make(map[string]CurrencyMeta, len(builtinCurrencyMeta))
+	for code, meta := range builtinCurrencyMeta {
+		table[code] = meta
+	}
+
+	for code, symbol := range symbols {
+		code = strings.ToUpper(code)
+		meta := currencyMetaIn(table, code)
+		meta.Symbol = symbol
+		table[code] = meta
+	}
+	for code, places := range decimals {
+		if places < 0 || places > MaxCurrencyDecimals {
+			return fmt.Errorf("decimals for %s must be between 0 and %d", code, MaxCurrencyDecimals)
+		}
+		code = strings.ToUpper(code)
+		meta := currencyMetaIn(table, code)
+		meta.Decimals = places
+		table[code] = meta
+	}
+
+	currencyMeta.Store(table)
+	return nil
+}
+
+// CurrencyMetaTable returns the metadata for every known currency
+func CurrencyMetaTable() map[string]CurrencyMeta {
+	table := currentCurrencyMeta()
+	result := make(map[string]CurrencyMeta, len(table))
+	for code, meta := range table {
+		result[code] = meta
+	}
+	return result
+}
+
+// CurrencyMetaFor returns a currency's metadata, its code and two decimals when unknown
+func CurrencyMetaFor(currency string) CurrencyMeta {
+	return currencyMetaIn(currentCurrencyMeta(), strings.ToUpper(currency))
+}
+
+func currentCurrencyMeta() map[string]CurrencyMeta {
+	if table, ok := currencyMeta.Load().(map[string]CurrencyMeta); ok {
+		return table
+	}
+	return builtinCurrencyMeta
+}
+
+func currencyMetaIn(table map[string]CurrencyMeta, code string) CurrencyMeta {
+	if meta, ok := table[code]; ok {
+		return meta
+	}
+	return CurrencyMeta{Symbol: code, Decimals: 2}
+}
@@ -0,0 +1,37 @@
+package models_test
+
+import (
+	"testing"
+
+	"github.com/maximicciullo/personal-finance-api/internal/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCurrencyMetaFor_BuiltinDecimals(t *testing.T) {
+	assert.Equal(t, 0, models.CurrencyMetaFor("JPY").Decimals)
+	assert.Equal(t, 2, models.CurrencyMetaFor("USD").Decimals)
+	assert.Equal(t, models.CurrencyMeta{Symbol: "XYZ", Decimals: 2}, models.CurrencyMetaFor("XYZ"))
+}
+
+func TestSetCurrencyMeta_Overrides(t *testing.T) {
+	// Given
+	t.Cleanup(func() { models.SetCurrencyMeta(nil, nil) })
+
+	// When
+	err := models.SetCurrencyMeta(map[string]string{"ars": "AR$"}, map[string]int{"KWD": 3})
+
+	// Then
+	assert.NoError(t, err)
+	assert.Equal(t, models.CurrencyMeta{Symbol: "AR$", Decimals: 2}, models.CurrencyMetaFor("ARS"))
+	assert.Equal(t, models.CurrencyMeta{Symbol: "KWD", Decimals: 3}, models.CurrencyMetaFor("KWD"))
+	assert.Equal(t, 0, models.CurrencyMetaTable()["JPY"].Decimals)
+}
+
+func TestSetCurrencyMeta_RejectsOutOfRangeDecimals(t *testing.T) {
+	// When
+	err := models.SetCurrencyMeta(nil, map[string]int{"USD": 9})
+
+	// Then
+	assert.EqualError(t, err, "decimals for USD must be between 0 and 4")
+	assert.Equal(t, 2, models.CurrencyMetaFor("USD").Decimals)
+}
@@ -0,0 +1,173 @@
+package models
+
+import (
+	"encoding/json"
+	"fmt"
+	"sync/atomic"
+	"time"
+)
+
+// Date output formats for transaction and report dates in responses
+const (
+	DateFormatRFC3339  = "rfc3339"   // 2024-06-19T00:00:00Z
+	DateFormatEpochMS  = "epoch_ms"  // 1718755200000
+	DateFormatDateOnly = "date_only" // 2024-06-19
+)
+
+var dateOutputFormat atomic.Value
+
+// SetDateOutputFormat selects how dates are rendered in responses. It is meant to be
+// called once at startup.
+func SetDateOutputFormat(format string) error {
+	switch format {
+	case DateFormatRFC3339, DateFormatEpochMS, DateFormatDateOnly:
+		dateOutputFormat.Store(format)
+		return nil
+	default:
+		return fmt.Errorf("date output format must be one of: %s, %s, %s", DateFormatRFC3339, DateFormatEpochMS, DateFormatDateOnly)
+	}
+}
+
+// DateOutputFormat returns the format dates are rendered in, rfc3339 unless set
+func DateOutputFormat() string {
+	if format, ok := dateOutputFormat.Load().(string); ok {
+		return format
+	}
+	return DateFormatRFC3339
+}
+
+// outputDate returns the JSON value for t in the configured format
+func outputDate(t time.Time) interface{} {
+	switch DateOutputFormat() {
+	case DateFormatEpochMS:
+		return t.UnixMilli()
+	case DateFormatDateOnly:
+		return t.Format("2006-01-02")
+	default:
+		return t
+	}
+}
+
+// outputDatePtr is outputDate for optional dates; nil stays nil so omitempty applies
+func outputDatePtr(t *time.Time) interface{} {
+	if t == nil {
+		return nil
+	}
+	return outputDate(*t)
+}
+
+type transactionJSON Transaction
+
+func (t Transaction) MarshalJSON() ([]byte, error) {
+	amount, baseAmount := transactionAmounts(t)
+	return json.Marshal(struct {
+		transactionJSON
+		Date       interface{}     `json:"date"`
+		Amount     currencyAmount  `json:"amount"`
+		BaseAmount *currencyAmount `json:"base_amount,omitempty"`
+	}{transactionJSON(t), outputDate(t.Date), amount, baseAmount})
+}
+
+// MarshalJSON is needed because the embedded Transaction's marshaler would otherwise
+// be promoted and drop RunningBalance
+func (e LedgerEntry) MarshalJSON() ([]byte, error) {
+	amount, baseAmount := transactionAmounts(e.Transaction)
+	return json.Marshal(struct {
+		transactionJSON
+		Date           interface{}     `json:"date"`
+		Amount         currencyAmount  `json:"amount"`
+		BaseAmount     *currencyAmount `json:"base_amount,omitempty"`
+		RunningBalance currencyAmount  `json:"running_balance"`
+	}{transactionJSON(e.Transaction), outputDate(e.Date), amount, baseAmount, inCurrency(e.RunningBalance, e.Currency)})
+}
+
+func (t TransactionWithWarnings) MarshalJSON() ([]byte, error) {
+	amount, baseAmount := transactionAmounts(t.Transaction)
+	return json.Marshal(struct {
+		transactionJSON
+		Date       interface{}     `json:"date"`
+		Amount     currencyAmount  `json:"amount"`
+		BaseAmount *currencyAmount `json:"base_amount,omitempty"`
+		Warnings   []Warning       `json:"warnings,omitempty"`
+	}{transactionJSON(t.Transaction), outputDate(t.Date), amount, baseAmount, t.Warnings})
+}
+
+func (t TransactionAmountsAsStrings) MarshalJSON() ([]byte, error) {
+	var baseAmount string
+	if t.BaseAmount != 0 {
+		baseAmount = t.BaseAmount.FixedIn(t.BaseCurrency)
+	}
+	return json.Marshal(struct {
+		transactionJSON
+		Date       interface{} `json:"date"`
+		Amount     string      `json:"amount"`
+		BaseAmount string      `json:"base_amount,omitempty"`
+		Warnings   []Warning   `json:"warnings,omitempty"`
+	}{transactionJSON(t.Transaction), outputDate(t.Date), t.Amount.FixedIn(t.Currency), baseAmount, t.Warnings})
+}
+
+// transactionAmounts renders amount and base_amount with the decimals of their
+// currencies; base_amount is nil when unset so omitempty applies
+func transactionAmounts(t Transaction) (currencyAmount, *currencyAmount) {
+	var baseAmount *currencyAmount
+	if t.BaseAmount != 0 {
+		converted := inCurrency(t.BaseAmount, t.BaseCurrency)
+		baseAmount = &converted
+	}
+	return inCurrency(t.Amount, t.Currency), baseAmount
+}
+
+type groupedReportJSON GroupedReport
+
+func (r GroupedReport) MarshalJSON() ([]byte, error) {
+	return json.Marshal(struct {
+		groupedReportJSON
+		FromDate interface{} `json:"from_date,omitempty"`
+		ToDate   interface{} `json:"to_date,omitempty"`
+	}{groupedReportJSON(r), outputDatePtr(r.FromDate), outputDatePtr(r.ToDate)})
+}
+
+type netWorthReportJSON NetWorthReport
+
+func (r NetWorthReport) MarshalJSON() ([]byte, error) {
+	return json.Marshal(struct {
+		netWorthReportJSON
+		From interface{} `json:"from"`
+		To   interface{} `json:"to"`
+	}{netWorthReportJSON(r), outputDate(r.From), outputDate(r.To)})
+}
+
+type netWorthPeriodJSON NetWorthPeriod
+
+func (p NetWorthPeriod) MarshalJSON() ([]byte, error) {
+	return json.Marshal(struct {
+		netWorthPeriodJSON
+		PeriodStart interface{} `json:"period_start"`
+		PeriodEnd   interface{} `json:"period_end"`
+	}{netWorthPeriodJSON(p), outputDate(p.PeriodStart), outputDate(p.PeriodEnd)})
+}
+
+type balanceReportJSON BalanceReport
+
+func (r BalanceReport) MarshalJSON() ([]byte, error) {
+	var consolidated *currencyAmount
+	if r.ConsolidatedBalance != nil {
+		amount := inCurrency(*r.ConsolidatedBalance, r.BaseCurrency)
+		consolidated = &amount
+	}
+	return json.Marshal(struct {
+		balanceReportJSON
+		AsOf                interface{}     `json:"as_of"`
+		ConsolidatedBalance *currencyAmount `json:"consolidated_balance,omitempty"`
+	}{balanceReportJSON(r), outputDate(r.AsOf), consolidated})
+}
+
+type anomalyReportJSON AnomalyReport
+
+func (r AnomalyReport) MarshalJSON() ([]byte, error) {
+	return json.Marshal(struct {
+		anomalyReportJSON
+		FromDate interface{} `json:"from_date,omitempty"`
+		ToDate   interface{} `json:"to_date,omitempty"`
+	}{anomalyReportJSON(r), outputDatePtr(r.FromDate), outputDatePtr(r.ToDate)})
+}
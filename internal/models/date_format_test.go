@@ -0,0 +1,112 @@
+package models_test
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/maximicciullo/personal-finance-api/internal/models"
+	"github.com/stretchr/testify/assert"
+)
+
+// withDateOutputFormat switches the output format for one test
+func withDateOutputFormat(t *testing.T, format string) {
+	t.Helper()
+	previous := models.DateOutputFormat()
+	assert.NoError(t, models.SetDateOutputFormat(format))
+	t.Cleanup(func() { models.SetDateOutputFormat(previous) })
+}
+
+func TestDateOutputFormat_Serialization(t *testing.T) {
+	date := time.Date(2024, 6, 19, 0, 0, 0, 0, time.UTC)
+	periodEnd := time.Date(2024, 6, 30, 23, 59, 59, 999999999, time.UTC)
+
+	testCases := []struct {
+		format    string
+		date      interface{}
+		periodEnd interface{}
+	}{
+		{models.DateFormatRFC3339, "2024-06-19T00:00:00Z", "2024-06-30T23:59:59.999999999Z"},
+		{models.DateFormatEpochMS, float64(1718755200000), float64(1719791999999)},
+		{models.DateFormatDateOnly, "2024-06-19", "2024-06-30"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.format, func(t *testing.T) {
+			// Given
+			withDateOutputFormat(t, tc.format)
+			transaction := models.Transaction{ID: 1, Amount: 100, Tags: []string{"work"}, Date: date, CreatedAt: date}
+			ledger := models.LedgerEntry{Transaction: transaction, RunningBalance: 250}
+			netWorth := models.NetWorthReport{
+				From: date, To: periodEnd,
+				Periods: []models.NetWorthPeriod{{PeriodStart: date, PeriodEnd: periodEnd}},
+			}
+			grouped := models.GroupedReport{FromDate: &date}
+
+			// When
+			var transactionJSON, ledgerJSON, netWorthJSON, groupedJSON map[string]interface{}
+			unmarshal(t, transaction, &transactionJSON)
+			unmarshal(t, ledger, &ledgerJSON)
+			unmarshal(t, netWorth, &netWorthJSON)
+			unmarshal(t, grouped, &groupedJSON)
+
+			// Then
+			assert.Equal(t, tc.date, transactionJSON["date"])
+			assert.Equal(t, "2024-06-19T00:00:00Z", transactionJSON["created_at"])
+			assert.Equal(t, []interface{}{"work"}, transactionJSON["tags"])
+
+			assert.Equal(t, tc.date, ledgerJSON["date"])
+			assert.Equal(t, 250.0, ledgerJSON["running_balance"])
+			assert.Equal(t, 1.0, ledgerJSON["id"])
+
+			assert.Equal(t, tc.date, netWorthJSON["from"])
+			assert.Equal(t, tc.periodEnd, netWorthJSON["to"])
+			period := netWorthJSON["periods"].([]interface{})[0].(map[string]interface{})
+			assert.Equal(t, tc.date, period["period_start"])
+			assert.Equal(t, tc.periodEnd, period["period_end"])
+
+			assert.Equal(t, tc.date, groupedJSON["from_date"])
+			assert.NotContains(t, groupedJSON, "to_date")
+		})
+	}
+}
+
+func TestSetDateOutputFormat_RejectsUnknownFormat(t *testing.T) {
+	// When
+	err := models.SetDateOutputFormat("unix")
+
+	// Then
+	assert.EqualError(t, err, "date output format must be one of: rfc3339, epoch_ms, date_only")
+	assert.Equal(t, models.DateFormatRFC3339, models.DateOutputFormat())
+}
+
+func unmarshal(t *testing.T, value interface{}, target *map[string]interface{}) {
+	t.Helper()
+	data, err := json.Marshal(value)
+	assert.NoError(t, err)
+	assert.NoError(t, json.Unmarshal(data, target))
+}
+
+func TestTransactionAmountsAsStrings_Serialization(t *testing.T) {
+	// Given
+	withDateOutputFormat(t, models.DateFormatDateOnly)
+	date := time.Date(2024, 6, 19, 0, 0, 0, 0, time.UTC)
+	transaction := models.Transaction{ID: 1, Amount: 1500.5, BaseAmount: 1.5, BaseCurrency: "USD", Date: date, CreatedAt: date}
+	response := models.TransactionAmountsAsStrings{
+		Transaction: transaction,
+		Warnings:    []models.Warning{{Code: models.WarningFarDate, Message: "check the date"}},
+	}
+
+	// When
+	var withBase, withoutBase map[string]interface{}
+	unmarshal(t, response, &withBase)
+	response.BaseAmount = 0
+	unmarshal(t, response, &withoutBase)
+
+	// Then
+	assert.Equal(t, "1500.50", withBase["amount"])
+	assert.Equal(t, "1.50", withBase["base_amount"])
+	assert.Equal(t, "2024-06-19", withBase["date"])
+	assert.Len(t, withBase["warnings"], 1)
+	assert.NotContains(t, withoutBase, "base_amount")
+}
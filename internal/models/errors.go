@@ -0,0 +1,16 @@
+package models
+
+import "errors"
+
+var (
+	ErrTransactionNotFound  = errors.New("transaction not found")
+	ErrTemplateNotFound     = errors.New("template not found")
+	ErrCategoryMetaNotFound = errors.New("category metadata not found")
+	ErrCurrencyRequired     = errors.New("running balance requires a currency filter")
+	ErrTopExpensesCurrency  = errors.New("currency is required, amounts in different currencies are not comparable")
+	ErrMonthClosed          = errors.New("month is closed")
+	ErrMonthNotClosed       = errors.New("month is not closed")
+	ErrStorageFull          = errors.New("transaction limit reached")
+	ErrReservedCategory     = errors.New("category is reserved")
+	ErrInvalidGroupField    = errors.New("field must be one of: category, currency, type")
+)
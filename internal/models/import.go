@@ -0,0 +1,13 @@
+package models
+
+// ImportError describes a statement record that could not be imported
+type ImportError struct {
+	Record  int    `json:"record"` // 1-based position in the file
+	Message string `json:"message"`
+}
+
+// ImportResult lists the transactions created from a file and the records skipped
+type ImportResult struct {
+	Imported []Transaction `json:"imported"`
+	Errors   []ImportError `json:"errors"`
+}
@@ -0,0 +1,25 @@
+package models
+
+// Integrity issue codes
+const (
+	IntegrityDuplicateID   = "duplicate_id"    // Two records share an id
+	IntegrityNextIDTooLow  = "next_id_too_low" // The next id to assign is already taken or below one
+	IntegrityInvalidRecord = "invalid_record"  // A record is missing required fields or has impossible values
+	IntegrityIndexMismatch = "index_mismatch"  // The id lookup index disagrees with the stored records
+)
+
+// IntegrityReport is the result of checking a store's invariants. OK is true when no
+// issues were found.
+type IntegrityReport struct {
+	OK               bool             `json:"ok"`
+	TransactionCount int              `json:"transaction_count"`
+	NextID           int              `json:"next_id"`
+	Issues           []IntegrityIssue `json:"issues"`
+}
+
+// IntegrityIssue is one broken invariant, with the transaction it concerns when there is one
+type IntegrityIssue struct {
+	Code          string `json:"code"`
+	TransactionID int    `json:"transaction_id,omitempty"`
+	Message       string `json:"message"`
+}
@@ -0,0 +1,158 @@
+package models
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"strconv"
+)
+
+// Money is an amount in a single currency. On its own it marshals to a JSON number with
+// exactly two decimal places, so 15000 renders as 15000.00 and 1500.5 as 1500.50. Where
+// the currency is known, amounts render with that currency's decimals instead, e.g.
+// 1500 for JPY.
+type Money float64
+
+func (m Money) MarshalJSON() ([]byte, error) {
+	return currencyAmount{amount: m}.MarshalJSON()
+}
+
+// Fixed formats the amount with exactly two decimal places, e.g. "15000.00"
+func (m Money) Fixed() string {
+	return strconv.FormatFloat(float64(m), 'f', 2, 64)
+}
+
+// FixedIn formats the amount with the currency's decimal places, e.g. "15000.00" for
+// ARS and "1500" for JPY
+func (m Money) FixedIn(currency string) string {
+	return strconv.FormatFloat(float64(m), 'f', CurrencyMetaFor(currency).Decimals, 64)
+}
+
+// CurrencyAmounts holds one amount per currency code. Each marshals with its
+// currency's decimal places.
+type CurrencyAmounts map[string]Money
+
+func (a CurrencyAmounts) MarshalJSON() ([]byte, error) {
+	if a == nil {
+		return []byte("null"), nil
+	}
+
+	amounts := make(map[string]currencyAmount, len(a))
+	for currency, amount := range a {
+		amounts[currency] = currencyAmount{amount: amount, currency: currency}
+	}
+	return json.Marshal(amounts)
+}
+
+// currencyAmount is an amount that marshals with its currency's decimal places; with no
+// currency it keeps two
+type currencyAmount struct {
+	amount   Money
+	currency string
+}
+
+func inCurrency(amount Money, currency string) currencyAmount {
+	return currencyAmount{amount: amount, currency: currency}
+}
+
+func (a currencyAmount) MarshalJSON() ([]byte, error) {
+	value := float64(a.amount)
+	if math.IsNaN(value) || math.IsInf(value, 0) {
+		return nil, fmt.Errorf("unsupported money value: %v", value)
+	}
+	if a.currency == "" {
+		return []byte(a.amount.Fixed()), nil
+	}
+	return []byte(a.amount.FixedIn(a.currency)), nil
+}
+
+// The marshalers below render amounts whose currency is a sibling field or map key
+
+type templateJSON Template
+
+func (t Template) MarshalJSON() ([]byte, error) {
+	var amount *currencyAmount
+	if t.Amount != 0 {
+		preset := inCurrency(t.Amount, t.Currency)
+		amount = &preset
+	}
+	return json.Marshal(struct {
+		templateJSON
+		Amount *currencyAmount `json:"amount,omitempty"`
+	}{templateJSON(t), amount})
+}
+
+type categoryStatsJSON CategoryStats
+
+func (s CategoryStats) MarshalJSON() ([]byte, error) {
+	return json.Marshal(struct {
+		categoryStatsJSON
+		Mean   currencyAmount `json:"mean"`
+		StdDev currencyAmount `json:"std_dev"`
+	}{categoryStatsJSON(s), inCurrency(s.Mean, s.Currency), inCurrency(s.StdDev, s.Currency)})
+}
+
+type amountOutlierJSON AmountOutlier
+
+func (o AmountOutlier) MarshalJSON() ([]byte, error) {
+	currency := o.Transaction.Currency
+	return json.Marshal(struct {
+		amountOutlierJSON
+		Mean   currencyAmount `json:"mean"`
+		StdDev currencyAmount `json:"std_dev"`
+	}{amountOutlierJSON(o), inCurrency(o.Mean, currency), inCurrency(o.StdDev, currency)})
+}
+
+type savingsRateReportJSON SavingsRateReport
+
+type savingsRateJSON struct {
+	Income   currencyAmount `json:"income"`
+	Expense  currencyAmount `json:"expense"`
+	Rate     *float64       `json:"rate"`
+	NoIncome bool           `json:"no_income"`
+}
+
+func (r SavingsRateReport) MarshalJSON() ([]byte, error) {
+	var rates map[string]savingsRateJSON
+	if r.Rates != nil {
+		rates = make(map[string]savingsRateJSON, len(r.Rates))
+		for currency, rate := range r.Rates {
+			rates[currency] = savingsRateJSON{
+				Income:   inCurrency(rate.Income, currency),
+				Expense:  inCurrency(rate.Expense, currency),
+				Rate:     rate.Rate,
+				NoIncome: rate.NoIncome,
+			}
+		}
+	}
+	return json.Marshal(struct {
+		savingsRateReportJSON
+		Rates map[string]savingsRateJSON `json:"rates"`
+	}{savingsRateReportJSON(r), rates})
+}
+
+type monthDiffReportJSON MonthDiffReport
+
+type currencyDeltaJSON struct {
+	Income  currencyAmount `json:"income"`
+	Expense currencyAmount `json:"expense"`
+	Balance currencyAmount `json:"balance"`
+}
+
+func (r MonthDiffReport) MarshalJSON() ([]byte, error) {
+	var currencies map[string]currencyDeltaJSON
+	if r.Currencies != nil {
+		currencies = make(map[string]currencyDeltaJSON, len(r.Currencies))
+		for currency, delta := range r.Currencies {
+			currencies[currency] = currencyDeltaJSON{
+				Income:  inCurrency(delta.Income, currency),
+				Expense: inCurrency(delta.Expense, currency),
+				Balance: inCurrency(delta.Balance, currency),
+			}
+		}
+	}
+	return json.Marshal(struct {
+		monthDiffReportJSON
+		Currencies map[string]currencyDeltaJSON `json:"currencies"`
+	}{monthDiffReportJSON(r), currencies})
+}
@@ -0,0 +1,54 @@
+package models_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/maximicciullo/personal-finance-api/internal/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMoney_SerializesWithCurrencyDecimals(t *testing.T) {
+	// Given
+	t.Cleanup(func() { models.SetCurrencyMeta(nil, nil) })
+	assert.NoError(t, models.SetCurrencyMeta(nil, map[string]int{"KWD": 3}))
+
+	testCases := []struct {
+		currency string
+		amount   models.Money
+		want     string
+	}{
+		{"JPY", 1500.4, "1500"},
+		{"USD", 1500.4, "1500.40"},
+		{"KWD", 1.2346, "1.235"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.currency, func(t *testing.T) {
+			transaction := models.Transaction{ID: 1, Amount: tc.amount, Currency: tc.currency}
+			sums := models.CurrencyAmounts{tc.currency: tc.amount}
+
+			// When
+			transactionJSON, err := json.Marshal(transaction)
+			assert.NoError(t, err)
+			sumsJSON, err := json.Marshal(sums)
+			assert.NoError(t, err)
+			asStringsJSON, err := json.Marshal(models.TransactionAmountsAsStrings{Transaction: transaction})
+			assert.NoError(t, err)
+
+			// Then
+			assert.Contains(t, string(transactionJSON), `"amount":`+tc.want+`}`)
+			assert.Equal(t, `{"`+tc.currency+`":`+tc.want+`}`, string(sumsJSON))
+			assert.Contains(t, string(asStringsJSON), `"amount":"`+tc.want+`"`)
+		})
+	}
+}
+
+func TestMoney_SerializesTwoDecimalsWithoutCurrency(t *testing.T) {
+	// When
+	data, err := json.Marshal(models.Money(1500.4))
+
+	// Then
+	assert.NoError(t, err)
+	assert.Equal(t, "1500.40", string(data))
+}
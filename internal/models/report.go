@@ -1,13 +1,50 @@
 package models
 
+import "time"
+
+const (
+	GroupByCategory = "category"
+	GroupByCurrency = "currency"
+	GroupByType     = "type"
+)
+
+const (
+	GranularityDay   = "day"
+	GranularityWeek  = "week"
+	GranularityMonth = "month"
+	GranularityYear  = "year"
+)
+
 type MonthlyReport struct {
-	Month        string             `json:"month"`
-	Year         int                `json:"year"`
-	TotalIncome  map[string]float64 `json:"total_income"`  // By currency
-	TotalExpense map[string]float64 `json:"total_expense"` // By currency
-	Balance      map[string]float64 `json:"balance"`       // By currency
-	Transactions []Transaction      `json:"transactions"`
-	Summary      ReportSummary      `json:"summary"`
+	Month            string                     `json:"month"`
+	Year             int                        `json:"year"`
+	TotalIncome      CurrencyAmounts            `json:"total_income"`                // By currency
+	TotalExpense     CurrencyAmounts            `json:"total_expense"`               // By currency
+	Balance          CurrencyAmounts            `json:"balance"`                     // By currency
+	ProjectedExpense CurrencyAmounts            `json:"projected_expense,omitempty"` // Month-to-date pace, current month only
+	AccountBalances  map[string]CurrencyAmounts `json:"account_balances"`            // By account, then currency
+	Transactions     []Transaction              `json:"transactions"`
+	Summary          ReportSummary              `json:"summary"`
+	Source           *ReportSource              `json:"source,omitempty"`    // Query behind the report, for auditing
+	Projected        *ProjectedTotals           `json:"projected,omitempty"` // Current month only, with ?include_projected=true
+}
+
+// ProjectedTotals sums the pending transactions dated from today to the end of the
+// current month: money that is expected but has not moved yet. It is reported apart
+// from the actual totals.
+type ProjectedTotals struct {
+	Income           CurrencyAmounts `json:"income"`  // By currency
+	Expense          CurrencyAmounts `json:"expense"` // By currency
+	Balance          CurrencyAmounts `json:"balance"` // By currency
+	TransactionCount int             `json:"transaction_count"`
+}
+
+// ReportSource records the date range a report queried and how long the query took.
+// Boundaries are always RFC3339 so month-edge issues can be diagnosed exactly.
+type ReportSource struct {
+	StartDate       time.Time `json:"start_date"`
+	EndDate         time.Time `json:"end_date"`
+	QueryDurationMs float64   `json:"query_duration_ms"`
 }
 
 type ReportSummary struct {
@@ -18,6 +55,169 @@ type ReportSummary struct {
 }
 
 type CategoryTotal struct {
-	Count  int                `json:"count"`
-	Totals map[string]float64 `json:"totals"` // By currency
+	Count            int                `json:"count"`
+	Totals           CurrencyAmounts    `json:"totals"`             // By currency
+	PercentOfIncome  map[string]float64 `json:"percent_of_income"`  // Share of total income, by currency
+	PercentOfExpense map[string]float64 `json:"percent_of_expense"` // Share of total expense, by currency
+	Color            string             `json:"color,omitempty"`    // From the category metadata store, when set
+	Icon             string             `json:"icon,omitempty"`
+}
+
+type GroupedReport struct {
+	Field            string                `json:"field"`
+	FromDate         *time.Time            `json:"from_date,omitempty"`
+	ToDate           *time.Time            `json:"to_date,omitempty"`
+	TransactionCount int                   `json:"transaction_count"`
+	Groups           map[string]GroupTotal `json:"groups"`
+}
+
+// GroupTotal keeps income and expense apart so a group mixing both types still
+// reports meaningful figures
+type GroupTotal struct {
+	Count   int             `json:"count"`
+	Income  CurrencyAmounts `json:"income"`  // By currency
+	Expense CurrencyAmounts `json:"expense"` // By currency
+	Balance CurrencyAmounts `json:"balance"` // Income minus expense, by currency
+}
+
+type NetWorthReport struct {
+	From           time.Time        `json:"from"`
+	To             time.Time        `json:"to"`
+	Granularity    string           `json:"granularity"`
+	OpeningBalance CurrencyAmounts  `json:"opening_balance"` // By currency
+	Periods        []NetWorthPeriod `json:"periods"`
+}
+
+type NetWorthPeriod struct {
+	PeriodStart time.Time       `json:"period_start"`
+	PeriodEnd   time.Time       `json:"period_end"`
+	Net         CurrencyAmounts `json:"net"`     // Income minus expense in the period, by currency
+	Balance     CurrencyAmounts `json:"balance"` // Running balance at the end of the period, by currency
+}
+
+type BalanceReport struct {
+	AsOf             time.Time       `json:"as_of"`
+	TransactionCount int             `json:"transaction_count"`
+	Balance          CurrencyAmounts `json:"balance"` // Income minus expense up to AsOf, by currency
+	BaseCurrency     string          `json:"base_currency,omitempty"`
+	// ConsolidatedBalance is the balance converted into BaseCurrency. It is omitted
+	// when a rate is missing, in which case MissingRates lists the currencies without one.
+	ConsolidatedBalance *Money   `json:"consolidated_balance,omitempty"`
+	MissingRates        []string `json:"missing_rates,omitempty"`
+}
+
+// AnomalyReport flags transactions that look like data-entry mistakes
+type AnomalyReport struct {
+	FromDate                *time.Time              `json:"from_date,omitempty"`
+	ToDate                  *time.Time              `json:"to_date,omitempty"`
+	TransactionCount        int                     `json:"transaction_count"`
+	Stats                   []CategoryStats         `json:"stats"`
+	MixedCurrencyCategories []MixedCurrencyCategory `json:"mixed_currency_categories"`
+	Outliers                []AmountOutlier         `json:"outliers"`
+}
+
+// CategoryStats summarizes the amounts of a category in one currency
+type CategoryStats struct {
+	Category string `json:"category"`
+	Currency string `json:"currency"`
+	Count    int    `json:"count"`
+	Mean     Money  `json:"mean"`
+	StdDev   Money  `json:"std_dev"` // Population standard deviation
+}
+
+// MixedCurrencyCategory is a category recorded in more than one currency. Transactions
+// outside its most common currency are listed as likely mistakes.
+type MixedCurrencyCategory struct {
+	Category            string         `json:"category"`
+	CurrencyCounts      map[string]int `json:"currency_counts"`
+	DominantCurrency    string         `json:"dominant_currency"`
+	OtherTransactionIDs []int          `json:"other_transaction_ids"`
+}
+
+// AmountOutlier is a transaction far from the mean of its category and currency
+type AmountOutlier struct {
+	Transaction Transaction `json:"transaction"`
+	Mean        Money       `json:"mean"`
+	StdDev      Money       `json:"std_dev"`
+	ZScore      float64     `json:"z_score"`
+}
+
+// CategoryTrendReport is a category's expense over consecutive months, oldest first
+type CategoryTrendReport struct {
+	Category string               `json:"category"`
+	Months   int                  `json:"months"`
+	Series   []CategoryTrendPoint `json:"series"`
+}
+
+// CategoryTrendPoint is a category's expense in one month. Months without expenses
+// have an empty map.
+type CategoryTrendPoint struct {
+	Year    int             `json:"year"`
+	Month   int             `json:"month"`
+	Expense CurrencyAmounts `json:"expense"` // By currency
+}
+
+// SavingsRateReport is the share of a month's income that was not spent, per currency
+type SavingsRateReport struct {
+	Month string                 `json:"month"`
+	Year  int                    `json:"year"`
+	Rates map[string]SavingsRate `json:"rates"` // By currency
+}
+
+// SavingsRate is (income - expense) / income. Rate is null and NoIncome set when the
+// currency had expenses but no income, since the ratio is undefined.
+type SavingsRate struct {
+	Income   Money    `json:"income"`
+	Expense  Money    `json:"expense"`
+	Rate     *float64 `json:"rate"` // 0.25 saves a quarter of income; negative means overspending
+	NoIncome bool     `json:"no_income"`
+}
+
+// IncomeExpenseReport is a month's income and expense totals by currency, the subset of
+// the monthly report that income vs expense charts need
+type IncomeExpenseReport struct {
+	Income  CurrencyAmounts `json:"income"`
+	Expense CurrencyAmounts `json:"expense"`
+}
+
+// MonthlyTotals is the monthly report reduced to its totals, for clients that need
+// neither the transaction list nor the category breakdown
+type MonthlyTotals struct {
+	Month            string          `json:"month"`
+	Year             int             `json:"year"`
+	TotalIncome      CurrencyAmounts `json:"total_income"`  // By currency
+	TotalExpense     CurrencyAmounts `json:"total_expense"` // By currency
+	Balance          CurrencyAmounts `json:"balance"`       // By currency
+	TransactionCount int             `json:"transaction_count"`
+}
+
+// MonthDiffReport compares month B against month A, e.g. June against May. Every delta
+// is B minus A, so a positive expense delta means B spent more.
+type MonthDiffReport struct {
+	A          string                   `json:"a"` // YYYY-MM
+	B          string                   `json:"b"` // YYYY-MM
+	Currencies map[string]CurrencyDelta `json:"currencies"`
+	Categories map[string]CategoryDelta `json:"categories"`
+}
+
+// CurrencyDelta is the change in one currency's monthly totals
+type CurrencyDelta struct {
+	Income  Money `json:"income"`
+	Expense Money `json:"expense"`
+	Balance Money `json:"balance"`
+}
+
+// CategoryDelta is a category's totals in each month and their change, by currency.
+// A category missing from one month has empty totals there and counts as zero.
+type CategoryDelta struct {
+	A     CurrencyAmounts `json:"a"`
+	B     CurrencyAmounts `json:"b"`
+	Delta CurrencyAmounts `json:"delta"`
+}
+
+// MonthCount is a calendar month (UTC) that holds transactions, for month pickers
+type MonthCount struct {
+	Year             int `json:"year"`
+	Month            int `json:"month"`
+	TransactionCount int `json:"transaction_count"`
 }
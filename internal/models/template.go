@@ -0,0 +1,29 @@
+package models
+
+import "time"
+
+// Template holds preset transaction fields for quick entry. Fields left empty must be
+// supplied as overrides when the template is applied.
+type Template struct {
+	ID          int       `json:"id"`
+	Name        string    `json:"name"`
+	Type        string    `json:"type,omitempty"`
+	Amount      Money     `json:"amount,omitempty"`
+	Currency    string    `json:"currency,omitempty"`
+	Description string    `json:"description,omitempty"`
+	Category    string    `json:"category,omitempty"`
+	Account     string    `json:"account,omitempty"`
+	CreatedAt   time.Time `json:"created_at"`
+	UpdatedAt   time.Time `json:"updated_at"`
+}
+
+// TemplateRequest creates a template or replaces all of its fields
+type TemplateRequest struct {
+	Name        string  `json:"name" binding:"required"`
+	Type        string  `json:"type" binding:"omitempty,oneof=expense income"`
+	Amount      float64 `json:"amount" binding:"omitempty,gt=0"`
+	Currency    string  `json:"currency"`
+	Description string  `json:"description"`
+	Category    string  `json:"category"`
+	Account     string  `json:"account"`
+}
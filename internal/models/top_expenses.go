@@ -0,0 +1,12 @@
+package models
+
+import "time"
+
+// TopExpensesQuery selects the largest expenses in a single currency, optionally within
+// a date range. Amounts in different currencies are not comparable, so Currency is required.
+type TopExpensesQuery struct {
+	Currency string
+	FromDate *time.Time
+	ToDate   *time.Time
+	Limit    int // Non-positive returns every match
+}
@@ -1,12 +1,21 @@
 package models
 
-import "time"
+import (
+	"maps"
+	"slices"
+	"time"
+)
 
 const (
 	TransactionTypeExpense = "expense"
 	TransactionTypeIncome  = "income"
 )
 
+const (
+	TransactionStatusPending = "pending"
+	TransactionStatusCleared = "cleared"
+)
+
 const (
 	CurrencyARS = "ARS"
 	CurrencyUSD = "USD"
@@ -14,39 +23,187 @@ const (
 )
 
 type Transaction struct {
-	ID          int       `json:"id"`
-	Type        string    `json:"type"` // "expense" or "income"
-	Amount      float64   `json:"amount"`
-	Currency    string    `json:"currency"` // "ARS", "USD", etc.
-	Description string    `json:"description"`
-	Category    string    `json:"category"` // "food", "salary", "rent", etc.
-	Date        time.Time `json:"date"`
-	CreatedAt   time.Time `json:"created_at"`
-	UpdatedAt   time.Time `json:"updated_at"`
+	ID           int               `json:"id"`
+	Type         string            `json:"type"` // "expense" or "income"
+	Amount       Money             `json:"amount"`
+	Currency     string            `json:"currency"`              // "ARS", "USD", etc.
+	BaseAmount   Money             `json:"base_amount,omitempty"` // Amount in BaseCurrency at creation
+	BaseCurrency string            `json:"base_currency,omitempty"`
+	Description  string            `json:"description"`
+	Category     string            `json:"category"`          // "food", "salary", "rent", etc.
+	Account      string            `json:"account,omitempty"` // Optional: "cash", "bank", "credit_card", etc.
+	Pinned       bool              `json:"pinned,omitempty"`
+	Status       string            `json:"status"` // "pending" or "cleared"
+	Tags         []string          `json:"tags,omitempty"`
+	Metadata     map[string]string `json:"metadata,omitempty"` // Integration-specific fields
+	Date         time.Time         `json:"date"`
+	CreatedAt    time.Time         `json:"created_at"`
+	UpdatedAt    time.Time         `json:"updated_at"`
+}
+
+// Equal reports whether both transactions hold the same values, comparing dates by instant
+func (t Transaction) Equal(other Transaction) bool {
+	return t.ID == other.ID &&
+		t.Type == other.Type &&
+		t.Amount == other.Amount &&
+		t.Currency == other.Currency &&
+		t.BaseAmount == other.BaseAmount &&
+		t.BaseCurrency == other.BaseCurrency &&
+		t.Description == other.Description &&
+		t.Category == other.Category &&
+		t.Account == other.Account &&
+		t.Pinned == other.Pinned &&
+		t.Status == other.Status &&
+		slices.Equal(t.Tags, other.Tags) &&
+		maps.Equal(t.Metadata, other.Metadata) &&
+		t.Date.Equal(other.Date) &&
+		t.CreatedAt.Equal(other.CreatedAt) &&
+		t.UpdatedAt.Equal(other.UpdatedAt)
+}
+
+// Clone returns a copy of the transaction that shares no slices or maps with it, so
+// changes to either one never reach the other
+func (t Transaction) Clone() Transaction {
+	t.Tags = slices.Clone(t.Tags)
+	t.Metadata = maps.Clone(t.Metadata)
+	return t
 }
 
 type CreateTransactionRequest struct {
-	Type        string  `json:"type" binding:"required,oneof=expense income"`
-	Amount      float64 `json:"amount" binding:"required,gt=0"`
-	Currency    string  `json:"currency"`
-	Description string  `json:"description" binding:"required"`
-	Category    string  `json:"category" binding:"required"`
-	Date        *string `json:"date,omitempty"` // Optional, format: YYYY-MM-DD
+	Type         string            `json:"type" binding:"required,oneof=expense income"`
+	Amount       float64           `json:"amount" binding:"required,gt=0"`
+	Currency     string            `json:"currency"`
+	BaseCurrency string            `json:"base_currency"` // Optional, also stores the amount converted into it
+	Description  string            `json:"description" binding:"required"`
+	Category     string            `json:"category" binding:"required"`
+	Account      string            `json:"account"`
+	Pinned       bool              `json:"pinned"`
+	Status       string            `json:"status" binding:"omitempty,oneof=pending cleared"` // Optional, defaults to "cleared"
+	Tags         []string          `json:"tags"`
+	Metadata     map[string]string `json:"metadata"`       // Optional key-value pairs, see MaxMetadataKeys
+	Date         *string           `json:"date,omitempty"` // Optional, format: YYYY-MM-DD
 }
 
 type UpdateTransactionRequest struct {
-	Type        *string  `json:"type,omitempty" binding:"omitempty,oneof=expense income"`
-	Amount      *float64 `json:"amount,omitempty" binding:"omitempty,gt=0"`
-	Currency    *string  `json:"currency,omitempty"`
-	Description *string  `json:"description,omitempty"`
-	Category    *string  `json:"category,omitempty"`
-	Date        *string  `json:"date,omitempty"` // Optional, format: YYYY-MM-DD
+	Type        *string            `json:"type,omitempty" binding:"omitempty,oneof=expense income"`
+	Amount      *float64           `json:"amount,omitempty" binding:"omitempty,gt=0"`
+	Currency    *string            `json:"currency,omitempty"`
+	Description *string            `json:"description,omitempty"`
+	Category    *string            `json:"category,omitempty"`
+	Account     *string            `json:"account,omitempty"`
+	Pinned      *bool              `json:"pinned,omitempty"`
+	Status      *string            `json:"status,omitempty" binding:"omitempty,oneof=pending cleared"`
+	Tags        *[]string          `json:"tags,omitempty"`     // Replaces all tags; [] clears them
+	Metadata    *map[string]string `json:"metadata,omitempty"` // Replaces all metadata; {} clears it
+	Date        *string            `json:"date,omitempty"`     // Optional, format: YYYY-MM-DD
+}
+
+// ValidationResult reports whether a payload passes validation and, if not, the error
+// for each invalid field
+type ValidationResult struct {
+	Valid  bool              `json:"valid"`
+	Errors map[string]string `json:"errors,omitempty"`
+}
+
+type BatchTransactionsResponse struct {
+	Transactions []Transaction `json:"transactions"`
+	MissingIDs   []int         `json:"missing_ids"`
+}
+
+// ListResponse is the v2 envelope for list endpoints
+type ListResponse struct {
+	Data interface{} `json:"data"`
+	Meta ListMeta    `json:"meta"`
+}
+
+// ListMeta describes the page returned in a ListResponse
+type ListMeta struct {
+	Count  int `json:"count"`
+	Limit  int `json:"limit,omitempty"` // Omitted when unpaginated
+	Offset int `json:"offset"`
+}
+
+// IsPending reports whether the transaction has not cleared yet. Transactions stored
+// without a status count as cleared.
+func (t Transaction) IsPending() bool {
+	return t.Status == TransactionStatusPending
 }
 
+// LedgerEntry is a transaction annotated with the balance after it, for register views
+type LedgerEntry struct {
+	Transaction
+	RunningBalance Money `json:"running_balance"`
+}
+
+// Warning codes, stable for clients to match on
+const (
+	WarningFarDate = "far_date" // Date is far from the creation date, often a typo in the year
+)
+
+// Warning is a non-blocking note about a created or updated transaction. The write
+// went through; the client may want to double check it.
+type Warning struct {
+	Code    string `json:"code"`
+	Message string `json:"message"`
+}
+
+// TransactionWithWarnings is the create and update response: the transaction plus any
+// warnings about it. Warnings are omitted when there are none.
+type TransactionWithWarnings struct {
+	Transaction
+	Warnings []Warning `json:"warnings,omitempty"`
+}
+
+// TransactionAmountsAsStrings is a create or update response whose amount and
+// base_amount are strings with their currency's decimals, such as "15000.00", for
+// clients that display amounts exactly without parsing floats
+type TransactionAmountsAsStrings TransactionWithWarnings
+
 type TransactionFilters struct {
-	Type     string
-	Category string
-	Currency string
-	FromDate *time.Time
-	ToDate   *time.Time
+	Type              string
+	Category          string
+	Currency          string
+	Account           string
+	ExcludeCategories []string          // Applied after the inclusion filters
+	Metadata          map[string]string // Every key must be present with exactly this value
+	Pinned            *bool
+	Status            string
+	Amount            *AmountFilter
+	IncludePending    bool           // Reports only: pending transactions are left out unless set
+	HideZero          bool           // Reports only: drop breakdown categories whose income and expense cancel out
+	Location          *time.Location // Reports only: month boundaries are computed in it; nil means UTC
+	FromDate          *time.Time
+	ToDate            *time.Time
+	UpdatedSince      *time.Time // Only transactions updated at or after it, ordered by UpdatedAt then ID
+	Limit             int        // 0 means no limit
+	Offset            int
+}
+
+// TransactionChange is a stored transaction before and after a bulk update
+type TransactionChange struct {
+	Before Transaction
+	After  Transaction
+}
+
+// RecategorizeRequest moves every transaction in category From, optionally narrowed
+// by Filters, to category To
+type RecategorizeRequest struct {
+	From    string              `json:"from" binding:"required"`
+	To      string              `json:"to" binding:"required"`
+	Filters RecategorizeFilters `json:"filters"`
+}
+
+type RecategorizeFilters struct {
+	Type     string  `json:"type" binding:"omitempty,oneof=expense income"`
+	Currency string  `json:"currency"`
+	Account  string  `json:"account"`
+	Status   string  `json:"status" binding:"omitempty,oneof=pending cleared"`
+	FromDate *string `json:"from_date"` // Format: YYYY-MM-DD
+	ToDate   *string `json:"to_date"`   // Format: YYYY-MM-DD, inclusive
+}
+
+type RecategorizeResult struct {
+	From    string `json:"from"`
+	To      string `json:"to"`
+	Updated int    `json:"updated"`
 }
@@ -1,17 +1,56 @@
 package repositories
 
 import (
+	"context"
 	"time"
 
 	"github.com/maximicciullo/personal-finance-api/internal/models"
 )
 
 type TransactionRepository interface {
-	Create(transaction *models.Transaction) error
-	GetByID(id int) (*models.Transaction, error)
-	GetAll() ([]models.Transaction, error)
-	GetByFilters(filters models.TransactionFilters) ([]models.Transaction, error)
-	GetByDateRange(startDate, endDate time.Time) ([]models.Transaction, error)
-	Delete(id int) error
-	Update(transaction *models.Transaction) error
+	Create(ctx context.Context, transaction *models.Transaction) error
+	GetByID(ctx context.Context, id int) (*models.Transaction, error)
+	GetByIDs(ctx context.Context, ids []int) ([]models.Transaction, error)
+	GetAll(ctx context.Context) ([]models.Transaction, error) // Sorted by ID ascending
+	GetByFilters(ctx context.Context, filters models.TransactionFilters) ([]models.Transaction, error)
+	GetByDateRange(ctx context.Context, startDate, endDate time.Time) ([]models.Transaction, error)
+	GetRecent(ctx context.Context, limit int) ([]models.Transaction, error)
+	GetTopExpenses(ctx context.Context, query models.TopExpensesQuery) ([]models.Transaction, error) // Largest amount first
+	StreamAll(ctx context.Context) (<-chan models.Transaction, <-chan error)
+	Delete(ctx context.Context, id int) error
+	Update(ctx context.Context, transaction *models.Transaction) error
+	Recategorize(ctx context.Context, filters models.TransactionFilters, category string) ([]models.TransactionChange, error)
+	LastModified(ctx context.Context) (time.Time, error)
+	GetMonthCounts(ctx context.Context, includePending bool) ([]models.MonthCount, error) // Oldest month first
+	CheckIntegrity(ctx context.Context) (*models.IntegrityReport, error)                  // Diagnostic, reports without repairing
+	// RunInTransaction runs fn's writes atomically: if fn returns an error, none of them
+	// are kept. fn must use the repository it is given, which a database backend binds
+	// to its transaction.
+	RunInTransaction(ctx context.Context, fn func(TransactionRepository) error) error
+}
+
+type TemplateRepository interface {
+	Create(ctx context.Context, template *models.Template) error
+	GetByID(ctx context.Context, id int) (*models.Template, error)
+	GetAll(ctx context.Context) ([]models.Template, error)
+	Update(ctx context.Context, template *models.Template) error
+	Delete(ctx context.Context, id int) error
+}
+
+type CategoryMetaRepository interface {
+	Set(ctx context.Context, meta *models.CategoryMeta) error
+	Get(ctx context.Context, category string) (*models.CategoryMeta, error)
+	GetAll(ctx context.Context) ([]models.CategoryMeta, error) // Sorted by category
+	Delete(ctx context.Context, category string) error
+}
+
+type ClosedMonthRepository interface {
+	Close(ctx context.Context, year, month int) (*models.ClosedMonth, error) // Closing an already closed month keeps its ClosedAt
+	Reopen(ctx context.Context, year, month int) error
+	IsClosed(ctx context.Context, year, month int) (bool, error)
+}
+
+type AuditRepository interface {
+	Append(ctx context.Context, entry models.AuditEntry) error
+	GetByTransactionID(ctx context.Context, id int) ([]models.AuditEntry, error)
 }
@@ -0,0 +1,106 @@
+package repositories
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/maximicciullo/personal-finance-api/internal/middleware"
+	"github.com/maximicciullo/personal-finance-api/internal/models"
+	"go.uber.org/zap"
+)
+
+// MemoryAuditRepository is an append-only, in-memory audit trail
+type MemoryAuditRepository struct {
+	entries []models.AuditEntry
+	mutex   sync.RWMutex
+	logger  *middleware.BusinessLoggerInstance
+}
+
+func NewMemoryAuditRepository() *MemoryAuditRepository {
+	return &MemoryAuditRepository{
+		entries: make([]models.AuditEntry, 0),
+		logger:  middleware.BusinessLogger(),
+	}
+}
+
+func (r *MemoryAuditRepository) Append(ctx context.Context, entry models.AuditEntry) error {
+	r.logger.Repository("Append audit entry started",
+		zap.Int("transaction_id", entry.TransactionID),
+		zap.String("action", entry.Action),
+	)
+
+	if err := ctx.Err(); err != nil {
+		r.logger.Error("repository", "Append audit entry cancelled", err)
+		return err
+	}
+
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	start := time.Now()
+
+	// Store private copies so later changes to the caller's values cannot rewrite history
+	entry.Before = copyTransaction(entry.Before)
+	entry.After = copyTransaction(entry.After)
+	r.entries = append(r.entries, entry)
+
+	duration := time.Since(start)
+	r.logger.Performance("Append audit entry", duration,
+		zap.Int("total_entries", len(r.entries)),
+	)
+
+	r.logger.Repository("Append audit entry completed successfully",
+		zap.Int("transaction_id", entry.TransactionID),
+		zap.Duration("duration", duration),
+	)
+
+	return nil
+}
+
+func (r *MemoryAuditRepository) GetByTransactionID(ctx context.Context, id int) ([]models.AuditEntry, error) {
+	r.logger.Repository("GetByTransactionID audit entries started",
+		zap.Int("transaction_id", id),
+	)
+
+	if err := ctx.Err(); err != nil {
+		r.logger.Error("repository", "GetByTransactionID audit entries cancelled", err)
+		return nil, err
+	}
+
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+
+	start := time.Now()
+
+	result := make([]models.AuditEntry, 0)
+	for _, entry := range r.entries {
+		if entry.TransactionID == id {
+			entry.Before = copyTransaction(entry.Before)
+			entry.After = copyTransaction(entry.After)
+			result = append(result, entry)
+		}
+	}
+
+	duration := time.Since(start)
+	r.logger.Performance("GetByTransactionID audit search", duration,
+		zap.Int("total_entries", len(r.entries)),
+		zap.Int("matched_count", len(result)),
+	)
+
+	r.logger.Repository("GetByTransactionID audit entries completed successfully",
+		zap.Int("transaction_id", id),
+		zap.Int("matched_count", len(result)),
+		zap.Duration("duration", duration),
+	)
+
+	return result, nil
+}
+
+func copyTransaction(transaction *models.Transaction) *models.Transaction {
+	if transaction == nil {
+		return nil
+	}
+	copied := *transaction
+	return &copied
+}
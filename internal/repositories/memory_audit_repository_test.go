@@ -0,0 +1,75 @@
+package repositories_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/maximicciullo/personal-finance-api/internal/middleware"
+	"github.com/maximicciullo/personal-finance-api/internal/models"
+	"github.com/maximicciullo/personal-finance-api/internal/repositories"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+// MemoryAuditRepositoryTestSuite is the test suite for MemoryAuditRepository
+type MemoryAuditRepositoryTestSuite struct {
+	suite.Suite
+	repo *repositories.MemoryAuditRepository
+}
+
+func (suite *MemoryAuditRepositoryTestSuite) SetupTest() {
+	// Initialize logger for testing
+	middleware.InitLogger("test")
+
+	suite.repo = repositories.NewMemoryAuditRepository()
+}
+
+func (suite *MemoryAuditRepositoryTestSuite) TestGetByTransactionID_ReturnsOnlyMatchingInOrder() {
+	// Given
+	now := time.Now()
+	entries := []models.AuditEntry{
+		{TransactionID: 1, Action: models.AuditActionCreate, Timestamp: now},
+		{TransactionID: 2, Action: models.AuditActionCreate, Timestamp: now},
+		{TransactionID: 1, Action: models.AuditActionUpdate, Timestamp: now.Add(time.Second)},
+	}
+	for _, entry := range entries {
+		assert.NoError(suite.T(), suite.repo.Append(context.Background(), entry))
+	}
+
+	// When
+	result, err := suite.repo.GetByTransactionID(context.Background(), 1)
+
+	// Then
+	assert.NoError(suite.T(), err)
+	assert.Len(suite.T(), result, 2)
+	assert.Equal(suite.T(), models.AuditActionCreate, result[0].Action)
+	assert.Equal(suite.T(), models.AuditActionUpdate, result[1].Action)
+}
+
+func (suite *MemoryAuditRepositoryTestSuite) TestAppend_StoresSnapshotCopies() {
+	// Given
+	transaction := &models.Transaction{ID: 1, Amount: 100}
+	suite.repo.Append(context.Background(), models.AuditEntry{TransactionID: 1, Action: models.AuditActionCreate, After: transaction})
+
+	// When - the caller mutates its value after appending
+	transaction.Amount = 999
+
+	// Then
+	result, err := suite.repo.GetByTransactionID(context.Background(), 1)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), models.Money(100.0), result[0].After.Amount)
+}
+
+func (suite *MemoryAuditRepositoryTestSuite) TestGetByTransactionID_Empty() {
+	// When
+	result, err := suite.repo.GetByTransactionID(context.Background(), 42)
+
+	// Then
+	assert.NoError(suite.T(), err)
+	assert.Empty(suite.T(), result)
+}
+
+func TestMemoryAuditRepositoryTestSuite(t *testing.T) {
+	suite.Run(t, new(MemoryAuditRepositoryTestSuite))
+}
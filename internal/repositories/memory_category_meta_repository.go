@@ -0,0 +1,135 @@
+package repositories
+
+import (
+	"context"
+	"sort"
+	"sync"
+
+	"github.com/maximicciullo/personal-finance-api/internal/middleware"
+	"github.com/maximicciullo/personal-finance-api/internal/models"
+	"go.uber.org/zap"
+)
+
+// MemoryCategoryMetaRepository stores category display metadata in memory, keyed by category
+type MemoryCategoryMetaRepository struct {
+	meta   map[string]models.CategoryMeta
+	mutex  sync.RWMutex
+	logger *middleware.BusinessLoggerInstance
+}
+
+func NewMemoryCategoryMetaRepository() *MemoryCategoryMetaRepository {
+	return &MemoryCategoryMetaRepository{
+		meta:   make(map[string]models.CategoryMeta),
+		logger: middleware.BusinessLogger(),
+	}
+}
+
+// Set creates or replaces the metadata for meta.Category
+func (r *MemoryCategoryMetaRepository) Set(ctx context.Context, meta *models.CategoryMeta) error {
+	r.logger.Repository("Set category meta started",
+		zap.String("category", meta.Category),
+	)
+
+	if err := ctx.Err(); err != nil {
+		r.logger.Error("repository", "Set category meta cancelled", err)
+		return err
+	}
+
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	meta.UpdatedAt = storedNow()
+	r.meta[meta.Category] = *meta
+
+	r.logger.Repository("Set category meta completed successfully",
+		zap.String("category", meta.Category),
+		zap.Int("total_categories", len(r.meta)),
+	)
+
+	return nil
+}
+
+func (r *MemoryCategoryMetaRepository) Get(ctx context.Context, category string) (*models.CategoryMeta, error) {
+	r.logger.Repository("Get category meta started",
+		zap.String("category", category),
+	)
+
+	if err := ctx.Err(); err != nil {
+		r.logger.Error("repository", "Get category meta cancelled", err)
+		return nil, err
+	}
+
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+
+	meta, exists := r.meta[category]
+	if !exists {
+		err := models.ErrCategoryMetaNotFound
+		r.logger.Error("repository", "Get - category meta not found", err,
+			zap.String("category", category),
+		)
+		return nil, err
+	}
+
+	r.logger.Repository("Get category meta completed successfully",
+		zap.String("category", category),
+	)
+
+	return &meta, nil
+}
+
+func (r *MemoryCategoryMetaRepository) GetAll(ctx context.Context) ([]models.CategoryMeta, error) {
+	r.logger.Repository("GetAll category meta started")
+
+	if err := ctx.Err(); err != nil {
+		r.logger.Error("repository", "GetAll category meta cancelled", err)
+		return nil, err
+	}
+
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+
+	result := make([]models.CategoryMeta, 0, len(r.meta))
+	for _, meta := range r.meta {
+		result = append(result, meta)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Category < result[j].Category
+	})
+
+	r.logger.Repository("GetAll category meta completed successfully",
+		zap.Int("category_count", len(result)),
+	)
+
+	return result, nil
+}
+
+func (r *MemoryCategoryMetaRepository) Delete(ctx context.Context, category string) error {
+	r.logger.Repository("Delete category meta started",
+		zap.String("category", category),
+	)
+
+	if err := ctx.Err(); err != nil {
+		r.logger.Error("repository", "Delete category meta cancelled", err)
+		return err
+	}
+
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	if _, exists := r.meta[category]; !exists {
+		err := models.ErrCategoryMetaNotFound
+		r.logger.Error("repository", "Delete - category meta not found", err,
+			zap.String("category", category),
+		)
+		return err
+	}
+	delete(r.meta, category)
+
+	r.logger.Repository("Delete category meta completed successfully",
+		zap.String("category", category),
+		zap.Int("remaining_categories", len(r.meta)),
+	)
+
+	return nil
+}
@@ -0,0 +1,68 @@
+package repositories_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/maximicciullo/personal-finance-api/internal/middleware"
+	"github.com/maximicciullo/personal-finance-api/internal/models"
+	"github.com/maximicciullo/personal-finance-api/internal/repositories"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+// MemoryCategoryMetaRepositoryTestSuite is the test suite for MemoryCategoryMetaRepository
+type MemoryCategoryMetaRepositoryTestSuite struct {
+	suite.Suite
+	repo *repositories.MemoryCategoryMetaRepository
+}
+
+func (suite *MemoryCategoryMetaRepositoryTestSuite) SetupTest() {
+	// Initialize logger for testing
+	middleware.InitLogger("test")
+
+	suite.repo = repositories.NewMemoryCategoryMetaRepository()
+}
+
+func (suite *MemoryCategoryMetaRepositoryTestSuite) TestSetReplacesAndGetAllSortsByCategory() {
+	// Given
+	ctx := context.Background()
+	assert.NoError(suite.T(), suite.repo.Set(ctx, &models.CategoryMeta{Category: "transport", Color: "#0000ff"}))
+	assert.NoError(suite.T(), suite.repo.Set(ctx, &models.CategoryMeta{Category: "food", Color: "#ff0000"}))
+
+	// When
+	err := suite.repo.Set(ctx, &models.CategoryMeta{Category: "food", Icon: "utensils"})
+
+	// Then
+	assert.NoError(suite.T(), err)
+	food, err := suite.repo.Get(ctx, "food")
+	assert.NoError(suite.T(), err)
+	assert.Empty(suite.T(), food.Color)
+	assert.Equal(suite.T(), "utensils", food.Icon)
+	assert.False(suite.T(), food.UpdatedAt.IsZero())
+
+	all, err := suite.repo.GetAll(ctx)
+	assert.NoError(suite.T(), err)
+	if assert.Len(suite.T(), all, 2) {
+		assert.Equal(suite.T(), "food", all[0].Category)
+		assert.Equal(suite.T(), "transport", all[1].Category)
+	}
+}
+
+func (suite *MemoryCategoryMetaRepositoryTestSuite) TestNotFound() {
+	// Given
+	assert.NoError(suite.T(), suite.repo.Set(context.Background(), &models.CategoryMeta{Category: "food", Color: "#ff0000"}))
+	assert.NoError(suite.T(), suite.repo.Delete(context.Background(), "food"))
+
+	// When
+	_, getErr := suite.repo.Get(context.Background(), "food")
+	deleteErr := suite.repo.Delete(context.Background(), "food")
+
+	// Then
+	assert.ErrorIs(suite.T(), getErr, models.ErrCategoryMetaNotFound)
+	assert.ErrorIs(suite.T(), deleteErr, models.ErrCategoryMetaNotFound)
+}
+
+func TestMemoryCategoryMetaRepositoryTestSuite(t *testing.T) {
+	suite.Run(t, new(MemoryCategoryMetaRepositoryTestSuite))
+}
@@ -0,0 +1,107 @@
+package repositories
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/maximicciullo/personal-finance-api/internal/middleware"
+	"github.com/maximicciullo/personal-finance-api/internal/models"
+	"go.uber.org/zap"
+)
+
+// monthKey identifies a calendar month in the closed set
+type monthKey struct {
+	year  int
+	month int
+}
+
+// MemoryClosedMonthRepository keeps the set of closed months in memory
+type MemoryClosedMonthRepository struct {
+	closed map[monthKey]time.Time
+	mutex  sync.RWMutex
+	logger *middleware.BusinessLoggerInstance
+}
+
+func NewMemoryClosedMonthRepository() *MemoryClosedMonthRepository {
+	return &MemoryClosedMonthRepository{
+		closed: make(map[monthKey]time.Time),
+		logger: middleware.BusinessLogger(),
+	}
+}
+
+func (r *MemoryClosedMonthRepository) Close(ctx context.Context, year, month int) (*models.ClosedMonth, error) {
+	r.logger.Repository("Close month started",
+		zap.Int("year", year),
+		zap.Int("month", month),
+	)
+
+	if err := ctx.Err(); err != nil {
+		r.logger.Error("repository", "Close month cancelled", err)
+		return nil, err
+	}
+
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	key := monthKey{year: year, month: month}
+	closedAt, exists := r.closed[key]
+	if !exists {
+		closedAt = time.Now()
+		r.closed[key] = closedAt
+	}
+
+	r.logger.Repository("Close month completed successfully",
+		zap.Int("year", year),
+		zap.Int("month", month),
+		zap.Bool("already_closed", exists),
+	)
+
+	return &models.ClosedMonth{Year: year, Month: month, ClosedAt: closedAt}, nil
+}
+
+func (r *MemoryClosedMonthRepository) Reopen(ctx context.Context, year, month int) error {
+	r.logger.Repository("Reopen month started",
+		zap.Int("year", year),
+		zap.Int("month", month),
+	)
+
+	if err := ctx.Err(); err != nil {
+		r.logger.Error("repository", "Reopen month cancelled", err)
+		return err
+	}
+
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	key := monthKey{year: year, month: month}
+	if _, exists := r.closed[key]; !exists {
+		err := models.ErrMonthNotClosed
+		r.logger.Error("repository", "Reopen - month not closed", err,
+			zap.Int("year", year),
+			zap.Int("month", month),
+		)
+		return err
+	}
+	delete(r.closed, key)
+
+	r.logger.Repository("Reopen month completed successfully",
+		zap.Int("year", year),
+		zap.Int("month", month),
+	)
+
+	return nil
+}
+
+func (r *MemoryClosedMonthRepository) IsClosed(ctx context.Context, year, month int) (bool, error) {
+	if err := ctx.Err(); err != nil {
+		r.logger.Error("repository", "IsClosed cancelled", err)
+		return false, err
+	}
+
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+
+	_, exists := r.closed[monthKey{year: year, month: month}]
+	return exists, nil
+}
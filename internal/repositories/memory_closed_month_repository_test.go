@@ -0,0 +1,53 @@
+package repositories_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/maximicciullo/personal-finance-api/internal/middleware"
+	"github.com/maximicciullo/personal-finance-api/internal/models"
+	"github.com/maximicciullo/personal-finance-api/internal/repositories"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+// MemoryClosedMonthRepositoryTestSuite is the test suite for MemoryClosedMonthRepository
+type MemoryClosedMonthRepositoryTestSuite struct {
+	suite.Suite
+	repo *repositories.MemoryClosedMonthRepository
+}
+
+func (suite *MemoryClosedMonthRepositoryTestSuite) SetupTest() {
+	// Initialize logger for testing
+	middleware.InitLogger("test")
+
+	suite.repo = repositories.NewMemoryClosedMonthRepository()
+}
+
+func (suite *MemoryClosedMonthRepositoryTestSuite) TestCloseAndReopen() {
+	// Given
+	ctx := context.Background()
+	first, err := suite.repo.Close(ctx, 2024, 6)
+	assert.NoError(suite.T(), err)
+
+	// When
+	again, err := suite.repo.Close(ctx, 2024, 6)
+
+	// Then
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), first.ClosedAt, again.ClosedAt)
+
+	june, _ := suite.repo.IsClosed(ctx, 2024, 6)
+	july, _ := suite.repo.IsClosed(ctx, 2024, 7)
+	assert.True(suite.T(), june)
+	assert.False(suite.T(), july)
+
+	assert.NoError(suite.T(), suite.repo.Reopen(ctx, 2024, 6))
+	june, _ = suite.repo.IsClosed(ctx, 2024, 6)
+	assert.False(suite.T(), june)
+	assert.ErrorIs(suite.T(), suite.repo.Reopen(ctx, 2024, 6), models.ErrMonthNotClosed)
+}
+
+func TestMemoryClosedMonthRepositoryTestSuite(t *testing.T) {
+	suite.Run(t, new(MemoryClosedMonthRepositoryTestSuite))
+}
@@ -0,0 +1,170 @@
+package repositories
+
+import (
+	"context"
+	"sync"
+
+	"github.com/maximicciullo/personal-finance-api/internal/middleware"
+	"github.com/maximicciullo/personal-finance-api/internal/models"
+	"go.uber.org/zap"
+)
+
+// MemoryTemplateRepository stores transaction templates in memory, in creation order
+type MemoryTemplateRepository struct {
+	templates []models.Template
+	nextID    int
+	mutex     sync.RWMutex
+	logger    *middleware.BusinessLoggerInstance
+}
+
+func NewMemoryTemplateRepository() *MemoryTemplateRepository {
+	return &MemoryTemplateRepository{
+		templates: make([]models.Template, 0),
+		nextID:    1,
+		logger:    middleware.BusinessLogger(),
+	}
+}
+
+func (r *MemoryTemplateRepository) Create(ctx context.Context, template *models.Template) error {
+	r.logger.Repository("Create template started",
+		zap.String("name", template.Name),
+	)
+
+	if err := ctx.Err(); err != nil {
+		r.logger.Error("repository", "Create template cancelled", err)
+		return err
+	}
+
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	template.ID = r.nextID
+	template.CreatedAt = storedNow()
+	template.UpdatedAt = template.CreatedAt
+
+	r.templates = append(r.templates, *template)
+	r.nextID++
+
+	r.logger.Repository("Create template completed successfully",
+		zap.Int("template_id", template.ID),
+		zap.Int("total_templates", len(r.templates)),
+	)
+
+	return nil
+}
+
+func (r *MemoryTemplateRepository) GetByID(ctx context.Context, id int) (*models.Template, error) {
+	r.logger.Repository("GetByID template started",
+		zap.Int("template_id", id),
+	)
+
+	if err := ctx.Err(); err != nil {
+		r.logger.Error("repository", "GetByID template cancelled", err)
+		return nil, err
+	}
+
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+
+	for _, template := range r.templates {
+		if template.ID == id {
+			r.logger.Repository("GetByID template completed successfully",
+				zap.Int("template_id", id),
+			)
+			return &template, nil
+		}
+	}
+
+	err := models.ErrTemplateNotFound
+	r.logger.Error("repository", "GetByID - template not found", err,
+		zap.Int("template_id", id),
+	)
+	return nil, err
+}
+
+func (r *MemoryTemplateRepository) GetAll(ctx context.Context) ([]models.Template, error) {
+	r.logger.Repository("GetAll templates started")
+
+	if err := ctx.Err(); err != nil {
+		r.logger.Error("repository", "GetAll templates cancelled", err)
+		return nil, err
+	}
+
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+
+	// Return a copy to avoid concurrent modification
+	result := make([]models.Template, len(r.templates))
+	copy(result, r.templates)
+
+	r.logger.Repository("GetAll templates completed successfully",
+		zap.Int("template_count", len(result)),
+	)
+
+	return result, nil
+}
+
+func (r *MemoryTemplateRepository) Update(ctx context.Context, template *models.Template) error {
+	r.logger.Repository("Update template started",
+		zap.Int("template_id", template.ID),
+	)
+
+	if err := ctx.Err(); err != nil {
+		r.logger.Error("repository", "Update template cancelled", err)
+		return err
+	}
+
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	for i, t := range r.templates {
+		if t.ID == template.ID {
+			template.CreatedAt = t.CreatedAt
+			template.UpdatedAt = storedNow()
+			r.templates[i] = *template
+
+			r.logger.Repository("Update template completed successfully",
+				zap.Int("template_id", template.ID),
+			)
+			return nil
+		}
+	}
+
+	err := models.ErrTemplateNotFound
+	r.logger.Error("repository", "Update - template not found", err,
+		zap.Int("template_id", template.ID),
+	)
+	return err
+}
+
+func (r *MemoryTemplateRepository) Delete(ctx context.Context, id int) error {
+	r.logger.Repository("Delete template started",
+		zap.Int("template_id", id),
+	)
+
+	if err := ctx.Err(); err != nil {
+		r.logger.Error("repository", "Delete template cancelled", err)
+		return err
+	}
+
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	for i, template := range r.templates {
+		if template.ID == id {
+			r.templates = append(r.templates[:i], r.templates[i+1:]...)
+
+			r.logger.Repository("Delete template completed successfully",
+				zap.Int("template_id", id),
+				zap.Int("remaining_templates", len(r.templates)),
+			)
+			return nil
+		}
+	}
+
+	err := models.ErrTemplateNotFound
+	r.logger.Error("repository", "Delete - template not found", err,
+		zap.Int("template_id", id),
+	)
+	return err
+}
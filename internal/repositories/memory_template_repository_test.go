@@ -0,0 +1,64 @@
+package repositories_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/maximicciullo/personal-finance-api/internal/middleware"
+	"github.com/maximicciullo/personal-finance-api/internal/models"
+	"github.com/maximicciullo/personal-finance-api/internal/repositories"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+// MemoryTemplateRepositoryTestSuite is the test suite for MemoryTemplateRepository
+type MemoryTemplateRepositoryTestSuite struct {
+	suite.Suite
+	repo *repositories.MemoryTemplateRepository
+}
+
+func (suite *MemoryTemplateRepositoryTestSuite) SetupTest() {
+	// Initialize logger for testing
+	middleware.InitLogger("test")
+
+	suite.repo = repositories.NewMemoryTemplateRepository()
+}
+
+func (suite *MemoryTemplateRepositoryTestSuite) TestCreateUpdateDelete() {
+	// Given
+	template := &models.Template{Name: "coffee", Amount: 1500}
+	assert.NoError(suite.T(), suite.repo.Create(context.Background(), template))
+	assert.Equal(suite.T(), 1, template.ID)
+
+	// When
+	replacement := &models.Template{ID: template.ID, Name: "tea", Amount: 900}
+	err := suite.repo.Update(context.Background(), replacement)
+
+	// Then
+	assert.NoError(suite.T(), err)
+	stored, err := suite.repo.GetByID(context.Background(), template.ID)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), "tea", stored.Name)
+	assert.Equal(suite.T(), template.CreatedAt, stored.CreatedAt)
+
+	assert.NoError(suite.T(), suite.repo.Delete(context.Background(), template.ID))
+	all, err := suite.repo.GetAll(context.Background())
+	assert.NoError(suite.T(), err)
+	assert.Empty(suite.T(), all)
+}
+
+func (suite *MemoryTemplateRepositoryTestSuite) TestNotFound() {
+	// When
+	_, getErr := suite.repo.GetByID(context.Background(), 7)
+	updateErr := suite.repo.Update(context.Background(), &models.Template{ID: 7})
+	deleteErr := suite.repo.Delete(context.Background(), 7)
+
+	// Then
+	assert.ErrorIs(suite.T(), getErr, models.ErrTemplateNotFound)
+	assert.ErrorIs(suite.T(), updateErr, models.ErrTemplateNotFound)
+	assert.ErrorIs(suite.T(), deleteErr, models.ErrTemplateNotFound)
+}
+
+func TestMemoryTemplateRepositoryTestSuite(t *testing.T) {
+	suite.Run(t, new(MemoryTemplateRepositoryTestSuite))
+}
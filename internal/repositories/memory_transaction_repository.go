@@ -1,7 +1,10 @@
 package repositories
 
 import (
-	"errors"
+	"context"
+	"fmt"
+	"math"
+	"sort"
 	"sync"
 	"time"
 
@@ -10,40 +13,78 @@ import (
 	"go.uber.org/zap"
 )
 
+// TimestampPrecision is the resolution of every stored CreatedAt/UpdatedAt, matching what
+// databases commonly keep so responses do not change when the backend does
+const TimestampPrecision = time.Millisecond
+
+// storedNow returns the current time at TimestampPrecision, for stored timestamps
+func storedNow() time.Time {
+	return time.Now().Truncate(TimestampPrecision)
+}
+
+// MemoryTransactionRepositoryConfig holds storage limits for the in-memory repository
+type MemoryTransactionRepositoryConfig struct {
+	MaxTransactions int // Create fails with ErrStorageFull once this many are stored; 0 means unlimited
+}
+
 type MemoryTransactionRepository struct {
+	config       MemoryTransactionRepositoryConfig
 	transactions []models.Transaction
+	positions    map[int]int // Transaction ID -> index in transactions
 	nextID       int
+	lastModified time.Time
 	mutex        sync.RWMutex
 	logger       *middleware.BusinessLoggerInstance
 }
 
 func NewMemoryTransactionRepository() *MemoryTransactionRepository {
+	return NewMemoryTransactionRepositoryWithConfig(MemoryTransactionRepositoryConfig{})
+}
+
+func NewMemoryTransactionRepositoryWithConfig(config MemoryTransactionRepositoryConfig) *MemoryTransactionRepository {
 	return &MemoryTransactionRepository{
+		config:       config,
 		transactions: make([]models.Transaction, 0),
+		positions:    make(map[int]int),
 		nextID:       1,
 		logger:       middleware.BusinessLogger(),
 	}
 }
 
-func (r *MemoryTransactionRepository) Create(transaction *models.Transaction) error {
+func (r *MemoryTransactionRepository) Create(ctx context.Context, transaction *models.Transaction) error {
 	r.logger.Repository("Create transaction started",
 		zap.String("type", transaction.Type),
-		zap.Float64("amount", transaction.Amount),
+		zap.Float64("amount", float64(transaction.Amount)),
 		zap.String("currency", transaction.Currency),
 		zap.String("category", transaction.Category),
 	)
 
+	if err := ctx.Err(); err != nil {
+		r.logger.Error("repository", "Create cancelled", err)
+		return err
+	}
+
 	r.mutex.Lock()
 	defer r.mutex.Unlock()
 
+	if r.config.MaxTransactions > 0 && len(r.transactions) >= r.config.MaxTransactions {
+		err := models.ErrStorageFull
+		r.logger.Error("repository", "Create - transaction limit reached", err,
+			zap.Int("max_transactions", r.config.MaxTransactions),
+		)
+		return err
+	}
+
 	start := time.Now()
 
 	transaction.ID = r.nextID
-	transaction.CreatedAt = time.Now()
-	transaction.UpdatedAt = time.Now()
+	transaction.CreatedAt = storedNow()
+	transaction.UpdatedAt = transaction.CreatedAt
 
-	r.transactions = append(r.transactions, *transaction)
+	r.transactions = append(r.transactions, transaction.Clone())
+	r.positions[transaction.ID] = len(r.transactions) - 1
 	r.nextID++
+	r.lastModified = transaction.UpdatedAt
 
 	duration := time.Since(start)
 	r.logger.Performance("Create transaction", duration,
@@ -60,40 +101,42 @@ func (r *MemoryTransactionRepository) Create(transaction *models.Transaction) er
 	return nil
 }
 
-func (r *MemoryTransactionRepository) GetByID(id int) (*models.Transaction, error) {
+func (r *MemoryTransactionRepository) GetByID(ctx context.Context, id int) (*models.Transaction, error) {
 	r.logger.Repository("GetByID started",
 		zap.Int("transaction_id", id),
 	)
 
+	if err := ctx.Err(); err != nil {
+		r.logger.Error("repository", "GetByID cancelled", err)
+		return nil, err
+	}
+
 	r.mutex.RLock()
 	defer r.mutex.RUnlock()
 
 	start := time.Now()
-	searched := 0
 
-	for _, transaction := range r.transactions {
-		searched++
-		if transaction.ID == id {
-			duration := time.Since(start)
-			r.logger.Performance("GetByID transaction found", duration,
-				zap.Int("transaction_id", id),
-				zap.Int("search_iterations", searched),
-			)
+	if i, ok := r.positions[id]; ok {
+		transaction := r.transactions[i].Clone()
 
-			r.logger.Repository("GetByID completed successfully",
-				zap.Int("transaction_id", id),
-				zap.Duration("duration", duration),
-			)
-			return &transaction, nil
-		}
+		duration := time.Since(start)
+		r.logger.Performance("GetByID transaction found", duration,
+			zap.Int("transaction_id", id),
+			zap.Int("position", i),
+		)
+
+		r.logger.Repository("GetByID completed successfully",
+			zap.Int("transaction_id", id),
+			zap.Duration("duration", duration),
+		)
+		return &transaction, nil
 	}
 
 	duration := time.Since(start)
-	err := errors.New("transaction not found")
-	
+	err := models.ErrTransactionNotFound
+
 	r.logger.Performance("GetByID transaction not found", duration,
 		zap.Int("transaction_id", id),
-		zap.Int("searched_count", searched),
 	)
 
 	r.logger.Error("repository", "GetByID - transaction not found", err,
@@ -104,17 +147,26 @@ func (r *MemoryTransactionRepository) GetByID(id int) (*models.Transaction, erro
 	return nil, err
 }
 
-func (r *MemoryTransactionRepository) GetAll() ([]models.Transaction, error) {
+// GetAll returns a copy of every transaction sorted by ID ascending, whatever order
+// the store holds them in
+func (r *MemoryTransactionRepository) GetAll(ctx context.Context) ([]models.Transaction, error) {
 	r.logger.Repository("GetAll started")
 
+	if err := ctx.Err(); err != nil {
+		r.logger.Error("repository", "GetAll cancelled", err)
+		return nil, err
+	}
+
 	r.mutex.RLock()
 	defer r.mutex.RUnlock()
 
 	start := time.Now()
 
 	// Return a copy to avoid concurrent modification
-	result := make([]models.Transaction, len(r.transactions))
-	copy(result, r.transactions)
+	result := cloneTransactions(r.transactions)
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].ID < result[j].ID
+	})
 
 	duration := time.Since(start)
 	r.logger.Performance("GetAll transactions", duration,
@@ -129,24 +181,34 @@ func (r *MemoryTransactionRepository) GetAll() ([]models.Transaction, error) {
 	return result, nil
 }
 
-func (r *MemoryTransactionRepository) GetByFilters(filters models.TransactionFilters) ([]models.Transaction, error) {
+func (r *MemoryTransactionRepository) GetByFilters(ctx context.Context, filters models.TransactionFilters) ([]models.Transaction, error) {
 	r.logger.Repository("GetByFilters started",
 		zap.String("type_filter", filters.Type),
 		zap.String("category_filter", filters.Category),
 		zap.String("currency_filter", filters.Currency),
+		zap.String("account_filter", filters.Account),
+		zap.Strings("exclude_categories", filters.ExcludeCategories),
+		zap.Int("limit", filters.Limit),
+		zap.Int("offset", filters.Offset),
 	)
 
 	r.mutex.RLock()
 	defer r.mutex.RUnlock()
 
 	start := time.Now()
-	var result []models.Transaction
+	result := make([]models.Transaction, 0)
 	processed := 0
 
 	for _, transaction := range r.transactions {
+		if err := ctx.Err(); err != nil {
+			r.logger.Error("repository", "GetByFilters cancelled", err,
+				zap.Int("processed_transactions", processed),
+			)
+			return nil, err
+		}
 		processed++
 		if r.matchesFilters(transaction, filters) {
-			result = append(result, transaction)
+			result = append(result, transaction.Clone())
 			r.logger.Debug("repository", "Transaction matches filters",
 				zap.Int("transaction_id", transaction.ID),
 				zap.String("type", transaction.Type),
@@ -155,6 +217,19 @@ func (r *MemoryTransactionRepository) GetByFilters(filters models.TransactionFil
 		}
 	}
 
+	// Incremental sync reads changes in the order they happened; changes stored in the
+	// same instant follow ID order so pages never depend on how the slice is stored
+	if filters.UpdatedSince != nil {
+		sort.Slice(result, func(i, j int) bool {
+			if !result[i].UpdatedAt.Equal(result[j].UpdatedAt) {
+				return result[i].UpdatedAt.Before(result[j].UpdatedAt)
+			}
+			return result[i].ID < result[j].ID
+		})
+	}
+
+	result = paginate(result, filters.Offset, filters.Limit)
+
 	duration := time.Since(start)
 	r.logger.Performance("GetByFilters search", duration,
 		zap.Int("total_transactions", len(r.transactions)),
@@ -170,7 +245,34 @@ func (r *MemoryTransactionRepository) GetByFilters(filters models.TransactionFil
 	return result, nil
 }
 
-func (r *MemoryTransactionRepository) GetByDateRange(startDate, endDate time.Time) ([]models.Transaction, error) {
+// cloneTransactions deep-copies transactions so callers can never modify the store
+// through a returned slice field such as Tags
+func cloneTransactions(transactions []models.Transaction) []models.Transaction {
+	result := make([]models.Transaction, len(transactions))
+	for i, transaction := range transactions {
+		result[i] = transaction.Clone()
+	}
+	return result
+}
+
+// paginate returns the page of transactions starting at offset, up to limit items.
+// A limit of zero returns everything after offset.
+func paginate(transactions []models.Transaction, offset, limit int) []models.Transaction {
+	if offset > 0 {
+		if offset >= len(transactions) {
+			return []models.Transaction{}
+		}
+		transactions = transactions[offset:]
+	}
+	if limit > 0 && len(transactions) > limit {
+		transactions = transactions[:limit]
+	}
+	return transactions
+}
+
+// GetByDateRange returns the transactions dated within [startDate, endDate], both
+// boundaries included exactly
+func (r *MemoryTransactionRepository) GetByDateRange(ctx context.Context, startDate, endDate time.Time) ([]models.Transaction, error) {
 	r.logger.Repository("GetByDateRange started",
 		zap.Time("start_date", startDate),
 		zap.Time("end_date", endDate),
@@ -184,9 +286,15 @@ func (r *MemoryTransactionRepository) GetByDateRange(startDate, endDate time.Tim
 	processed := 0
 
 	for _, transaction := range r.transactions {
+		if err := ctx.Err(); err != nil {
+			r.logger.Error("repository", "GetByDateRange cancelled", err,
+				zap.Int("processed_transactions", processed),
+			)
+			return nil, err
+		}
 		processed++
-		if transaction.Date.After(startDate.Add(-time.Second)) && transaction.Date.Before(endDate.Add(time.Second)) {
-			result = append(result, transaction)
+		if !transaction.Date.Before(startDate) && !transaction.Date.After(endDate) {
+			result = append(result, transaction.Clone())
 			r.logger.Debug("repository", "Transaction matches date range",
 				zap.Int("transaction_id", transaction.ID),
 				zap.Time("transaction_date", transaction.Date),
@@ -210,49 +318,283 @@ func (r *MemoryTransactionRepository) GetByDateRange(startDate, endDate time.Tim
 	return result, nil
 }
 
-func (r *MemoryTransactionRepository) Delete(id int) error {
+// GetByIDs returns the transactions matching ids in the order requested, skipping
+// ids that do not exist and repeated ids
+func (r *MemoryTransactionRepository) GetByIDs(ctx context.Context, ids []int) ([]models.Transaction, error) {
+	r.logger.Repository("GetByIDs started",
+		zap.Ints("transaction_ids", ids),
+	)
+
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+
+	start := time.Now()
+
+	wanted := make(map[int]bool, len(ids))
+	for _, id := range ids {
+		wanted[id] = true
+	}
+
+	found := make(map[int]models.Transaction, len(wanted))
+	for _, transaction := range r.transactions {
+		if err := ctx.Err(); err != nil {
+			r.logger.Error("repository", "GetByIDs cancelled", err)
+			return nil, err
+		}
+		if wanted[transaction.ID] {
+			found[transaction.ID] = transaction.Clone()
+		}
+	}
+
+	result := make([]models.Transaction, 0, len(found))
+	for _, id := range ids {
+		if transaction, ok := found[id]; ok {
+			result = append(result, transaction)
+			delete(found, id)
+		}
+	}
+
+	duration := time.Since(start)
+	r.logger.Performance("GetByIDs search", duration,
+		zap.Int("total_transactions", len(r.transactions)),
+		zap.Int("requested_count", len(ids)),
+		zap.Int("found_count", len(result)),
+	)
+
+	r.logger.Repository("GetByIDs completed successfully",
+		zap.Int("found_count", len(result)),
+		zap.Duration("duration", duration),
+	)
+
+	return result, nil
+}
+
+// StreamAll sends every transaction stored when it starts on the returned channel, in
+// insertion order, and closes it when done. Only the ids are copied up front; each
+// record is looked up under its own read lock so a slow consumer never blocks writers.
+// Records deleted mid-stream are skipped, updated ones are sent as they are when reached
+// and ones created mid-stream are not included. If ctx is cancelled, ctx.Err() is sent
+// on the error channel before both channels close.
+func (r *MemoryTransactionRepository) StreamAll(ctx context.Context) (<-chan models.Transaction, <-chan error) {
+	r.logger.Repository("StreamAll started")
+
+	out := make(chan models.Transaction)
+	errs := make(chan error, 1)
+
+	r.mutex.RLock()
+	ids := make([]int, len(r.transactions))
+	for i, transaction := range r.transactions {
+		ids[i] = transaction.ID
+	}
+	r.mutex.RUnlock()
+
+	go func() {
+		defer close(out)
+		defer close(errs)
+
+		start := time.Now()
+		sent := 0
+
+		for _, id := range ids {
+			if err := ctx.Err(); err != nil {
+				r.logger.Repository("StreamAll cancelled",
+					zap.Int("sent_count", sent),
+					zap.Error(err),
+				)
+				errs <- err
+				return
+			}
+
+			r.mutex.RLock()
+			position, exists := r.positions[id]
+			var transaction models.Transaction
+			if exists {
+				transaction = r.transactions[position].Clone()
+			}
+			r.mutex.RUnlock()
+			if !exists {
+				continue
+			}
+
+			select {
+			case out <- transaction:
+				sent++
+			case <-ctx.Done():
+				r.logger.Repository("StreamAll cancelled",
+					zap.Int("sent_count", sent),
+					zap.Error(ctx.Err()),
+				)
+				errs <- ctx.Err()
+				return
+			}
+		}
+
+		r.logger.Repository("StreamAll completed successfully",
+			zap.Int("sent_count", sent),
+			zap.Duration("duration", time.Since(start)),
+		)
+	}()
+
+	return out, errs
+}
+
+// GetRecent returns up to limit transactions ordered by date, newest first
+func (r *MemoryTransactionRepository) GetRecent(ctx context.Context, limit int) ([]models.Transaction, error) {
+	r.logger.Repository("GetRecent started",
+		zap.Int("limit", limit),
+	)
+
+	if err := ctx.Err(); err != nil {
+		r.logger.Error("repository", "GetRecent cancelled", err)
+		return nil, err
+	}
+
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+
+	start := time.Now()
+
+	result := cloneTransactions(r.transactions)
+
+	sortByDateDesc(result)
+
+	if limit >= 0 && len(result) > limit {
+		result = result[:limit]
+	}
+
+	duration := time.Since(start)
+	r.logger.Performance("GetRecent search", duration,
+		zap.Int("total_transactions", len(r.transactions)),
+		zap.Int("returned_count", len(result)),
+	)
+
+	r.logger.Repository("GetRecent completed successfully",
+		zap.Int("returned_count", len(result)),
+		zap.Duration("duration", duration),
+	)
+
+	return result, nil
+}
+
+// GetTopExpenses returns up to query.Limit expenses in query.Currency within the optional
+// date range, largest amount first. Equal amounts keep the newest first, then ascending ID.
+func (r *MemoryTransactionRepository) GetTopExpenses(ctx context.Context, query models.TopExpensesQuery) ([]models.Transaction, error) {
+	r.logger.Repository("GetTopExpenses started",
+		zap.String("currency", query.Currency),
+		zap.Int("limit", query.Limit),
+	)
+
+	if err := ctx.Err(); err != nil {
+		r.logger.Error("repository", "GetTopExpenses cancelled", err)
+		return nil, err
+	}
+
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+
+	start := time.Now()
+
+	filters := models.TransactionFilters{
+		Type:     models.TransactionTypeExpense,
+		Currency: query.Currency,
+		FromDate: query.FromDate,
+		ToDate:   query.ToDate,
+	}
+	result := make([]models.Transaction, 0)
+	for _, transaction := range r.transactions {
+		if r.matchesFilters(transaction, filters) {
+			result = append(result, transaction.Clone())
+		}
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].Amount != result[j].Amount {
+			return result[i].Amount > result[j].Amount
+		}
+		if !result[i].Date.Equal(result[j].Date) {
+			return result[i].Date.After(result[j].Date)
+		}
+		return result[i].ID < result[j].ID
+	})
+
+	if query.Limit > 0 && len(result) > query.Limit {
+		result = result[:query.Limit]
+	}
+
+	duration := time.Since(start)
+	r.logger.Performance("GetTopExpenses search", duration,
+		zap.Int("total_transactions", len(r.transactions)),
+		zap.Int("returned_count", len(result)),
+	)
+
+	r.logger.Repository("GetTopExpenses completed successfully",
+		zap.Int("returned_count", len(result)),
+		zap.Duration("duration", duration),
+	)
+
+	return result, nil
+}
+
+// sortByDateDesc orders transactions newest first, breaking ties on the same date by
+// ascending ID so the order never depends on how the slice happens to be stored
+func sortByDateDesc(transactions []models.Transaction) {
+	sort.Slice(transactions, func(i, j int) bool {
+		if !transactions[i].Date.Equal(transactions[j].Date) {
+			return transactions[i].Date.After(transactions[j].Date)
+		}
+		return transactions[i].ID < transactions[j].ID
+	})
+}
+
+func (r *MemoryTransactionRepository) Delete(ctx context.Context, id int) error {
 	r.logger.Repository("Delete started",
 		zap.Int("transaction_id", id),
 	)
 
+	if err := ctx.Err(); err != nil {
+		r.logger.Error("repository", "Delete cancelled", err)
+		return err
+	}
+
 	r.mutex.Lock()
 	defer r.mutex.Unlock()
 
 	start := time.Now()
-	searched := 0
 
-	for i, transaction := range r.transactions {
-		searched++
-		if transaction.ID == id {
-			// Store transaction info before deletion for logging
-			deletedTransaction := transaction
-			
-			r.transactions = append(r.transactions[:i], r.transactions[i+1:]...)
-			
-			duration := time.Since(start)
-			r.logger.Performance("Delete transaction", duration,
-				zap.Int("transaction_id", id),
-				zap.Int("search_iterations", searched),
-				zap.Int("remaining_transactions", len(r.transactions)),
-			)
+	if i, ok := r.positions[id]; ok {
+		// Store transaction info before deletion for logging
+		deletedTransaction := r.transactions[i]
 
-			r.logger.Repository("Delete completed successfully",
-				zap.Int("transaction_id", id),
-				zap.Int("position", i),
-				zap.String("deleted_type", deletedTransaction.Type),
-				zap.Float64("deleted_amount", deletedTransaction.Amount),
-				zap.Duration("duration", duration),
-			)
-			return nil
+		r.transactions = append(r.transactions[:i], r.transactions[i+1:]...)
+		delete(r.positions, id)
+		// Everything after the removed transaction moved back one position
+		for j := i; j < len(r.transactions); j++ {
+			r.positions[r.transactions[j].ID] = j
 		}
+		r.lastModified = storedNow()
+
+		duration := time.Since(start)
+		r.logger.Performance("Delete transaction", duration,
+			zap.Int("transaction_id", id),
+			zap.Int("shifted_positions", len(r.transactions)-i),
+			zap.Int("remaining_transactions", len(r.transactions)),
+		)
+
+		r.logger.Repository("Delete completed successfully",
+			zap.Int("transaction_id", id),
+			zap.Int("position", i),
+			zap.String("deleted_type", deletedTransaction.Type),
+			zap.Float64("deleted_amount", float64(deletedTransaction.Amount)),
+			zap.Duration("duration", duration),
+		)
+		return nil
 	}
 
 	duration := time.Since(start)
-	err := errors.New("transaction not found")
-	
+	err := models.ErrTransactionNotFound
+
 	r.logger.Performance("Delete transaction not found", duration,
 		zap.Int("transaction_id", id),
-		zap.Int("searched_count", searched),
 	)
 
 	r.logger.Error("repository", "Delete - transaction not found", err,
@@ -263,51 +605,51 @@ func (r *MemoryTransactionRepository) Delete(id int) error {
 	return err
 }
 
-func (r *MemoryTransactionRepository) Update(transaction *models.Transaction) error {
+func (r *MemoryTransactionRepository) Update(ctx context.Context, transaction *models.Transaction) error {
 	r.logger.Repository("Update started",
 		zap.Int("transaction_id", transaction.ID),
 		zap.String("type", transaction.Type),
-		zap.Float64("amount", transaction.Amount),
+		zap.Float64("amount", float64(transaction.Amount)),
 	)
 
+	if err := ctx.Err(); err != nil {
+		r.logger.Error("repository", "Update cancelled", err)
+		return err
+	}
+
 	r.mutex.Lock()
 	defer r.mutex.Unlock()
 
 	start := time.Now()
-	searched := 0
-
-	for i, t := range r.transactions {
-		searched++
-		if t.ID == transaction.ID {
-			// Store old values for logging
-			oldTransaction := t
-			
-			transaction.UpdatedAt = time.Now()
-			r.transactions[i] = *transaction
-			
-			duration := time.Since(start)
-			r.logger.Performance("Update transaction", duration,
-				zap.Int("transaction_id", transaction.ID),
-				zap.Int("search_iterations", searched),
-			)
 
-			r.logger.Repository("Update completed successfully",
-				zap.Int("transaction_id", transaction.ID),
-				zap.Int("position", i),
-				zap.Float64("old_amount", oldTransaction.Amount),
-				zap.Float64("new_amount", transaction.Amount),
-				zap.Duration("duration", duration),
-			)
-			return nil
-		}
+	if i, ok := r.positions[transaction.ID]; ok {
+		// Store old values for logging
+		oldTransaction := r.transactions[i]
+
+		transaction.UpdatedAt = storedNow()
+		r.transactions[i] = transaction.Clone()
+		r.lastModified = transaction.UpdatedAt
+
+		duration := time.Since(start)
+		r.logger.Performance("Update transaction", duration,
+			zap.Int("transaction_id", transaction.ID),
+		)
+
+		r.logger.Repository("Update completed successfully",
+			zap.Int("transaction_id", transaction.ID),
+			zap.Int("position", i),
+			zap.Float64("old_amount", float64(oldTransaction.Amount)),
+			zap.Float64("new_amount", float64(transaction.Amount)),
+			zap.Duration("duration", duration),
+		)
+		return nil
 	}
 
 	duration := time.Since(start)
-	err := errors.New("transaction not found")
-	
+	err := models.ErrTransactionNotFound
+
 	r.logger.Performance("Update transaction not found", duration,
 		zap.Int("transaction_id", transaction.ID),
-		zap.Int("searched_count", searched),
 	)
 
 	r.logger.Error("repository", "Update - transaction not found", err,
@@ -318,6 +660,313 @@ func (r *MemoryTransactionRepository) Update(transaction *models.Transaction) er
 	return err
 }
 
+// Recategorize moves every transaction matching filters to category in one write,
+// returning each changed transaction before and after. Limit and offset are ignored.
+func (r *MemoryTransactionRepository) Recategorize(ctx context.Context, filters models.TransactionFilters, category string) ([]models.TransactionChange, error) {
+	r.logger.Repository("Recategorize started",
+		zap.String("filter_category", filters.Category),
+		zap.String("category", category),
+	)
+
+	if err := ctx.Err(); err != nil {
+		r.logger.Error("repository", "Recategorize cancelled", err)
+		return nil, err
+	}
+
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	start := time.Now()
+	now := storedNow()
+	changes := make([]models.TransactionChange, 0)
+
+	for i, transaction := range r.transactions {
+		if !r.matchesFilters(transaction, filters) {
+			continue
+		}
+
+		updated := transaction.Clone()
+		updated.Category = category
+		updated.UpdatedAt = now
+		r.transactions[i] = updated
+
+		changes = append(changes, models.TransactionChange{
+			Before: transaction.Clone(),
+			After:  updated.Clone(),
+		})
+	}
+
+	if len(changes) > 0 {
+		r.lastModified = now
+	}
+
+	duration := time.Since(start)
+	r.logger.Performance("Recategorize transactions", duration,
+		zap.Int("updated_count", len(changes)),
+		zap.Int("total_transactions", len(r.transactions)),
+	)
+
+	r.logger.Repository("Recategorize completed successfully",
+		zap.Int("updated_count", len(changes)),
+		zap.Duration("duration", duration),
+	)
+
+	return changes, nil
+}
+
+// GetMonthCounts counts the transactions in each UTC calendar month that has any.
+// Pending transactions are left out unless includePending, as in the reports.
+func (r *MemoryTransactionRepository) GetMonthCounts(ctx context.Context, includePending bool) ([]models.MonthCount, error) {
+	r.logger.Repository("GetMonthCounts started",
+		zap.Bool("include_pending", includePending),
+	)
+
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+
+	start := time.Now()
+
+	type monthOf struct {
+		year  int
+		month time.Month
+	}
+	counts := make(map[monthOf]int)
+	for _, transaction := range r.transactions {
+		if err := ctx.Err(); err != nil {
+			r.logger.Error("repository", "GetMonthCounts cancelled", err)
+			return nil, err
+		}
+		if !includePending && transaction.IsPending() {
+			continue
+		}
+		date := transaction.Date.UTC()
+		counts[monthOf{year: date.Year(), month: date.Month()}]++
+	}
+
+	result := make([]models.MonthCount, 0, len(counts))
+	for key, count := range counts {
+		result = append(result, models.MonthCount{Year: key.year, Month: int(key.month), TransactionCount: count})
+	}
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].Year != result[j].Year {
+			return result[i].Year < result[j].Year
+		}
+		return result[i].Month < result[j].Month
+	})
+
+	duration := time.Since(start)
+	r.logger.Repository("GetMonthCounts completed successfully",
+		zap.Int("month_count", len(result)),
+		zap.Duration("duration", duration),
+	)
+
+	return result, nil
+}
+
+// Reset empties the store and restarts ids at 1, for tests that need a clean slate
+// without rebuilding their dependencies
+func (r *MemoryTransactionRepository) Reset() {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	r.transactions = make([]models.Transaction, 0)
+	r.positions = make(map[int]int)
+	r.nextID = 1
+	r.lastModified = time.Time{}
+
+	r.logger.Repository("Reset completed")
+}
+
+// Seed replaces the store with the given records exactly as passed, ids and timestamps
+// included, and sets the next id to assign. Nothing is validated, so tests can build
+// states that normal writes never produce.
+func (r *MemoryTransactionRepository) Seed(transactions []models.Transaction, nextID int) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	r.transactions = make([]models.Transaction, len(transactions))
+	r.positions = make(map[int]int, len(transactions))
+	for i, transaction := range transactions {
+		r.transactions[i] = transaction.Clone()
+		r.positions[transaction.ID] = i
+	}
+	r.nextID = nextID
+	r.lastModified = storedNow()
+
+	r.logger.Repository("Seed completed",
+		zap.Int("total_transactions", len(r.transactions)),
+		zap.Int("next_id", nextID),
+	)
+}
+
+// CheckIntegrity verifies the store's invariants: ids are positive and unique, the next
+// id is above all of them, the id index points at the right records and every record
+// has the fields a create would have set. It only reports; nothing is repaired.
+func (r *MemoryTransactionRepository) CheckIntegrity(ctx context.Context) (*models.IntegrityReport, error) {
+	r.logger.Repository("CheckIntegrity started")
+
+	if err := ctx.Err(); err != nil {
+		r.logger.Error("repository", "CheckIntegrity cancelled", err)
+		return nil, err
+	}
+
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+
+	start := time.Now()
+	report := &models.IntegrityReport{
+		TransactionCount: len(r.transactions),
+		NextID:           r.nextID,
+		Issues:           []models.IntegrityIssue{},
+	}
+	addIssue := func(code string, id int, format string, args ...interface{}) {
+		report.Issues = append(report.Issues, models.IntegrityIssue{
+			Code:          code,
+			TransactionID: id,
+			Message:       fmt.Sprintf(format, args...),
+		})
+	}
+
+	seen := make(map[int]bool, len(r.transactions))
+	maxID := 0
+	for i, transaction := range r.transactions {
+		id := transaction.ID
+		if seen[id] {
+			addIssue(models.IntegrityDuplicateID, id, "id %d is used by more than one transaction", id)
+		}
+		seen[id] = true
+		if id > maxID {
+			maxID = id
+		}
+
+		if position, ok := r.positions[id]; !ok {
+			addIssue(models.IntegrityIndexMismatch, id, "id %d is stored but missing from the index", id)
+		} else if position != i {
+			addIssue(models.IntegrityIndexMismatch, id, "id %d is stored at position %d but indexed at %d", id, i, position)
+		}
+
+		for _, problem := range recordProblems(transaction) {
+			addIssue(models.IntegrityInvalidRecord, id, "transaction %d %s", id, problem)
+		}
+	}
+
+	if len(r.positions) != len(seen) {
+		addIssue(models.IntegrityIndexMismatch, 0, "index holds %d ids but %d distinct ids are stored", len(r.positions), len(seen))
+	}
+
+	if r.nextID <= maxID || r.nextID < 1 {
+		addIssue(models.IntegrityNextIDTooLow, 0, "next id %d must be above the highest stored id %d", r.nextID, maxID)
+	}
+
+	report.OK = len(report.Issues) == 0
+
+	r.logger.Repository("CheckIntegrity completed successfully",
+		zap.Bool("ok", report.OK),
+		zap.Int("issue_count", len(report.Issues)),
+		zap.Duration("duration", time.Since(start)),
+	)
+
+	return report, nil
+}
+
+// recordProblems lists what is wrong with a single stored record, empty when it is sound
+func recordProblems(transaction models.Transaction) []string {
+	var problems []string
+	if transaction.ID <= 0 {
+		problems = append(problems, "has a non-positive id")
+	}
+	if transaction.Type != models.TransactionTypeIncome && transaction.Type != models.TransactionTypeExpense {
+		problems = append(problems, fmt.Sprintf("has an unknown type %q", transaction.Type))
+	}
+	if amount := float64(transaction.Amount); amount <= 0 || math.IsNaN(amount) || math.IsInf(amount, 0) {
+		problems = append(problems, fmt.Sprintf("has an invalid amount %v", amount))
+	}
+	if transaction.Currency == "" {
+		problems = append(problems, "has no currency")
+	}
+	if transaction.Date.IsZero() {
+		problems = append(problems, "has no date")
+	}
+	if transaction.CreatedAt.IsZero() {
+		problems = append(problems, "has no created_at")
+	}
+	return problems
+}
+
+// RunInTransaction runs fn against a private copy of the store and swaps the copy in only
+// if fn succeeds; a failure or panic discards it. The write lock is held throughout, so
+// other callers wait for fn rather than seeing its uncommitted writes or having their
+// own writes rolled back with it.
+func (r *MemoryTransactionRepository) RunInTransaction(ctx context.Context, fn func(TransactionRepository) error) error {
+	r.logger.Repository("RunInTransaction started")
+
+	if err := ctx.Err(); err != nil {
+		r.logger.Error("repository", "RunInTransaction cancelled", err)
+		return err
+	}
+
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	tx := r.copyLocked()
+	if err := fn(tx); err != nil {
+		r.logger.Error("repository", "RunInTransaction - operation failed", err)
+		r.logger.Repository("RunInTransaction rolled back",
+			zap.Int("total_transactions", len(r.transactions)),
+		)
+		return err
+	}
+
+	r.transactions = tx.transactions
+	r.positions = tx.positions
+	r.nextID = tx.nextID
+	r.lastModified = tx.lastModified
+
+	r.logger.Repository("RunInTransaction completed successfully",
+		zap.Int("total_transactions", len(r.transactions)),
+	)
+	return nil
+}
+
+// copyLocked returns an independent repository holding a deep copy of the store; the
+// caller must hold the lock
+func (r *MemoryTransactionRepository) copyLocked() *MemoryTransactionRepository {
+	transactions := make([]models.Transaction, len(r.transactions))
+	for i, transaction := range r.transactions {
+		transactions[i] = transaction.Clone()
+	}
+	positions := make(map[int]int, len(r.positions))
+	for id, i := range r.positions {
+		positions[id] = i
+	}
+
+	return &MemoryTransactionRepository{
+		config:       r.config,
+		transactions: transactions,
+		positions:    positions,
+		nextID:       r.nextID,
+		lastModified: r.lastModified,
+		logger:       r.logger,
+	}
+}
+
+// LastModified returns the time of the most recent create, update or delete.
+// A zero time means the repository has never been written to.
+func (r *MemoryTransactionRepository) LastModified(ctx context.Context) (time.Time, error) {
+	if err := ctx.Err(); err != nil {
+		return time.Time{}, err
+	}
+
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+
+	r.logger.Debug("repository", "LastModified requested",
+		zap.Time("last_modified", r.lastModified),
+	)
+
+	return r.lastModified, nil
+}
+
 func (r *MemoryTransactionRepository) matchesFilters(transaction models.Transaction, filters models.TransactionFilters) bool {
 	r.logger.Debug("repository", "Checking transaction against filters",
 		zap.Int("transaction_id", transaction.ID),
@@ -356,6 +1005,63 @@ func (r *MemoryTransactionRepository) matchesFilters(transaction models.Transact
 		return false
 	}
 
+	if filters.Account != "" && transaction.Account != filters.Account {
+		r.logger.Debug("repository", "Transaction filtered out by account",
+			zap.Int("transaction_id", transaction.ID),
+			zap.String("transaction_account", transaction.Account),
+			zap.String("filter_account", filters.Account),
+		)
+		return false
+	}
+
+	for _, excluded := range filters.ExcludeCategories {
+		if transaction.Category == excluded {
+			r.logger.Debug("repository", "Transaction filtered out by excluded category",
+				zap.Int("transaction_id", transaction.ID),
+				zap.String("transaction_category", transaction.Category),
+			)
+			return false
+		}
+	}
+
+	for key, value := range filters.Metadata {
+		if actual, ok := transaction.Metadata[key]; !ok || actual != value {
+			r.logger.Debug("repository", "Transaction filtered out by metadata",
+				zap.Int("transaction_id", transaction.ID),
+				zap.String("metadata_key", key),
+			)
+			return false
+		}
+	}
+
+	if filters.Pinned != nil && transaction.Pinned != *filters.Pinned {
+		r.logger.Debug("repository", "Transaction filtered out by pinned",
+			zap.Int("transaction_id", transaction.ID),
+			zap.Bool("transaction_pinned", transaction.Pinned),
+			zap.Bool("filter_pinned", *filters.Pinned),
+		)
+		return false
+	}
+
+	if filters.Status != "" && transaction.IsPending() != (filters.Status == models.TransactionStatusPending) {
+		r.logger.Debug("repository", "Transaction filtered out by status",
+			zap.Int("transaction_id", transaction.ID),
+			zap.String("transaction_status", transaction.Status),
+			zap.String("filter_status", filters.Status),
+		)
+		return false
+	}
+
+	if filters.Amount != nil && !filters.Amount.Matches(transaction.Amount) {
+		r.logger.Debug("repository", "Transaction filtered out by amount",
+			zap.Int("transaction_id", transaction.ID),
+			zap.Float64("transaction_amount", float64(transaction.Amount)),
+			zap.String("filter_amount_operator", filters.Amount.Operator),
+			zap.Float64("filter_amount_value", float64(filters.Amount.Value)),
+		)
+		return false
+	}
+
 	if filters.FromDate != nil && transaction.Date.Before(*filters.FromDate) {
 		r.logger.Debug("repository", "Transaction filtered out by from_date",
 			zap.Int("transaction_id", transaction.ID),
@@ -374,6 +1080,16 @@ func (r *MemoryTransactionRepository) matchesFilters(transaction models.Transact
 		return false
 	}
 
+	// Inclusive, so a write stored in the same millisecond as the client's last one is not lost
+	if filters.UpdatedSince != nil && transaction.UpdatedAt.Before(*filters.UpdatedSince) {
+		r.logger.Debug("repository", "Transaction filtered out by updated_since",
+			zap.Int("transaction_id", transaction.ID),
+			zap.Time("transaction_updated_at", transaction.UpdatedAt),
+			zap.Time("filter_updated_since", *filters.UpdatedSince),
+		)
+		return false
+	}
+
 	r.logger.Debug("repository", "Transaction matches all filters",
 		zap.Int("transaction_id", transaction.ID),
 	)
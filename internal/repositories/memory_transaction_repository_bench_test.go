@@ -0,0 +1,107 @@
+package repositories_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/maximicciullo/personal-finance-api/internal/middleware"
+	"github.com/maximicciullo/personal-finance-api/internal/models"
+	"github.com/maximicciullo/personal-finance-api/internal/repositories"
+)
+
+var benchmarkSizes = []int{100, 10000, 100000}
+
+// benchmarkRepository returns a repository holding size transactions with IDs 1..size
+func benchmarkRepository(b *testing.B, size int) *repositories.MemoryTransactionRepository {
+	b.Helper()
+	middleware.InitLogger("test")
+
+	repo := repositories.NewMemoryTransactionRepository()
+	for i := 0; i < size; i++ {
+		repo.Create(context.Background(), &models.Transaction{
+			Type: "expense", Amount: models.Money(i + 1), Currency: "ARS",
+			Description: "Item", Category: "food", Date: time.Now(),
+		})
+	}
+	return repo
+}
+
+// BenchmarkGetByID looks up the last transaction, the worst case for a linear scan
+func BenchmarkGetByID(b *testing.B) {
+	for _, size := range benchmarkSizes {
+		b.Run(fmt.Sprintf("size=%d", size), func(b *testing.B) {
+			repo := benchmarkRepository(b, size)
+			ctx := context.Background()
+
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				if _, err := repo.GetByID(ctx, size); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
+
+// BenchmarkLinearScan is the lookup GetByID did before the ID index, kept as a baseline
+func BenchmarkLinearScan(b *testing.B) {
+	for _, size := range benchmarkSizes {
+		b.Run(fmt.Sprintf("size=%d", size), func(b *testing.B) {
+			transactions, _ := benchmarkRepository(b, size).GetAll(context.Background())
+
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				found := false
+				for _, transaction := range transactions {
+					if transaction.ID == size {
+						found = true
+						break
+					}
+				}
+				if !found {
+					b.Fatal("transaction not found")
+				}
+			}
+		})
+	}
+}
+
+func BenchmarkUpdate(b *testing.B) {
+	for _, size := range benchmarkSizes {
+		b.Run(fmt.Sprintf("size=%d", size), func(b *testing.B) {
+			repo := benchmarkRepository(b, size)
+			ctx := context.Background()
+			transaction, _ := repo.GetByID(ctx, size)
+
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				if err := repo.Update(ctx, transaction); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
+
+// BenchmarkDeleteLast deletes and recreates the newest transaction, so no positions shift
+func BenchmarkDeleteLast(b *testing.B) {
+	for _, size := range benchmarkSizes {
+		b.Run(fmt.Sprintf("size=%d", size), func(b *testing.B) {
+			repo := benchmarkRepository(b, size)
+			ctx := context.Background()
+			id := size
+
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				if err := repo.Delete(ctx, id); err != nil {
+					b.Fatal(err)
+				}
+				transaction := &models.Transaction{Type: "expense", Amount: 1, Currency: "ARS", Category: "food", Date: time.Now()}
+				repo.Create(ctx, transaction)
+				id = transaction.ID
+			}
+		})
+	}
+}
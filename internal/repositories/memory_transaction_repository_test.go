@@ -1,6 +1,8 @@
 package repositories_test
 
 import (
+	"context"
+	"errors"
 	"testing"
 	"time"
 
@@ -37,8 +39,8 @@ func (suite *MemoryTransactionRepositoryTestSuite) TestCreate_Success() {
 	}
 
 	// When
-	beforeCreate := time.Now()
-	err := suite.repo.Create(transaction)
+	beforeCreate := time.Now().Truncate(repositories.TimestampPrecision)
+	err := suite.repo.Create(context.Background(), transaction)
 	afterCreate := time.Now()
 
 	// Then
@@ -53,9 +55,29 @@ func (suite *MemoryTransactionRepositoryTestSuite) TestCreate_Success() {
 	assert.True(suite.T(), transaction.UpdatedAt.After(beforeCreate) || transaction.UpdatedAt.Equal(beforeCreate))
 	assert.True(suite.T(), transaction.UpdatedAt.Before(afterCreate) || transaction.UpdatedAt.Equal(afterCreate))
 
-	// CreatedAt and UpdatedAt should be very close (within 1 second)
-	timeDiff := transaction.UpdatedAt.Sub(transaction.CreatedAt)
-	assert.True(suite.T(), timeDiff >= 0 && timeDiff < time.Second)
+	// A new transaction has not been updated yet
+	assert.Equal(suite.T(), transaction.CreatedAt, transaction.UpdatedAt)
+}
+
+func (suite *MemoryTransactionRepositoryTestSuite) TestTimestamps_StoredAtMillisecondPrecision() {
+	// Given
+	transaction := &models.Transaction{
+		Type: "expense", Amount: 100, Currency: "ARS", Description: "Test", Category: "food", Date: time.Now(),
+	}
+
+	// When
+	suite.Require().NoError(suite.repo.Create(context.Background(), transaction))
+	created, _ := suite.repo.GetByID(context.Background(), transaction.ID)
+	suite.Require().NoError(suite.repo.Update(context.Background(), created))
+	updated, _ := suite.repo.GetByID(context.Background(), transaction.ID)
+
+	// Then
+	for _, stored := range []time.Time{created.CreatedAt, created.UpdatedAt, updated.UpdatedAt} {
+		assert.Zero(suite.T(), stored.Nanosecond()%int(time.Millisecond), "timestamp %s", stored.Format(time.RFC3339Nano))
+	}
+	lastModified, err := suite.repo.LastModified(context.Background())
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), updated.UpdatedAt, lastModified)
 }
 
 func (suite *MemoryTransactionRepositoryTestSuite) TestCreate_MultipleTransactions() {
@@ -79,8 +101,8 @@ func (suite *MemoryTransactionRepositoryTestSuite) TestCreate_MultipleTransactio
 	}
 
 	// When
-	err1 := suite.repo.Create(transaction1)
-	err2 := suite.repo.Create(transaction2)
+	err1 := suite.repo.Create(context.Background(), transaction1)
+	err2 := suite.repo.Create(context.Background(), transaction2)
 
 	// Then
 	assert.NoError(suite.T(), err1)
@@ -98,13 +120,13 @@ func (suite *MemoryTransactionRepositoryTestSuite) TestCreate_ConcurrentAccess()
 		go func(index int) {
 			transaction := &models.Transaction{
 				Type:        "expense",
-				Amount:      float64(index * 100),
+				Amount:      models.Money(index * 100),
 				Currency:    "ARS",
 				Description: "Concurrent transaction",
 				Category:    "test",
 				Date:        time.Now(),
 			}
-			err := suite.repo.Create(transaction)
+			err := suite.repo.Create(context.Background(), transaction)
 			assert.NoError(suite.T(), err)
 			assert.NotZero(suite.T(), transaction.ID)
 			done <- true
@@ -117,7 +139,7 @@ func (suite *MemoryTransactionRepositoryTestSuite) TestCreate_ConcurrentAccess()
 	}
 
 	// Verify all transactions were created
-	transactions, err := suite.repo.GetAll()
+	transactions, err := suite.repo.GetAll(context.Background())
 	assert.NoError(suite.T(), err)
 	assert.Len(suite.T(), transactions, numGoroutines)
 }
@@ -133,17 +155,17 @@ func (suite *MemoryTransactionRepositoryTestSuite) TestGetByID_Success() {
 		Category:    "work",
 		Date:        time.Now(),
 	}
-	suite.repo.Create(transaction)
+	suite.repo.Create(context.Background(), transaction)
 
 	// When
-	result, err := suite.repo.GetByID(1)
+	result, err := suite.repo.GetByID(context.Background(), 1)
 
 	// Then
 	assert.NoError(suite.T(), err)
 	assert.NotNil(suite.T(), result)
 	assert.Equal(suite.T(), 1, result.ID)
 	assert.Equal(suite.T(), "income", result.Type)
-	assert.Equal(suite.T(), 5000.0, result.Amount)
+	assert.Equal(suite.T(), models.Money(5000.0), result.Amount)
 	assert.Equal(suite.T(), "USD", result.Currency)
 	assert.Equal(suite.T(), "Test income", result.Description)
 	assert.Equal(suite.T(), "work", result.Category)
@@ -151,7 +173,7 @@ func (suite *MemoryTransactionRepositoryTestSuite) TestGetByID_Success() {
 
 func (suite *MemoryTransactionRepositoryTestSuite) TestGetByID_NotFound() {
 	// When
-	result, err := suite.repo.GetByID(999)
+	result, err := suite.repo.GetByID(context.Background(), 999)
 
 	// Then
 	assert.Error(suite.T(), err)
@@ -168,12 +190,12 @@ func (suite *MemoryTransactionRepositoryTestSuite) TestGetByID_MultipleTransacti
 	}
 
 	for _, tx := range transactions {
-		suite.repo.Create(tx)
+		suite.repo.Create(context.Background(), tx)
 	}
 
 	// When & Then - verify each transaction can be retrieved
 	for i, expectedTx := range transactions {
-		result, err := suite.repo.GetByID(i + 1)
+		result, err := suite.repo.GetByID(context.Background(), i + 1)
 		assert.NoError(suite.T(), err)
 		assert.Equal(suite.T(), expectedTx.Description, result.Description)
 		assert.Equal(suite.T(), expectedTx.Amount, result.Amount)
@@ -183,7 +205,7 @@ func (suite *MemoryTransactionRepositoryTestSuite) TestGetByID_MultipleTransacti
 // Test GetAll
 func (suite *MemoryTransactionRepositoryTestSuite) TestGetAll_EmptyRepository() {
 	// When
-	result, err := suite.repo.GetAll()
+	result, err := suite.repo.GetAll(context.Background())
 
 	// Then
 	assert.NoError(suite.T(), err)
@@ -199,11 +221,11 @@ func (suite *MemoryTransactionRepositoryTestSuite) TestGetAll_WithTransactions()
 	}
 
 	for _, tx := range transactions {
-		suite.repo.Create(tx)
+		suite.repo.Create(context.Background(), tx)
 	}
 
 	// When
-	result, err := suite.repo.GetAll()
+	result, err := suite.repo.GetAll(context.Background())
 
 	// Then
 	assert.NoError(suite.T(), err)
@@ -218,11 +240,11 @@ func (suite *MemoryTransactionRepositoryTestSuite) TestGetAll_ReturnsCopy() {
 		Type: "expense", Amount: 100, Currency: "ARS",
 		Description: "Test", Category: "food", Date: time.Now(),
 	}
-	suite.repo.Create(transaction)
+	suite.repo.Create(context.Background(), transaction)
 
 	// When
-	result1, _ := suite.repo.GetAll()
-	result2, _ := suite.repo.GetAll()
+	result1, _ := suite.repo.GetAll(context.Background())
+	result2, _ := suite.repo.GetAll(context.Background())
 
 	// Then - verify we get different slices (copies)
 	assert.NotSame(suite.T(), &result1, &result2)
@@ -233,6 +255,61 @@ func (suite *MemoryTransactionRepositoryTestSuite) TestGetAll_ReturnsCopy() {
 	assert.Equal(suite.T(), "Test", result2[0].Description)
 }
 
+func (suite *MemoryTransactionRepositoryTestSuite) TestReads_DeepCopyTags() {
+	// Given
+	transaction := &models.Transaction{
+		Type: "expense", Amount: 100, Currency: "ARS", Description: "Test", Category: "food",
+		Tags: []string{"work", "travel"}, Date: time.Now(),
+	}
+	suite.repo.Create(context.Background(), transaction)
+	transaction.Tags[0] = "changed after create"
+
+	from, to := time.Now().Add(-time.Hour), time.Now().Add(time.Hour)
+	reads := map[string]func() models.Transaction{
+		"GetByID": func() models.Transaction {
+			result, _ := suite.repo.GetByID(context.Background(), 1)
+			return *result
+		},
+		"GetAll": func() models.Transaction {
+			result, _ := suite.repo.GetAll(context.Background())
+			return result[0]
+		},
+		"GetByFilters": func() models.Transaction {
+			result, _ := suite.repo.GetByFilters(context.Background(), models.TransactionFilters{})
+			return result[0]
+		},
+		"GetByDateRange": func() models.Transaction {
+			result, _ := suite.repo.GetByDateRange(context.Background(), from, to)
+			return result[0]
+		},
+		"GetByIDs": func() models.Transaction {
+			result, _ := suite.repo.GetByIDs(context.Background(), []int{1})
+			return result[0]
+		},
+		"GetRecent": func() models.Transaction {
+			result, _ := suite.repo.GetRecent(context.Background(), 1)
+			return result[0]
+		},
+		"StreamAll": func() models.Transaction {
+			out, _ := suite.repo.StreamAll(context.Background())
+			return <-out
+		},
+	}
+
+	for name, read := range reads {
+		suite.Run(name, func() {
+			// When
+			returned := read()
+			returned.Tags[0] = "mutated"
+			returned.Tags = append(returned.Tags, "extra")
+
+			// Then
+			stored, _ := suite.repo.GetByID(context.Background(), 1)
+			assert.Equal(suite.T(), []string{"work", "travel"}, stored.Tags)
+		})
+	}
+}
+
 // Test GetByFilters
 func (suite *MemoryTransactionRepositoryTestSuite) TestGetByFilters_NoFilters() {
 	// Given
@@ -242,18 +319,32 @@ func (suite *MemoryTransactionRepositoryTestSuite) TestGetByFilters_NoFilters()
 	}
 
 	for _, tx := range transactions {
-		suite.repo.Create(tx)
+		suite.repo.Create(context.Background(), tx)
 	}
 
 	// When
 	filters := models.TransactionFilters{}
-	result, err := suite.repo.GetByFilters(filters)
+	result, err := suite.repo.GetByFilters(context.Background(), filters)
 
 	// Then
 	assert.NoError(suite.T(), err)
 	assert.Len(suite.T(), result, 2)
 }
 
+func (suite *MemoryTransactionRepositoryTestSuite) TestGetByFilters_CancelledContext() {
+	// Given
+	suite.repo.Create(context.Background(), &models.Transaction{Type: "expense", Amount: 100, Currency: "ARS", Description: "Expense", Category: "food", Date: time.Now()})
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	// When
+	result, err := suite.repo.GetByFilters(ctx, models.TransactionFilters{})
+
+	// Then
+	assert.ErrorIs(suite.T(), err, context.Canceled)
+	assert.Nil(suite.T(), result)
+}
+
 func (suite *MemoryTransactionRepositoryTestSuite) TestGetByFilters_TypeFilter() {
 	// Given
 	transactions := []*models.Transaction{
@@ -263,12 +354,12 @@ func (suite *MemoryTransactionRepositoryTestSuite) TestGetByFilters_TypeFilter()
 	}
 
 	for _, tx := range transactions {
-		suite.repo.Create(tx)
+		suite.repo.Create(context.Background(), tx)
 	}
 
 	// When
 	filters := models.TransactionFilters{Type: "expense"}
-	result, err := suite.repo.GetByFilters(filters)
+	result, err := suite.repo.GetByFilters(context.Background(), filters)
 
 	// Then
 	assert.NoError(suite.T(), err)
@@ -278,6 +369,31 @@ func (suite *MemoryTransactionRepositoryTestSuite) TestGetByFilters_TypeFilter()
 	}
 }
 
+func (suite *MemoryTransactionRepositoryTestSuite) TestGetByFilters_MetadataFilter() {
+	// Given
+	transactions := []*models.Transaction{
+		{Type: "expense", Amount: 100, Currency: "ARS", Description: "Synced", Category: "food", Date: time.Now(),
+			Metadata: map[string]string{"source": "bank_sync", "external_id": "abc"}},
+		{Type: "expense", Amount: 200, Currency: "ARS", Description: "Other source", Category: "food", Date: time.Now(),
+			Metadata: map[string]string{"source": "manual"}},
+		{Type: "expense", Amount: 300, Currency: "ARS", Description: "No metadata", Category: "food", Date: time.Now()},
+	}
+
+	for _, tx := range transactions {
+		suite.repo.Create(context.Background(), tx)
+	}
+
+	// When
+	filters := models.TransactionFilters{Metadata: map[string]string{"source": "bank_sync", "external_id": "abc"}}
+	result, err := suite.repo.GetByFilters(context.Background(), filters)
+
+	// Then
+	assert.NoError(suite.T(), err)
+	if assert.Len(suite.T(), result, 1) {
+		assert.Equal(suite.T(), "Synced", result[0].Description)
+	}
+}
+
 func (suite *MemoryTransactionRepositoryTestSuite) TestGetByFilters_CategoryFilter() {
 	// Given
 	transactions := []*models.Transaction{
@@ -287,12 +403,12 @@ func (suite *MemoryTransactionRepositoryTestSuite) TestGetByFilters_CategoryFilt
 	}
 
 	for _, tx := range transactions {
-		suite.repo.Create(tx)
+		suite.repo.Create(context.Background(), tx)
 	}
 
 	// When
 	filters := models.TransactionFilters{Category: "food"}
-	result, err := suite.repo.GetByFilters(filters)
+	result, err := suite.repo.GetByFilters(context.Background(), filters)
 
 	// Then
 	assert.NoError(suite.T(), err)
@@ -311,12 +427,12 @@ func (suite *MemoryTransactionRepositoryTestSuite) TestGetByFilters_CurrencyFilt
 	}
 
 	for _, tx := range transactions {
-		suite.repo.Create(tx)
+		suite.repo.Create(context.Background(), tx)
 	}
 
 	// When
 	filters := models.TransactionFilters{Currency: "ARS"}
-	result, err := suite.repo.GetByFilters(filters)
+	result, err := suite.repo.GetByFilters(context.Background(), filters)
 
 	// Then
 	assert.NoError(suite.T(), err)
@@ -326,6 +442,94 @@ func (suite *MemoryTransactionRepositoryTestSuite) TestGetByFilters_CurrencyFilt
 	}
 }
 
+func (suite *MemoryTransactionRepositoryTestSuite) TestGetByFilters_AccountFilter() {
+	// Given
+	transactions := []*models.Transaction{
+		{Type: "expense", Amount: 100, Currency: "ARS", Description: "Cash1", Category: "food", Account: "cash", Date: time.Now()},
+		{Type: "income", Amount: 200, Currency: "ARS", Description: "Bank1", Category: "work", Account: "bank", Date: time.Now()},
+		{Type: "expense", Amount: 300, Currency: "ARS", Description: "Cash2", Category: "transport", Account: "cash", Date: time.Now()},
+		{Type: "expense", Amount: 400, Currency: "ARS", Description: "NoAccount", Category: "food", Date: time.Now()},
+	}
+
+	for _, tx := range transactions {
+		suite.repo.Create(context.Background(), tx)
+	}
+
+	// When
+	filters := models.TransactionFilters{Account: "cash"}
+	result, err := suite.repo.GetByFilters(context.Background(), filters)
+
+	// Then
+	assert.NoError(suite.T(), err)
+	assert.Len(suite.T(), result, 2)
+	for _, tx := range result {
+		assert.Equal(suite.T(), "cash", tx.Account)
+	}
+
+	// When - combined with another filter
+	combined, err := suite.repo.GetByFilters(context.Background(), models.TransactionFilters{Account: "cash", Category: "food"})
+
+	// Then
+	assert.NoError(suite.T(), err)
+	assert.Len(suite.T(), combined, 1)
+	assert.Equal(suite.T(), "Cash1", combined[0].Description)
+}
+
+func (suite *MemoryTransactionRepositoryTestSuite) TestGetByFilters_ExcludeCategories() {
+	// Given
+	transactions := []*models.Transaction{
+		{Type: "expense", Amount: 100, Currency: "ARS", Description: "Food", Category: "food", Date: time.Now()},
+		{Type: "expense", Amount: 200, Currency: "ARS", Description: "Transfer", Category: "transfer", Date: time.Now()},
+		{Type: "expense", Amount: 300, Currency: "ARS", Description: "Fees", Category: "fees", Date: time.Now()},
+		{Type: "income", Amount: 400, Currency: "ARS", Description: "Salary", Category: "work", Date: time.Now()},
+	}
+
+	for _, tx := range transactions {
+		suite.repo.Create(context.Background(), tx)
+	}
+
+	// When
+	filters := models.TransactionFilters{ExcludeCategories: []string{"transfer", "fees"}}
+	result, err := suite.repo.GetByFilters(context.Background(), filters)
+
+	// Then
+	assert.NoError(suite.T(), err)
+	assert.Len(suite.T(), result, 2)
+	for _, tx := range result {
+		assert.NotContains(suite.T(), []string{"transfer", "fees"}, tx.Category)
+	}
+}
+
+func (suite *MemoryTransactionRepositoryTestSuite) TestGetByFilters_ExcludeCategoriesWithInclusionFilters() {
+	// Given
+	transactions := []*models.Transaction{
+		{Type: "expense", Amount: 100, Currency: "ARS", Description: "Food", Category: "food", Date: time.Now()},
+		{Type: "expense", Amount: 200, Currency: "ARS", Description: "Transfer", Category: "transfer", Date: time.Now()},
+		{Type: "income", Amount: 300, Currency: "ARS", Description: "Incoming transfer", Category: "transfer", Date: time.Now()},
+		{Type: "expense", Amount: 400, Currency: "USD", Description: "Trip", Category: "travel", Date: time.Now()},
+	}
+
+	for _, tx := range transactions {
+		suite.repo.Create(context.Background(), tx)
+	}
+
+	// When
+	filters := models.TransactionFilters{Type: "expense", Currency: "ARS", ExcludeCategories: []string{"transfer"}}
+	result, err := suite.repo.GetByFilters(context.Background(), filters)
+
+	// Then
+	assert.NoError(suite.T(), err)
+	assert.Len(suite.T(), result, 1)
+	assert.Equal(suite.T(), "Food", result[0].Description)
+
+	// When - excluding the only included category
+	none, err := suite.repo.GetByFilters(context.Background(), models.TransactionFilters{Category: "transfer", ExcludeCategories: []string{"transfer"}})
+
+	// Then
+	assert.NoError(suite.T(), err)
+	assert.Empty(suite.T(), none)
+}
+
 func (suite *MemoryTransactionRepositoryTestSuite) TestGetByFilters_DateRangeFilter() {
 	// Given
 	baseDate := time.Date(2024, 6, 15, 0, 0, 0, 0, time.UTC)
@@ -336,7 +540,7 @@ func (suite *MemoryTransactionRepositoryTestSuite) TestGetByFilters_DateRangeFil
 	}
 
 	for _, tx := range transactions {
-		suite.repo.Create(tx)
+		suite.repo.Create(context.Background(), tx)
 	}
 
 	// When
@@ -346,7 +550,7 @@ func (suite *MemoryTransactionRepositoryTestSuite) TestGetByFilters_DateRangeFil
 		FromDate: &fromDate,
 		ToDate:   &toDate,
 	}
-	result, err := suite.repo.GetByFilters(filters)
+	result, err := suite.repo.GetByFilters(context.Background(), filters)
 
 	// Then
 	assert.NoError(suite.T(), err)
@@ -364,7 +568,7 @@ func (suite *MemoryTransactionRepositoryTestSuite) TestGetByFilters_MultipleFilt
 	}
 
 	for _, tx := range transactions {
-		suite.repo.Create(tx)
+		suite.repo.Create(context.Background(), tx)
 	}
 
 	// When
@@ -373,7 +577,7 @@ func (suite *MemoryTransactionRepositoryTestSuite) TestGetByFilters_MultipleFilt
 		Currency: "ARS",
 		Category: "food",
 	}
-	result, err := suite.repo.GetByFilters(filters)
+	result, err := suite.repo.GetByFilters(context.Background(), filters)
 
 	// Then
 	assert.NoError(suite.T(), err)
@@ -387,11 +591,11 @@ func (suite *MemoryTransactionRepositoryTestSuite) TestGetByFilters_NoMatches()
 		Type: "expense", Amount: 100, Currency: "ARS",
 		Description: "Test", Category: "food", Date: time.Now(),
 	}
-	suite.repo.Create(transaction)
+	suite.repo.Create(context.Background(), transaction)
 
 	// When
 	filters := models.TransactionFilters{Type: "nonexistent"}
-	result, err := suite.repo.GetByFilters(filters)
+	result, err := suite.repo.GetByFilters(context.Background(), filters)
 
 	// Then
 	assert.NoError(suite.T(), err)
@@ -411,13 +615,13 @@ func (suite *MemoryTransactionRepositoryTestSuite) TestGetByDateRange_Success()
 	}
 
 	for _, tx := range transactions {
-		suite.repo.Create(tx)
+		suite.repo.Create(context.Background(), tx)
 	}
 
 	// When
 	startDate := baseDate
 	endDate := baseDate.AddDate(0, 0, 2)
-	result, err := suite.repo.GetByDateRange(startDate, endDate)
+	result, err := suite.repo.GetByDateRange(context.Background(), startDate, endDate)
 
 	// Then
 	assert.NoError(suite.T(), err)
@@ -432,6 +636,33 @@ func (suite *MemoryTransactionRepositoryTestSuite) TestGetByDateRange_Success()
 	assert.Contains(suite.T(), descriptions, "End")
 }
 
+func (suite *MemoryTransactionRepositoryTestSuite) TestGetByDateRange_ExactBoundaries() {
+	// Given
+	startDate := time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)
+	endDate := time.Date(2024, 6, 30, 23, 59, 59, 0, time.UTC)
+	transactions := []*models.Transaction{
+		{Type: "expense", Amount: 100, Currency: "ARS", Description: "Second before start", Category: "food", Date: startDate.Add(-time.Second)},
+		{Type: "expense", Amount: 200, Currency: "ARS", Description: "On start", Category: "food", Date: startDate},
+		{Type: "expense", Amount: 300, Currency: "ARS", Description: "On end", Category: "food", Date: endDate},
+		{Type: "expense", Amount: 400, Currency: "ARS", Description: "Second after end", Category: "food", Date: endDate.Add(time.Second)},
+	}
+
+	for _, tx := range transactions {
+		suite.repo.Create(context.Background(), tx)
+	}
+
+	// When
+	result, err := suite.repo.GetByDateRange(context.Background(), startDate, endDate)
+
+	// Then
+	assert.NoError(suite.T(), err)
+	descriptions := make([]string, len(result))
+	for i, tx := range result {
+		descriptions[i] = tx.Description
+	}
+	assert.ElementsMatch(suite.T(), []string{"On start", "On end"}, descriptions)
+}
+
 func (suite *MemoryTransactionRepositoryTestSuite) TestGetByDateRange_EmptyResult() {
 	// Given
 	transaction := &models.Transaction{
@@ -439,18 +670,337 @@ func (suite *MemoryTransactionRepositoryTestSuite) TestGetByDateRange_EmptyResul
 		Description: "Test", Category: "food",
 		Date: time.Date(2024, 6, 15, 0, 0, 0, 0, time.UTC),
 	}
-	suite.repo.Create(transaction)
+	suite.repo.Create(context.Background(), transaction)
 
 	// When - search in a different date range
 	startDate := time.Date(2024, 7, 1, 0, 0, 0, 0, time.UTC)
 	endDate := time.Date(2024, 7, 31, 0, 0, 0, 0, time.UTC)
-	result, err := suite.repo.GetByDateRange(startDate, endDate)
+	result, err := suite.repo.GetByDateRange(context.Background(), startDate, endDate)
 
 	// Then
 	assert.NoError(suite.T(), err)
 	assert.Empty(suite.T(), result)
 }
 
+func (suite *MemoryTransactionRepositoryTestSuite) TestGetByFilters_Pinned() {
+	// Given
+	transactions := []*models.Transaction{
+		{Type: "expense", Amount: 100, Currency: "ARS", Description: "Rent", Category: "housing", Pinned: true, Date: time.Now()},
+		{Type: "expense", Amount: 200, Currency: "ARS", Description: "Coffee", Category: "food", Date: time.Now()},
+	}
+	for _, tx := range transactions {
+		suite.repo.Create(context.Background(), tx)
+	}
+	pinned, unpinned := true, false
+
+	// When
+	pinnedResult, err := suite.repo.GetByFilters(context.Background(), models.TransactionFilters{Pinned: &pinned})
+	unpinnedResult, err2 := suite.repo.GetByFilters(context.Background(), models.TransactionFilters{Pinned: &unpinned})
+
+	// Then
+	assert.NoError(suite.T(), err)
+	assert.NoError(suite.T(), err2)
+	assert.Len(suite.T(), pinnedResult, 1)
+	assert.Equal(suite.T(), "Rent", pinnedResult[0].Description)
+	assert.Len(suite.T(), unpinnedResult, 1)
+	assert.Equal(suite.T(), "Coffee", unpinnedResult[0].Description)
+}
+
+func (suite *MemoryTransactionRepositoryTestSuite) TestGetByFilters_AmountOperators() {
+	// Given
+	for _, amount := range []models.Money{250, 500, 1000, 1500.5} {
+		suite.repo.Create(context.Background(), &models.Transaction{
+			Type: "expense", Amount: amount, Currency: "ARS", Description: "Item", Category: "food", Date: time.Now(),
+		})
+	}
+
+	testCases := []struct {
+		operator string
+		value    models.Money
+		expected []models.Money
+	}{
+		{models.AmountOpGreater, 1000, []models.Money{1500.5}},
+		{models.AmountOpGreaterOrEqual, 1000, []models.Money{1000, 1500.5}},
+		{models.AmountOpLess, 500, []models.Money{250}},
+		{models.AmountOpLessOrEqual, 500, []models.Money{250, 500}},
+		{models.AmountOpEqual, 1500.5, []models.Money{1500.5}},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.operator, func() {
+			// When
+			result, err := suite.repo.GetByFilters(context.Background(), models.TransactionFilters{
+				Amount: &models.AmountFilter{Operator: tc.operator, Value: tc.value},
+			})
+
+			// Then
+			assert.NoError(suite.T(), err)
+			amounts := make([]models.Money, 0, len(result))
+			for _, tx := range result {
+				amounts = append(amounts, tx.Amount)
+			}
+			assert.Equal(suite.T(), tc.expected, amounts)
+		})
+	}
+}
+
+func (suite *MemoryTransactionRepositoryTestSuite) TestGetByFilters_LimitAndOffset() {
+	// Given
+	for i := 1; i <= 5; i++ {
+		suite.repo.Create(context.Background(), &models.Transaction{
+			Type: "expense", Amount: models.Money(i), Currency: "ARS",
+			Description: "Item", Category: "food", Date: time.Now(),
+		})
+	}
+
+	// When
+	page, err := suite.repo.GetByFilters(context.Background(), models.TransactionFilters{Limit: 2, Offset: 1})
+	beyond, err2 := suite.repo.GetByFilters(context.Background(), models.TransactionFilters{Offset: 10})
+
+	// Then
+	assert.NoError(suite.T(), err)
+	assert.NoError(suite.T(), err2)
+	assert.Len(suite.T(), page, 2)
+	assert.Equal(suite.T(), 2, page[0].ID)
+	assert.Equal(suite.T(), 3, page[1].ID)
+	assert.Empty(suite.T(), beyond)
+}
+
+// Test StreamAll
+func (suite *MemoryTransactionRepositoryTestSuite) createTransactions(count int) {
+	for i := 0; i < count; i++ {
+		suite.repo.Create(context.Background(), &models.Transaction{
+			Type: "expense", Amount: models.Money(i + 1), Currency: "ARS",
+			Description: "Item", Category: "food", Date: time.Now(),
+		})
+	}
+}
+
+func (suite *MemoryTransactionRepositoryTestSuite) TestStreamAll_SendsEveryTransaction() {
+	// Given
+	suite.createTransactions(25)
+
+	// When
+	transactions, errs := suite.repo.StreamAll(context.Background())
+
+	count := 0
+	lastID := 0
+	for transaction := range transactions {
+		count++
+		assert.Greater(suite.T(), transaction.ID, lastID)
+		lastID = transaction.ID
+	}
+
+	// Then
+	assert.Equal(suite.T(), 25, count)
+	assert.NoError(suite.T(), <-errs)
+}
+
+func (suite *MemoryTransactionRepositoryTestSuite) TestStreamAll_StopsOnCancellation() {
+	// Given
+	suite.createTransactions(50)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	// When - cancel after reading a few records
+	transactions, errs := suite.repo.StreamAll(ctx)
+
+	count := 0
+	for range transactions {
+		count++
+		if count == 5 {
+			cancel()
+		}
+	}
+
+	// Then
+	assert.Less(suite.T(), count, 50)
+	assert.ErrorIs(suite.T(), <-errs, context.Canceled)
+}
+
+func (suite *MemoryTransactionRepositoryTestSuite) TestStreamAll_DoesNotBlockWriters() {
+	// Given
+	suite.createTransactions(3)
+	transactions, errs := suite.repo.StreamAll(context.Background())
+	<-transactions
+
+	// When - write while the stream is paused mid-way
+	done := make(chan struct{})
+	go func() {
+		suite.createTransactions(1)
+		close(done)
+	}()
+
+	// Then
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		suite.T().Fatal("Create blocked while a stream was open")
+	}
+
+	for range transactions {
+	}
+	assert.NoError(suite.T(), <-errs)
+}
+
+func (suite *MemoryTransactionRepositoryTestSuite) TestStreamAll_DeleteMidStreamKeepsOtherRecords() {
+	// Given - two records already streamed
+	suite.createTransactions(5)
+	transactions, errs := suite.repo.StreamAll(context.Background())
+	ids := []int{(<-transactions).ID, (<-transactions).ID}
+
+	// When - an earlier record is deleted before the rest is read
+	suite.Require().NoError(suite.repo.Delete(context.Background(), 1))
+	for transaction := range transactions {
+		ids = append(ids, transaction.ID)
+	}
+
+	// Then - nothing untouched is skipped or repeated
+	assert.Equal(suite.T(), []int{1, 2, 3, 4, 5}, ids)
+	assert.NoError(suite.T(), <-errs)
+}
+
+// Test GetByIDs
+func (suite *MemoryTransactionRepositoryTestSuite) TestGetByIDs_RequestOrderSkippingMissingAndRepeats() {
+	// Given
+	for _, description := range []string{"First", "Second", "Third"} {
+		suite.repo.Create(context.Background(), &models.Transaction{
+			Type: "expense", Amount: 100, Currency: "ARS",
+			Description: description, Category: "food", Date: time.Now(),
+		})
+	}
+
+	// When
+	result, err := suite.repo.GetByIDs(context.Background(), []int{3, 99, 1, 3})
+
+	// Then
+	assert.NoError(suite.T(), err)
+	assert.Len(suite.T(), result, 2)
+	assert.Equal(suite.T(), 3, result[0].ID)
+	assert.Equal(suite.T(), 1, result[1].ID)
+}
+
+// Test GetRecent
+func (suite *MemoryTransactionRepositoryTestSuite) TestGetRecent_NewestFirstAndLimited() {
+	// Given - inserted out of date order
+	baseDate := time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)
+	transactions := []*models.Transaction{
+		{Type: "expense", Amount: 100, Currency: "ARS", Description: "Middle", Category: "food", Date: baseDate.AddDate(0, 0, 5)},
+		{Type: "expense", Amount: 200, Currency: "ARS", Description: "Oldest", Category: "food", Date: baseDate},
+		{Type: "expense", Amount: 300, Currency: "ARS", Description: "Newest", Category: "food", Date: baseDate.AddDate(0, 0, 10)},
+	}
+
+	for _, tx := range transactions {
+		suite.repo.Create(context.Background(), tx)
+	}
+
+	// When
+	result, err := suite.repo.GetRecent(context.Background(), 2)
+
+	// Then
+	assert.NoError(suite.T(), err)
+	assert.Len(suite.T(), result, 2)
+	assert.Equal(suite.T(), "Newest", result[0].Description)
+	assert.Equal(suite.T(), "Middle", result[1].Description)
+}
+
+func (suite *MemoryTransactionRepositoryTestSuite) TestGetRecent_SameDateOrderedByID() {
+	// Given - several transactions on one date, with a delete leaving a gap
+	sameDate := time.Date(2024, 6, 15, 0, 0, 0, 0, time.UTC)
+	for _, description := range []string{"First", "Second", "Third", "Fourth"} {
+		suite.repo.Create(context.Background(), &models.Transaction{
+			Type: "expense", Amount: 100, Currency: "ARS",
+			Description: description, Category: "food", Date: sameDate,
+		})
+	}
+	suite.repo.Create(context.Background(), &models.Transaction{
+		Type: "expense", Amount: 100, Currency: "ARS",
+		Description: "Later", Category: "food", Date: sameDate.AddDate(0, 0, 1),
+	})
+	suite.repo.Delete(context.Background(), 2)
+
+	// When - repeated calls
+	for i := 0; i < 3; i++ {
+		result, err := suite.repo.GetRecent(context.Background(), 10)
+
+		// Then
+		assert.NoError(suite.T(), err)
+		ids := make([]int, len(result))
+		for j, tx := range result {
+			ids[j] = tx.ID
+		}
+		assert.Equal(suite.T(), []int{5, 1, 3, 4}, ids)
+	}
+}
+
+func (suite *MemoryTransactionRepositoryTestSuite) TestGetRecent_LimitAboveCount() {
+	// Given
+	transaction := &models.Transaction{
+		Type: "expense", Amount: 100, Currency: "ARS",
+		Description: "Only", Category: "food",
+		Date: time.Date(2024, 6, 15, 0, 0, 0, 0, time.UTC),
+	}
+	suite.repo.Create(context.Background(), transaction)
+
+	// When
+	result, err := suite.repo.GetRecent(context.Background(), 10)
+
+	// Then
+	assert.NoError(suite.T(), err)
+	assert.Len(suite.T(), result, 1)
+}
+
+func (suite *MemoryTransactionRepositoryTestSuite) TestGetTopExpenses_LargestFirstInCurrencyAndRange() {
+	// Given
+	june := time.Date(2024, 6, 10, 0, 0, 0, 0, time.UTC)
+	transactions := []*models.Transaction{
+		{Type: "expense", Amount: 500, Currency: "ARS", Description: "Medium", Category: "food", Date: june},
+		{Type: "expense", Amount: 9000, Currency: "ARS", Description: "Largest", Category: "rent", Date: june},
+		{Type: "income", Amount: 50000, Currency: "ARS", Description: "Salary", Category: "salary", Date: june},
+		{Type: "expense", Amount: 20000, Currency: "USD", Description: "Other currency", Category: "travel", Date: june},
+		{Type: "expense", Amount: 700, Currency: "ARS", Description: "Same amount, older", Category: "food", Date: june.AddDate(0, 0, -2)},
+		{Type: "expense", Amount: 700, Currency: "ARS", Description: "Same amount, newer", Category: "food", Date: june},
+		{Type: "expense", Amount: 99999, Currency: "ARS", Description: "Out of range", Category: "car", Date: june.AddDate(0, 1, 0)},
+	}
+	for _, tx := range transactions {
+		suite.repo.Create(context.Background(), tx)
+	}
+	from, to := june.AddDate(0, 0, -9), june.AddDate(0, 0, 20)
+
+	// When
+	result, err := suite.repo.GetTopExpenses(context.Background(), models.TopExpensesQuery{
+		Currency: "ARS", FromDate: &from, ToDate: &to, Limit: 3,
+	})
+
+	// Then
+	assert.NoError(suite.T(), err)
+	descriptions := make([]string, 0, len(result))
+	for _, transaction := range result {
+		descriptions = append(descriptions, transaction.Description)
+	}
+	assert.Equal(suite.T(), []string{"Largest", "Same amount, newer", "Same amount, older"}, descriptions)
+}
+
+func (suite *MemoryTransactionRepositoryTestSuite) TestGetTopExpenses_TiesByAscendingID() {
+	// Given - stored out of ID order with the same amount and date
+	june := time.Date(2024, 6, 10, 0, 0, 0, 0, time.UTC)
+	suite.repo.Seed([]models.Transaction{
+		{ID: 3, Type: "expense", Amount: 700, Currency: "ARS", Description: "Third", Category: "food", Date: june},
+		{ID: 1, Type: "expense", Amount: 700, Currency: "ARS", Description: "First", Category: "food", Date: june},
+		{ID: 2, Type: "expense", Amount: 700, Currency: "ARS", Description: "Second", Category: "food", Date: june},
+	}, 4)
+
+	// When
+	result, err := suite.repo.GetTopExpenses(context.Background(), models.TopExpensesQuery{Currency: "ARS", Limit: 2})
+
+	// Then
+	assert.NoError(suite.T(), err)
+	if assert.Len(suite.T(), result, 2) {
+		assert.Equal(suite.T(), 1, result[0].ID)
+		assert.Equal(suite.T(), 2, result[1].ID)
+	}
+}
+
 // Test Delete
 func (suite *MemoryTransactionRepositoryTestSuite) TestDelete_Success() {
 	// Given
@@ -458,28 +1008,28 @@ func (suite *MemoryTransactionRepositoryTestSuite) TestDelete_Success() {
 		Type: "expense", Amount: 100, Currency: "ARS",
 		Description: "Test", Category: "food", Date: time.Now(),
 	}
-	suite.repo.Create(transaction)
+	suite.repo.Create(context.Background(), transaction)
 
 	// When
-	err := suite.repo.Delete(1)
+	err := suite.repo.Delete(context.Background(), 1)
 
 	// Then
 	assert.NoError(suite.T(), err)
 
 	// Verify transaction is deleted
-	result, err := suite.repo.GetByID(1)
+	result, err := suite.repo.GetByID(context.Background(), 1)
 	assert.Error(suite.T(), err)
 	assert.Nil(suite.T(), result)
 
 	// Verify repository is empty
-	all, err := suite.repo.GetAll()
+	all, err := suite.repo.GetAll(context.Background())
 	assert.NoError(suite.T(), err)
 	assert.Empty(suite.T(), all)
 }
 
 func (suite *MemoryTransactionRepositoryTestSuite) TestDelete_NotFound() {
 	// When
-	err := suite.repo.Delete(999)
+	err := suite.repo.Delete(context.Background(), 999)
 
 	// Then
 	assert.Error(suite.T(), err)
@@ -495,17 +1045,17 @@ func (suite *MemoryTransactionRepositoryTestSuite) TestDelete_MiddleTransaction(
 	}
 
 	for _, tx := range transactions {
-		suite.repo.Create(tx)
+		suite.repo.Create(context.Background(), tx)
 	}
 
 	// When - delete middle transaction
-	err := suite.repo.Delete(2)
+	err := suite.repo.Delete(context.Background(), 2)
 
 	// Then
 	assert.NoError(suite.T(), err)
 
 	// Verify only 2 transactions remain
-	all, err := suite.repo.GetAll()
+	all, err := suite.repo.GetAll(context.Background())
 	assert.NoError(suite.T(), err)
 	assert.Len(suite.T(), all, 2)
 
@@ -514,11 +1064,240 @@ func (suite *MemoryTransactionRepositoryTestSuite) TestDelete_MiddleTransaction(
 	assert.Equal(suite.T(), "Third", all[1].Description)
 
 	// Verify deleted transaction cannot be found
-	result, err := suite.repo.GetByID(2)
+	result, err := suite.repo.GetByID(context.Background(), 2)
 	assert.Error(suite.T(), err)
 	assert.Nil(suite.T(), result)
 }
 
+func (suite *MemoryTransactionRepositoryTestSuite) TestGetAll_AscendingIDsAfterDelete() {
+	// Given
+	suite.createTransactions(5)
+	suite.repo.Delete(context.Background(), 3)
+	suite.repo.Create(context.Background(), &models.Transaction{
+		Type: "expense", Amount: 600, Currency: "ARS", Description: "Late", Category: "food", Date: time.Now(),
+	})
+
+	// When
+	all, err := suite.repo.GetAll(context.Background())
+
+	// Then
+	assert.NoError(suite.T(), err)
+	ids := make([]int, 0, len(all))
+	for _, transaction := range all {
+		ids = append(ids, transaction.ID)
+	}
+	assert.Equal(suite.T(), []int{1, 2, 4, 5, 6}, ids)
+}
+
+func (suite *MemoryTransactionRepositoryTestSuite) TestIDIndex_ConsistentAfterShiftingDeletes() {
+	// Given
+	suite.createTransactions(6)
+
+	// When - deleting from the front and middle shifts every later position
+	assert.NoError(suite.T(), suite.repo.Delete(context.Background(), 1))
+	assert.NoError(suite.T(), suite.repo.Delete(context.Background(), 4))
+	created := &models.Transaction{Type: "expense", Amount: 7, Currency: "ARS", Description: "Item", Category: "food", Date: time.Now()}
+	assert.NoError(suite.T(), suite.repo.Create(context.Background(), created))
+
+	// Then - every remaining id resolves to its own transaction
+	for _, id := range []int{2, 3, 5, 6, 7} {
+		transaction, err := suite.repo.GetByID(context.Background(), id)
+		assert.NoError(suite.T(), err)
+		assert.Equal(suite.T(), id, transaction.ID)
+		assert.Equal(suite.T(), models.Money(id), transaction.Amount)
+	}
+	for _, id := range []int{1, 4} {
+		_, err := suite.repo.GetByID(context.Background(), id)
+		assert.ErrorIs(suite.T(), err, models.ErrTransactionNotFound)
+	}
+
+	// Updates and deletes land on the right record after the shift
+	updated, _ := suite.repo.GetByID(context.Background(), 6)
+	updated.Description = "Updated"
+	assert.NoError(suite.T(), suite.repo.Update(context.Background(), updated))
+	assert.NoError(suite.T(), suite.repo.Delete(context.Background(), 3))
+
+	all, _ := suite.repo.GetAll(context.Background())
+	assert.Len(suite.T(), all, 4)
+	descriptions := map[int]string{}
+	for _, transaction := range all {
+		descriptions[transaction.ID] = transaction.Description
+	}
+	assert.Equal(suite.T(), map[int]string{2: "Item", 5: "Item", 6: "Updated", 7: "Item"}, descriptions)
+}
+
+func (suite *MemoryTransactionRepositoryTestSuite) TestGetMonthCounts_OnlyMonthsWithData() {
+	// Given - nothing in May 2024, one pending transaction in July
+	dates := []time.Time{
+		time.Date(2024, 6, 30, 23, 0, 0, 0, time.UTC),
+		time.Date(2024, 4, 2, 0, 0, 0, 0, time.UTC),
+		time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC),
+		time.Date(2023, 12, 31, 0, 0, 0, 0, time.UTC),
+	}
+	for _, date := range dates {
+		suite.Require().NoError(suite.repo.Create(context.Background(), &models.Transaction{
+			Type: "expense", Amount: 10, Currency: "ARS", Description: "Item", Category: "food", Date: date,
+		}))
+	}
+	suite.Require().NoError(suite.repo.Create(context.Background(), &models.Transaction{
+		Type: "expense", Amount: 10, Currency: "ARS", Description: "Item", Category: "food",
+		Status: models.TransactionStatusPending, Date: time.Date(2024, 7, 5, 0, 0, 0, 0, time.UTC),
+	}))
+
+	// When
+	cleared, err := suite.repo.GetMonthCounts(context.Background(), false)
+	withPending, pendingErr := suite.repo.GetMonthCounts(context.Background(), true)
+
+	// Then
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), []models.MonthCount{
+		{Year: 2023, Month: 12, TransactionCount: 1},
+		{Year: 2024, Month: 4, TransactionCount: 1},
+		{Year: 2024, Month: 6, TransactionCount: 2},
+	}, cleared)
+
+	assert.NoError(suite.T(), pendingErr)
+	assert.Len(suite.T(), withPending, 4)
+	assert.Equal(suite.T(), models.MonthCount{Year: 2024, Month: 7, TransactionCount: 1}, withPending[3])
+}
+
+func (suite *MemoryTransactionRepositoryTestSuite) TestGetByFilters_UpdatedSinceOrdersByUpdatedAt() {
+	// Given
+	suite.createTransactions(1)
+	first, _ := suite.repo.GetByID(context.Background(), 1)
+	since := first.UpdatedAt
+
+	time.Sleep(repositories.TimestampPrecision)
+	suite.createTransactions(2)
+	time.Sleep(repositories.TimestampPrecision)
+	first.Description = "Edited"
+	suite.Require().NoError(suite.repo.Update(context.Background(), first))
+
+	// When
+	result, err := suite.repo.GetByFilters(context.Background(), models.TransactionFilters{UpdatedSince: &since})
+
+	// Then - ID 1 was updated last, so it comes after 2 and 3
+	assert.NoError(suite.T(), err)
+	ids := make([]int, 0, len(result))
+	for _, transaction := range result {
+		assert.True(suite.T(), transaction.UpdatedAt.After(since))
+		ids = append(ids, transaction.ID)
+	}
+	assert.Equal(suite.T(), []int{2, 3, 1}, ids)
+}
+
+func (suite *MemoryTransactionRepositoryTestSuite) TestGetByFilters_UpdatedSinceIncludesSameInstant() {
+	// Given - one write at the client's last seen updated_at, one just before it
+	since := time.Date(2024, 6, 1, 10, 0, 0, 0, time.UTC)
+	suite.repo.Seed([]models.Transaction{
+		{ID: 1, Type: "expense", Amount: 100, Currency: "ARS", Description: "Before", Category: "food", Date: since, UpdatedAt: since.Add(-time.Millisecond)},
+		{ID: 2, Type: "expense", Amount: 100, Currency: "ARS", Description: "Same instant", Category: "food", Date: since, UpdatedAt: since},
+	}, 3)
+
+	// When
+	result, err := suite.repo.GetByFilters(context.Background(), models.TransactionFilters{UpdatedSince: &since})
+
+	// Then
+	assert.NoError(suite.T(), err)
+	if assert.Len(suite.T(), result, 1) {
+		assert.Equal(suite.T(), 2, result[0].ID)
+	}
+}
+
+func (suite *MemoryTransactionRepositoryTestSuite) TestGetByFilters_UpdatedSinceTiesByAscendingID() {
+	// Given - stored out of ID order, all updated in the same instant
+	since := time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)
+	updated := since.Add(time.Hour)
+	suite.repo.Seed([]models.Transaction{
+		{ID: 3, Type: "expense", Amount: 100, Currency: "ARS", Description: "Third", Category: "food", Date: since, UpdatedAt: updated},
+		{ID: 1, Type: "expense", Amount: 100, Currency: "ARS", Description: "First", Category: "food", Date: since, UpdatedAt: updated},
+		{ID: 2, Type: "expense", Amount: 100, Currency: "ARS", Description: "Second", Category: "food", Date: since, UpdatedAt: updated},
+	}, 4)
+
+	// When
+	result, err := suite.repo.GetByFilters(context.Background(), models.TransactionFilters{UpdatedSince: &since})
+
+	// Then
+	assert.NoError(suite.T(), err)
+	ids := make([]int, 0, len(result))
+	for _, transaction := range result {
+		ids = append(ids, transaction.ID)
+	}
+	assert.Equal(suite.T(), []int{1, 2, 3}, ids)
+}
+
+func (suite *MemoryTransactionRepositoryTestSuite) TestCreate_RejectsOnceMaxTransactionsReached() {
+	// Given
+	repo := repositories.NewMemoryTransactionRepositoryWithConfig(repositories.MemoryTransactionRepositoryConfig{MaxTransactions: 2})
+	for i := 1; i <= 2; i++ {
+		transaction := &models.Transaction{Type: "expense", Amount: models.Money(i), Currency: "ARS", Description: "Item", Category: "food", Date: time.Now()}
+		suite.Require().NoError(repo.Create(context.Background(), transaction))
+	}
+
+	// When
+	rejected := &models.Transaction{Type: "expense", Amount: 3, Currency: "ARS", Description: "Item", Category: "food", Date: time.Now()}
+	err := repo.Create(context.Background(), rejected)
+	again := repo.Create(context.Background(), &models.Transaction{Type: "expense", Amount: 4, Currency: "ARS", Description: "Item", Category: "food", Date: time.Now()})
+
+	// Then
+	assert.ErrorIs(suite.T(), err, models.ErrStorageFull)
+	assert.ErrorIs(suite.T(), again, models.ErrStorageFull)
+	assert.Zero(suite.T(), rejected.ID)
+
+	all, err := repo.GetAll(context.Background())
+	assert.NoError(suite.T(), err)
+	assert.Len(suite.T(), all, 2)
+	stored, err := repo.GetByID(context.Background(), 2)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), models.Money(2), stored.Amount)
+
+	// Deleting frees room for a new transaction
+	assert.NoError(suite.T(), repo.Delete(context.Background(), 1))
+	assert.NoError(suite.T(), repo.Create(context.Background(), rejected))
+	assert.Equal(suite.T(), 3, rejected.ID)
+}
+
+func (suite *MemoryTransactionRepositoryTestSuite) TestRecategorize_OnlyMatchingRecordsChange() {
+	// Given
+	transactions := []*models.Transaction{
+		{Type: "expense", Amount: 100, Currency: "ARS", Description: "A", Category: "uncategorized", Date: time.Now()},
+		{Type: "expense", Amount: 200, Currency: "ARS", Description: "B", Category: "food", Date: time.Now()},
+		{Type: "income", Amount: 300, Currency: "ARS", Description: "C", Category: "uncategorized", Date: time.Now()},
+		{Type: "expense", Amount: 400, Currency: "USD", Description: "D", Category: "uncategorized", Date: time.Now()},
+	}
+	for _, tx := range transactions {
+		suite.repo.Create(context.Background(), tx)
+	}
+	before, _ := suite.repo.LastModified(context.Background())
+
+	// When
+	changes, err := suite.repo.Recategorize(context.Background(), models.TransactionFilters{
+		Category: "uncategorized", Type: "expense", Limit: 1,
+	}, "misc")
+
+	// Then
+	assert.NoError(suite.T(), err)
+	assert.Len(suite.T(), changes, 2)
+	assert.Equal(suite.T(), "uncategorized", changes[0].Before.Category)
+	assert.Equal(suite.T(), "misc", changes[0].After.Category)
+
+	all, _ := suite.repo.GetAll(context.Background())
+	categories := make([]string, 0, len(all))
+	for _, tx := range all {
+		categories = append(categories, tx.Category)
+	}
+	assert.Equal(suite.T(), []string{"misc", "food", "uncategorized", "misc"}, categories)
+	assert.Equal(suite.T(), changes[0].After.UpdatedAt, all[0].UpdatedAt)
+
+	after, _ := suite.repo.LastModified(context.Background())
+	assert.False(suite.T(), after.Before(before))
+
+	// No matches changes nothing
+	none, err := suite.repo.Recategorize(context.Background(), models.TransactionFilters{Category: "rent"}, "misc")
+	assert.NoError(suite.T(), err)
+	assert.Empty(suite.T(), none)
+}
+
 // Test Update
 func (suite *MemoryTransactionRepositoryTestSuite) TestUpdate_Success() {
 	// Given
@@ -526,7 +1305,7 @@ func (suite *MemoryTransactionRepositoryTestSuite) TestUpdate_Success() {
 		Type: "expense", Amount: 100, Currency: "ARS",
 		Description: "Original", Category: "food", Date: time.Now(),
 	}
-	suite.repo.Create(original)
+	suite.repo.Create(context.Background(), original)
 
 	// Capture original timestamps and wait a bit
 	originalCreatedAt := original.CreatedAt
@@ -544,18 +1323,18 @@ func (suite *MemoryTransactionRepositoryTestSuite) TestUpdate_Success() {
 		CreatedAt:   originalCreatedAt, // Keep original
 	}
 
-	beforeUpdate := time.Now()
-	err := suite.repo.Update(updated)
+	beforeUpdate := time.Now().Truncate(repositories.TimestampPrecision)
+	err := suite.repo.Update(context.Background(), updated)
 	afterUpdate := time.Now()
 
 	// Then
 	assert.NoError(suite.T(), err)
 
 	// Verify update
-	result, err := suite.repo.GetByID(1)
+	result, err := suite.repo.GetByID(context.Background(), 1)
 	assert.NoError(suite.T(), err)
 	assert.Equal(suite.T(), "income", result.Type)
-	assert.Equal(suite.T(), 500.0, result.Amount)
+	assert.Equal(suite.T(), models.Money(500.0), result.Amount)
 	assert.Equal(suite.T(), "USD", result.Currency)
 	assert.Equal(suite.T(), "Updated", result.Description)
 	assert.Equal(suite.T(), "work", result.Category)
@@ -575,13 +1354,216 @@ func (suite *MemoryTransactionRepositoryTestSuite) TestUpdate_NotFound() {
 	}
 
 	// When
-	err := suite.repo.Update(transaction)
+	err := suite.repo.Update(context.Background(), transaction)
 
 	// Then
 	assert.Error(suite.T(), err)
 	assert.Contains(suite.T(), err.Error(), "transaction not found")
 }
 
+// Test LastModified
+func (suite *MemoryTransactionRepositoryTestSuite) TestLastModified_TracksWrites() {
+	// Given - empty repository
+	lastModified, err := suite.repo.LastModified(context.Background())
+	assert.NoError(suite.T(), err)
+	assert.True(suite.T(), lastModified.IsZero())
+
+	transaction := &models.Transaction{Type: "expense", Amount: 100, Currency: "ARS", Category: "food", Date: time.Now()}
+	suite.repo.Create(context.Background(), transaction)
+
+	// When
+	afterCreate, err := suite.repo.LastModified(context.Background())
+
+	// Then
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), transaction.UpdatedAt, afterCreate)
+
+	// When - deleting also counts as a modification
+	suite.repo.Delete(context.Background(), transaction.ID)
+	afterDelete, _ := suite.repo.LastModified(context.Background())
+
+	// Then
+	assert.False(suite.T(), afterDelete.Before(afterCreate))
+}
+
+// Test RunInTransaction
+func (suite *MemoryTransactionRepositoryTestSuite) TestRunInTransaction_RollsBackOnFailure() {
+	// Given
+	ctx := context.Background()
+	original := &models.Transaction{Type: "expense", Amount: 100, Currency: "ARS", Description: "Dinner", Category: "food"}
+	suite.Require().NoError(suite.repo.Create(ctx, original))
+	before, _ := suite.repo.GetAll(ctx)
+	lastModified, _ := suite.repo.LastModified(ctx)
+	failure := errors.New("second write failed")
+
+	// When - the operation updates, creates and then fails midway
+	err := suite.repo.RunInTransaction(ctx, func(tx repositories.TransactionRepository) error {
+		changed := *original
+		changed.Amount = 60
+		if err := tx.Update(ctx, &changed); err != nil {
+			return err
+		}
+		if err := tx.Create(ctx, &models.Transaction{Type: "expense", Amount: 40, Currency: "ARS", Description: "Dinner (split)", Category: "food"}); err != nil {
+			return err
+		}
+		return failure
+	})
+
+	// Then
+	assert.ErrorIs(suite.T(), err, failure)
+	after, _ := suite.repo.GetAll(ctx)
+	assert.Equal(suite.T(), before, after)
+	restoredModified, _ := suite.repo.LastModified(ctx)
+	assert.Equal(suite.T(), lastModified, restoredModified)
+
+	// Then - ids are not burnt by the rolled back create
+	next := &models.Transaction{Type: "expense", Amount: 1, Currency: "ARS", Description: "Next", Category: "food"}
+	suite.Require().NoError(suite.repo.Create(ctx, next))
+	assert.Equal(suite.T(), original.ID+1, next.ID)
+}
+
+func (suite *MemoryTransactionRepositoryTestSuite) TestRunInTransaction_KeepsWritesOnSuccess() {
+	// Given
+	ctx := context.Background()
+
+	// When
+	err := suite.repo.RunInTransaction(ctx, func(tx repositories.TransactionRepository) error {
+		for _, description := range []string{"Part 1", "Part 2"} {
+			if err := tx.Create(ctx, &models.Transaction{Type: "expense", Amount: 50, Currency: "ARS", Description: description, Category: "food"}); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+
+	// Then
+	assert.NoError(suite.T(), err)
+	all, _ := suite.repo.GetAll(ctx)
+	assert.Len(suite.T(), all, 2)
+}
+
+func (suite *MemoryTransactionRepositoryTestSuite) TestRunInTransaction_RollsBackOnPanic() {
+	// Given
+	ctx := context.Background()
+
+	// When
+	assert.Panics(suite.T(), func() {
+		suite.repo.RunInTransaction(ctx, func(tx repositories.TransactionRepository) error {
+			tx.Create(ctx, &models.Transaction{Type: "expense", Amount: 50, Currency: "ARS", Description: "Lost", Category: "food"})
+			panic("boom")
+		})
+	})
+
+	// Then
+	all, _ := suite.repo.GetAll(ctx)
+	assert.Empty(suite.T(), all)
+}
+
+func (suite *MemoryTransactionRepositoryTestSuite) TestRunInTransaction_ConcurrentWritersSurviveRollback() {
+	// Given
+	ctx := context.Background()
+	failure := errors.New("operation failed")
+	started := make(chan bool)
+	done := make(chan bool)
+	var seen []models.Transaction
+
+	// When - a plain create and a read are issued while the failing operation runs
+	err := suite.repo.RunInTransaction(ctx, func(tx repositories.TransactionRepository) error {
+		if err := tx.Create(ctx, &models.Transaction{Type: "expense", Amount: 50, Currency: "ARS", Description: "Uncommitted", Category: "food"}); err != nil {
+			return err
+		}
+		go func() {
+			started <- true
+			seen, _ = suite.repo.GetAll(ctx)
+			assert.NoError(suite.T(), suite.repo.Create(ctx, &models.Transaction{Type: "income", Amount: 10, Currency: "ARS", Description: "Concurrent", Category: "work"}))
+			done <- true
+		}()
+		<-started
+		time.Sleep(10 * time.Millisecond) // Give the concurrent calls time to reach the store
+		return failure
+	})
+	<-done
+
+	// Then - the reader never saw the uncommitted write and the concurrent create is kept
+	assert.ErrorIs(suite.T(), err, failure)
+	assert.Empty(suite.T(), seen)
+	all, _ := suite.repo.GetAll(ctx)
+	if assert.Len(suite.T(), all, 1) {
+		assert.Equal(suite.T(), "Concurrent", all[0].Description)
+		assert.Equal(suite.T(), 1, all[0].ID)
+	}
+}
+
+// Test Reset
+func (suite *MemoryTransactionRepositoryTestSuite) TestReset_EmptiesStoreAndRestartsIDs() {
+	// Given
+	ctx := context.Background()
+	for i := 0; i < 3; i++ {
+		suite.Require().NoError(suite.repo.Create(ctx, &models.Transaction{Type: "expense", Amount: 10, Currency: "ARS", Description: "Item", Category: "food"}))
+	}
+
+	// When
+	suite.repo.Reset()
+
+	// Then
+	all, err := suite.repo.GetAll(ctx)
+	assert.NoError(suite.T(), err)
+	assert.Empty(suite.T(), all)
+	lastModified, _ := suite.repo.LastModified(ctx)
+	assert.True(suite.T(), lastModified.IsZero())
+	_, err = suite.repo.GetByID(ctx, 1)
+	assert.ErrorIs(suite.T(), err, models.ErrTransactionNotFound)
+
+	transaction := &models.Transaction{Type: "expense", Amount: 10, Currency: "ARS", Description: "Fresh", Category: "food"}
+	suite.Require().NoError(suite.repo.Create(ctx, transaction))
+	assert.Equal(suite.T(), 1, transaction.ID)
+}
+
+// Test CheckIntegrity
+func (suite *MemoryTransactionRepositoryTestSuite) TestCheckIntegrity_ConsistentStore() {
+	// Given
+	ctx := context.Background()
+	for i := 0; i < 3; i++ {
+		suite.Require().NoError(suite.repo.Create(ctx, &models.Transaction{Type: "expense", Amount: 10, Currency: "ARS", Description: "Item", Category: "food", Date: time.Now()}))
+	}
+	suite.Require().NoError(suite.repo.Delete(ctx, 2))
+
+	// When
+	report, err := suite.repo.CheckIntegrity(ctx)
+
+	// Then
+	suite.Require().NoError(err)
+	assert.True(suite.T(), report.OK)
+	assert.Empty(suite.T(), report.Issues)
+	assert.Equal(suite.T(), 2, report.TransactionCount)
+	assert.Equal(suite.T(), 4, report.NextID)
+}
+
+func (suite *MemoryTransactionRepositoryTestSuite) TestCheckIntegrity_FlagsSeededInconsistencies() {
+	// Given - a duplicated id, a record with no type or currency, and a stale next id
+	date := time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)
+	valid := models.Transaction{ID: 1, Type: "expense", Amount: 10, Currency: "ARS", Category: "food", Date: date, CreatedAt: date}
+	duplicate := valid
+	duplicate.Description = "Imported twice"
+	corrupt := models.Transaction{ID: 5, Amount: 10, Date: date, CreatedAt: date}
+	suite.repo.Seed([]models.Transaction{valid, duplicate, corrupt}, 3)
+
+	// When
+	report, err := suite.repo.CheckIntegrity(context.Background())
+
+	// Then
+	suite.Require().NoError(err)
+	assert.False(suite.T(), report.OK)
+	codes := make(map[string][]int)
+	for _, issue := range report.Issues {
+		codes[issue.Code] = append(codes[issue.Code], issue.TransactionID)
+	}
+	assert.Equal(suite.T(), []int{1}, codes[models.IntegrityDuplicateID])
+	assert.Equal(suite.T(), []int{5, 5}, codes[models.IntegrityInvalidRecord])
+	assert.Equal(suite.T(), []int{0}, codes[models.IntegrityNextIDTooLow])
+	assert.Contains(suite.T(), codes, models.IntegrityIndexMismatch)
+}
+
 func TestMemoryTransactionRepositoryTestSuite(t *testing.T) {
 	suite.Run(t, new(MemoryTransactionRepositoryTestSuite))
 }
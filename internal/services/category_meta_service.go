@@ -0,0 +1,136 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"regexp"
+	"strings"
+
+	"github.com/maximicciullo/personal-finance-api/internal/middleware"
+	"github.com/maximicciullo/personal-finance-api/internal/models"
+	"github.com/maximicciullo/personal-finance-api/internal/repositories"
+	"go.uber.org/zap"
+)
+
+const maxCategoryIconLength = 64
+
+// hexColorPattern accepts #RGB and #RRGGBB colors
+var hexColorPattern = regexp.MustCompile(`^#([0-9a-fA-F]{3}|[0-9a-fA-F]{6})$`)
+
+type categoryMetaService struct {
+	repo   repositories.CategoryMetaRepository
+	logger *middleware.BusinessLoggerInstance
+}
+
+func NewCategoryMetaService(repo repositories.CategoryMetaRepository) CategoryMetaService {
+	return &categoryMetaService{
+		repo:   repo,
+		logger: middleware.BusinessLogger(),
+	}
+}
+
+// SetCategoryMeta creates or replaces the color and icon of a category
+func (s *categoryMetaService) SetCategoryMeta(ctx context.Context, category string, req *models.CategoryMetaRequest) (*models.CategoryMeta, error) {
+	s.logger.Service("SetCategoryMeta started",
+		zap.String("category", category),
+	)
+
+	meta, err := buildCategoryMeta(category, req)
+	if err != nil {
+		s.logger.Error("service", "SetCategoryMeta - validation failed", err,
+			zap.String("category", category),
+			zap.Any("request", req),
+		)
+		return nil, err
+	}
+
+	if err := s.repo.Set(ctx, meta); err != nil {
+		s.logger.Error("service", "SetCategoryMeta - repository error", err)
+		return nil, err
+	}
+
+	s.logger.Service("SetCategoryMeta completed successfully",
+		zap.String("category", meta.Category),
+	)
+
+	return meta, nil
+}
+
+func (s *categoryMetaService) GetCategoryMeta(ctx context.Context, category string) (*models.CategoryMeta, error) {
+	s.logger.Service("GetCategoryMeta started",
+		zap.String("category", category),
+	)
+
+	meta, err := s.repo.Get(ctx, strings.TrimSpace(category))
+	if err != nil {
+		s.logger.Error("service", "GetCategoryMeta - repository error", err,
+			zap.String("category", category),
+		)
+		return nil, err
+	}
+
+	s.logger.Service("GetCategoryMeta completed successfully",
+		zap.String("category", category),
+	)
+
+	return meta, nil
+}
+
+func (s *categoryMetaService) GetAllCategoryMeta(ctx context.Context) ([]models.CategoryMeta, error) {
+	s.logger.Service("GetAllCategoryMeta started")
+
+	all, err := s.repo.GetAll(ctx)
+	if err != nil {
+		s.logger.Error("service", "GetAllCategoryMeta - repository error", err)
+		return nil, err
+	}
+
+	s.logger.Service("GetAllCategoryMeta completed successfully",
+		zap.Int("category_count", len(all)),
+	)
+
+	return all, nil
+}
+
+func (s *categoryMetaService) DeleteCategoryMeta(ctx context.Context, category string) error {
+	s.logger.Service("DeleteCategoryMeta started",
+		zap.String("category", category),
+	)
+
+	if err := s.repo.Delete(ctx, strings.TrimSpace(category)); err != nil {
+		s.logger.Error("service", "DeleteCategoryMeta - repository error", err,
+			zap.String("category", category),
+		)
+		return err
+	}
+
+	s.logger.Service("DeleteCategoryMeta completed successfully",
+		zap.String("category", category),
+	)
+
+	return nil
+}
+
+// buildCategoryMeta validates the request; at least one of color and icon is required
+func buildCategoryMeta(category string, req *models.CategoryMetaRequest) (*models.CategoryMeta, error) {
+	meta := &models.CategoryMeta{
+		Category: strings.TrimSpace(category),
+		Color:    strings.TrimSpace(req.Color),
+		Icon:     strings.TrimSpace(req.Icon),
+	}
+
+	if meta.Category == "" {
+		return nil, errors.New("category is required")
+	}
+	if meta.Color == "" && meta.Icon == "" {
+		return nil, errors.New("color or icon is required")
+	}
+	if meta.Color != "" && !hexColorPattern.MatchString(meta.Color) {
+		return nil, errors.New("color must be a hex value like #RRGGBB")
+	}
+	if len(meta.Icon) > maxCategoryIconLength {
+		return nil, errors.New("icon must be at most 64 characters")
+	}
+
+	return meta, nil
+}
@@ -0,0 +1,19 @@
+package services
+
+import "time"
+
+// Clock supplies the current time so date-dependent logic can be tested deterministically
+type Clock interface {
+	Now() time.Time
+}
+
+type realClock struct{}
+
+func (realClock) Now() time.Time {
+	return time.Now()
+}
+
+// RealClock returns a Clock backed by time.Now
+func RealClock() Clock {
+	return realClock{}
+}
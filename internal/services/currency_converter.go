@@ -0,0 +1,60 @@
+package services
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/maximicciullo/personal-finance-api/internal/models"
+)
+
+// CurrencyConverter converts amounts between currencies using fixed rates. Each rate is
+// the value of one unit of the currency in a shared reference unit, so only the ratio
+// between two rates matters.
+type CurrencyConverter struct {
+	rates map[string]float64
+}
+
+func NewCurrencyConverter(rates map[string]float64) *CurrencyConverter {
+	copied := make(map[string]float64, len(rates))
+	for currency, rate := range rates {
+		if rate > 0 {
+			copied[currency] = rate
+		}
+	}
+	return &CurrencyConverter{rates: copied}
+}
+
+// Convert returns amount expressed in the to currency. Converting a currency to
+// itself never needs a rate.
+func (c *CurrencyConverter) Convert(amount models.Money, from, to string) (models.Money, error) {
+	if from == to {
+		return amount, nil
+	}
+	if missing := c.MissingRates([]string{from}, to); len(missing) > 0 {
+		return 0, fmt.Errorf("no exchange rate for %v", missing)
+	}
+	return amount * models.Money(c.rates[from]/c.rates[to]), nil
+}
+
+// MissingRates returns, sorted, the currencies without a rate that converting every
+// one of currencies into base would need
+func (c *CurrencyConverter) MissingRates(currencies []string, base string) []string {
+	missing := make(map[string]bool)
+	for _, currency := range currencies {
+		if currency == base {
+			continue
+		}
+		for _, needed := range []string{currency, base} {
+			if _, ok := c.rates[needed]; !ok {
+				missing[needed] = true
+			}
+		}
+	}
+
+	result := make([]string, 0, len(missing))
+	for currency := range missing {
+		result = append(result, currency)
+	}
+	sort.Strings(result)
+	return result
+}
@@ -0,0 +1,46 @@
+package services
+
+import (
+	"github.com/maximicciullo/personal-finance-api/internal/models"
+	"github.com/shopspring/decimal"
+)
+
+// currencySums accumulates amounts per currency with decimal arithmetic, so long sums
+// such as ten 0.1 expenses add up to exactly 1. Reports convert back to Money only
+// once totals are final.
+type currencySums map[string]decimal.Decimal
+
+func (c currencySums) add(currency string, amount models.Money) {
+	c[currency] = c[currency].Add(decimal.NewFromFloat(float64(amount)))
+}
+
+func (c currencySums) sub(currency string, amount models.Money) {
+	c[currency] = c[currency].Sub(decimal.NewFromFloat(float64(amount)))
+}
+
+// money returns the sums as Money, the type reports serialize, rounded to each
+// currency's decimals so e.g. JPY totals are whole yen
+func (c currencySums) money() models.CurrencyAmounts {
+	amounts := make(models.CurrencyAmounts, len(c))
+	for currency, sum := range c {
+		decimals := int32(models.CurrencyMetaFor(currency).Decimals)
+		amounts[currency] = models.Money(sum.Round(decimals).InexactFloat64())
+	}
+	return amounts
+}
+
+// equal reports whether both hold the same amount for every currency, treating a
+// missing currency as zero
+func (c currencySums) equal(other currencySums) bool {
+	for currency, sum := range c {
+		if !sum.Equal(other[currency]) {
+			return false
+		}
+	}
+	for currency, sum := range other {
+		if !sum.Equal(c[currency]) {
+			return false
+		}
+	}
+	return true
+}
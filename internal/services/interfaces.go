@@ -1,18 +1,67 @@
 package services
 
 import (
+	"context"
+	"time"
+
 	"github.com/maximicciullo/personal-finance-api/internal/models"
 )
 
 type TransactionService interface {
-	CreateTransaction(req *models.CreateTransactionRequest) (*models.Transaction, error)
-	GetTransaction(id int) (*models.Transaction, error)
-	GetTransactions(filters models.TransactionFilters) ([]models.Transaction, error)
-	UpdateTransaction(id int, req *models.UpdateTransactionRequest) (*models.Transaction, error)
-	DeleteTransaction(id int) error
+	CreateTransaction(ctx context.Context, req *models.CreateTransactionRequest) (*models.Transaction, error)
+	CreateTransactionWithWarnings(ctx context.Context, req *models.CreateTransactionRequest) (*models.Transaction, []models.Warning, error)
+	PreviewCreateTransaction(ctx context.Context, req *models.CreateTransactionRequest) (*models.Transaction, []models.Warning, error)
+	ValidateCreate(ctx context.Context, req *models.CreateTransactionRequest) map[string]string
+	GetTransaction(ctx context.Context, id int) (*models.Transaction, error)
+	GetTransactions(ctx context.Context, filters models.TransactionFilters) ([]models.Transaction, error)
+	GetLedger(ctx context.Context, filters models.TransactionFilters) ([]models.LedgerEntry, error)
+	GetRecentTransactions(ctx context.Context, limit int) ([]models.Transaction, error)
+	GetTopExpenses(ctx context.Context, query models.TopExpensesQuery) ([]models.Transaction, error)
+	GetTransactionsByDay(ctx context.Context, year, month int) (map[string][]models.Transaction, error)
+	StreamTransactions(ctx context.Context) (<-chan models.Transaction, <-chan error)
+	GetTransactionsByIDs(ctx context.Context, ids []int) (*models.BatchTransactionsResponse, error)
+	UpdateTransaction(ctx context.Context, id int, req *models.UpdateTransactionRequest) (*models.Transaction, error)
+	UpdateTransactionWithWarnings(ctx context.Context, id int, req *models.UpdateTransactionRequest) (*models.Transaction, []models.Warning, error)
+	PreviewUpdateTransaction(ctx context.Context, id int, req *models.UpdateTransactionRequest) (*models.Transaction, []models.Warning, error)
+	SetPinned(ctx context.Context, id int, pinned bool) (*models.Transaction, error)
+	CloneTransaction(ctx context.Context, id int, overrides *models.UpdateTransactionRequest) (*models.Transaction, []models.Warning, error)
+	DeleteTransaction(ctx context.Context, id int) error
+	RecategorizeTransactions(ctx context.Context, req *models.RecategorizeRequest) (*models.RecategorizeResult, error)
+	GetTransactionHistory(ctx context.Context, id int) ([]models.AuditEntry, error)
+	GetLastModified(ctx context.Context) (time.Time, error)
+	CheckIntegrity(ctx context.Context) (*models.IntegrityReport, error)
+}
+
+type TemplateService interface {
+	CreateTemplate(ctx context.Context, req *models.TemplateRequest) (*models.Template, error)
+	GetTemplate(ctx context.Context, id int) (*models.Template, error)
+	GetTemplates(ctx context.Context) ([]models.Template, error)
+	UpdateTemplate(ctx context.Context, id int, req *models.TemplateRequest) (*models.Template, error)
+	DeleteTemplate(ctx context.Context, id int) error
+	ApplyTemplate(ctx context.Context, id int, overrides *models.UpdateTransactionRequest) (*models.Transaction, error)
+}
+
+type CategoryMetaService interface {
+	SetCategoryMeta(ctx context.Context, category string, req *models.CategoryMetaRequest) (*models.CategoryMeta, error)
+	GetCategoryMeta(ctx context.Context, category string) (*models.CategoryMeta, error)
+	GetAllCategoryMeta(ctx context.Context) ([]models.CategoryMeta, error)
+	DeleteCategoryMeta(ctx context.Context, category string) error
 }
 
 type ReportService interface {
-	GetMonthlyReport(year, month int) (*models.MonthlyReport, error)
-	GetCurrentMonthReport() (*models.MonthlyReport, error)
+	GetMonthlyReport(ctx context.Context, year, month int) (*models.MonthlyReport, error)
+	GetFilteredMonthlyReport(ctx context.Context, year, month int, filters models.TransactionFilters) (*models.MonthlyReport, error)
+	GetCurrentMonthReport(ctx context.Context, includePending, includeProjected bool, location *time.Location) (*models.MonthlyReport, error)
+	GetBalanceAsOf(ctx context.Context, asOf time.Time, base string, includePending bool) (*models.BalanceReport, error)
+	GetNetWorthReport(ctx context.Context, from, to time.Time, granularity string, openingBalance map[string]float64, includePending bool) (*models.NetWorthReport, error)
+	GetGroupedReport(ctx context.Context, field string, fromDate, toDate *time.Time, includePending bool) (*models.GroupedReport, error)
+	GetAnomalyReport(ctx context.Context, fromDate, toDate *time.Time, includePending bool) (*models.AnomalyReport, error)
+	GetCategoryTrend(ctx context.Context, category string, months int, includePending bool) (*models.CategoryTrendReport, error)
+	GetSavingsRate(ctx context.Context, year, month int, includePending bool, location *time.Location) (*models.SavingsRateReport, error)
+	GetIncomeExpense(ctx context.Context, year, month int, includePending bool, location *time.Location) (*models.IncomeExpenseReport, error)
+	GetMonthlyTotals(ctx context.Context, year, month int, includePending bool, location *time.Location) (*models.MonthlyTotals, error)
+	GetReportMonths(ctx context.Context, includePending bool) ([]models.MonthCount, error)
+	GetMonthDiff(ctx context.Context, a, b time.Time, includePending bool, location *time.Location) (*models.MonthDiffReport, error)
+	CloseMonth(ctx context.Context, year, month int) (*models.ClosedMonth, error)
+	ReopenMonth(ctx context.Context, year, month int) error
 }
\ No newline at end of file
@@ -0,0 +1,179 @@
+package services
+
+import (
+	"context"
+	"math"
+	"sort"
+	"time"
+
+	"github.com/maximicciullo/personal-finance-api/internal/models"
+	"go.uber.org/zap"
+)
+
+// outlierStdDevs is how many standard deviations from its category mean an amount
+// must be to count as an outlier
+const outlierStdDevs = 3.0
+
+// GetAnomalyReport computes amount statistics per category and currency, then flags
+// categories recorded in mixed currencies and amounts more than outlierStdDevs
+// standard deviations from their category mean
+func (s *reportService) GetAnomalyReport(ctx context.Context, fromDate, toDate *time.Time, includePending bool) (*models.AnomalyReport, error) {
+	s.logger.Service("GetAnomalyReport started")
+
+	repoStart := time.Now()
+	transactions, err := s.repo.GetByFilters(ctx, models.TransactionFilters{
+		FromDate: fromDate,
+		ToDate:   toDate,
+	})
+	repoDuration := time.Since(repoStart)
+
+	s.logger.Performance("GetAnomalyReport repository call", repoDuration,
+		zap.Int("transaction_count", len(transactions)),
+		zap.Bool("success", err == nil),
+	)
+
+	if err != nil {
+		s.logger.Error("service", "GetAnomalyReport - repository error", err)
+		return nil, err
+	}
+
+	if !includePending {
+		transactions = withoutPending(transactions)
+	}
+
+	stats := categoryStats(transactions)
+	report := &models.AnomalyReport{
+		FromDate:                fromDate,
+		ToDate:                  toDate,
+		TransactionCount:        len(transactions),
+		Stats:                   stats,
+		MixedCurrencyCategories: mixedCurrencyCategories(transactions),
+		Outliers:                amountOutliers(transactions, stats),
+	}
+
+	s.logger.Service("GetAnomalyReport completed successfully",
+		zap.Int("mixed_currency_categories", len(report.MixedCurrencyCategories)),
+		zap.Int("outliers", len(report.Outliers)),
+		zap.Duration("total_duration", time.Since(repoStart)),
+	)
+
+	return report, nil
+}
+
+type categoryCurrency struct {
+	category string
+	currency string
+}
+
+// categoryStats returns the count, mean and standard deviation of the amounts of each
+// category and currency, sorted by category then currency
+func categoryStats(transactions []models.Transaction) []models.CategoryStats {
+	amounts := make(map[categoryCurrency][]float64)
+	for _, transaction := range transactions {
+		key := categoryCurrency{transaction.Category, transaction.Currency}
+		amounts[key] = append(amounts[key], float64(transaction.Amount))
+	}
+
+	stats := make([]models.CategoryStats, 0, len(amounts))
+	for key, values := range amounts {
+		var sum float64
+		for _, v := range values {
+			sum += v
+		}
+		mean := sum / float64(len(values))
+
+		var squares float64
+		for _, v := range values {
+			squares += (v - mean) * (v - mean)
+		}
+
+		stats = append(stats, models.CategoryStats{
+			Category: key.category,
+			Currency: key.currency,
+			Count:    len(values),
+			Mean:     models.Money(mean),
+			StdDev:   models.Money(math.Sqrt(squares / float64(len(values)))),
+		})
+	}
+
+	sort.Slice(stats, func(i, j int) bool {
+		if stats[i].Category != stats[j].Category {
+			return stats[i].Category < stats[j].Category
+		}
+		return stats[i].Currency < stats[j].Currency
+	})
+
+	return stats
+}
+
+// mixedCurrencyCategories lists the categories recorded in more than one currency,
+// sorted by category. Ties for the dominant currency go to the alphabetically first.
+func mixedCurrencyCategories(transactions []models.Transaction) []models.MixedCurrencyCategory {
+	counts := make(map[string]map[string]int)
+	for _, transaction := range transactions {
+		if counts[transaction.Category] == nil {
+			counts[transaction.Category] = make(map[string]int)
+		}
+		counts[transaction.Category][transaction.Currency]++
+	}
+
+	mixed := make([]models.MixedCurrencyCategory, 0)
+	for category, currencies := range counts {
+		if len(currencies) < 2 {
+			continue
+		}
+
+		dominant := ""
+		for currency, count := range currencies {
+			if dominant == "" || count > currencies[dominant] || (count == currencies[dominant] && currency < dominant) {
+				dominant = currency
+			}
+		}
+
+		others := make([]int, 0)
+		for _, transaction := range transactions {
+			if transaction.Category == category && transaction.Currency != dominant {
+				others = append(others, transaction.ID)
+			}
+		}
+
+		mixed = append(mixed, models.MixedCurrencyCategory{
+			Category:            category,
+			CurrencyCounts:      currencies,
+			DominantCurrency:    dominant,
+			OtherTransactionIDs: others,
+		})
+	}
+
+	sort.Slice(mixed, func(i, j int) bool { return mixed[i].Category < mixed[j].Category })
+	return mixed
+}
+
+// amountOutliers returns the transactions whose amount is more than outlierStdDevs
+// standard deviations from the mean of their category and currency
+func amountOutliers(transactions []models.Transaction, stats []models.CategoryStats) []models.AmountOutlier {
+	byKey := make(map[categoryCurrency]models.CategoryStats, len(stats))
+	for _, stat := range stats {
+		byKey[categoryCurrency{stat.Category, stat.Currency}] = stat
+	}
+
+	outliers := make([]models.AmountOutlier, 0)
+	for _, transaction := range transactions {
+		stat := byKey[categoryCurrency{transaction.Category, transaction.Currency}]
+		if stat.StdDev == 0 {
+			continue
+		}
+
+		z := float64(transaction.Amount-stat.Mean) / float64(stat.StdDev)
+		if math.Abs(z) > outlierStdDevs {
+			outliers = append(outliers, models.AmountOutlier{
+				Transaction: transaction,
+				Mean:        stat.Mean,
+				StdDev:      stat.StdDev,
+				ZScore:      math.Round(z*100) / 100,
+			})
+		}
+	}
+
+	return outliers
+}
@@ -0,0 +1,83 @@
+package services
+
+import (
+	"context"
+	"errors"
+
+	"github.com/maximicciullo/personal-finance-api/internal/models"
+	"go.uber.org/zap"
+)
+
+// errMonthClosingDisabled is returned when the service has no closed-month store
+var errMonthClosingDisabled = errors.New("month closing is not configured")
+
+// CloseMonth records the month as closed. Closing an already closed month succeeds
+// and keeps the original closing time.
+func (s *reportService) CloseMonth(ctx context.Context, year, month int) (*models.ClosedMonth, error) {
+	s.logger.Service("CloseMonth started",
+		zap.Int("year", year),
+		zap.Int("month", month),
+	)
+
+	if err := s.validateClosingMonth(year, month); err != nil {
+		s.logger.Error("service", "CloseMonth - validation failed", err,
+			zap.Int("year", year),
+			zap.Int("month", month),
+		)
+		return nil, err
+	}
+
+	closed, err := s.config.ClosedMonths.Close(ctx, year, month)
+	if err != nil {
+		s.logger.Error("service", "CloseMonth - repository error", err)
+		return nil, err
+	}
+
+	s.logger.Service("CloseMonth completed successfully",
+		zap.Int("year", year),
+		zap.Int("month", month),
+	)
+
+	return closed, nil
+}
+
+// ReopenMonth removes the month from the closed set so its transactions can change again
+func (s *reportService) ReopenMonth(ctx context.Context, year, month int) error {
+	s.logger.Service("ReopenMonth started",
+		zap.Int("year", year),
+		zap.Int("month", month),
+	)
+
+	if err := s.validateClosingMonth(year, month); err != nil {
+		s.logger.Error("service", "ReopenMonth - validation failed", err,
+			zap.Int("year", year),
+			zap.Int("month", month),
+		)
+		return err
+	}
+
+	if err := s.config.ClosedMonths.Reopen(ctx, year, month); err != nil {
+		s.logger.Error("service", "ReopenMonth - repository error", err)
+		return err
+	}
+
+	s.logger.Service("ReopenMonth completed successfully",
+		zap.Int("year", year),
+		zap.Int("month", month),
+	)
+
+	return nil
+}
+
+func (s *reportService) validateClosingMonth(year, month int) error {
+	if s.config.ClosedMonths == nil {
+		return errMonthClosingDisabled
+	}
+	if year < 1900 || year > s.config.Clock.Now().Year()+10 {
+		return errors.New("invalid year")
+	}
+	if month < 1 || month > 12 {
+		return errors.New("month must be between 1 and 12")
+	}
+	return nil
+}
@@ -0,0 +1,97 @@
+package services
+
+import (
+	"context"
+	"time"
+
+	"github.com/maximicciullo/personal-finance-api/internal/models"
+	"go.uber.org/zap"
+)
+
+// GetMonthDiff builds the monthly reports for a and b and subtracts them, giving the
+// change per currency and per category from month a to month b. Only the year and
+// month of each date are used; month boundaries are computed in location.
+func (s *reportService) GetMonthDiff(ctx context.Context, a, b time.Time, includePending bool, location *time.Location) (*models.MonthDiffReport, error) {
+	s.logger.Service("GetMonthDiff started",
+		zap.String("a", a.Format("2006-01")),
+		zap.String("b", b.Format("2006-01")),
+	)
+
+	start := time.Now()
+	filters := models.TransactionFilters{IncludePending: includePending, Location: location}
+	reportA, err := s.GetFilteredMonthlyReport(ctx, a.Year(), int(a.Month()), filters)
+	if err != nil {
+		s.logger.Error("service", "GetMonthDiff - monthly report failed", err,
+			zap.String("month", a.Format("2006-01")),
+		)
+		return nil, err
+	}
+	reportB, err := s.GetFilteredMonthlyReport(ctx, b.Year(), int(b.Month()), filters)
+	if err != nil {
+		s.logger.Error("service", "GetMonthDiff - monthly report failed", err,
+			zap.String("month", b.Format("2006-01")),
+		)
+		return nil, err
+	}
+
+	report := &models.MonthDiffReport{
+		A:          a.Format("2006-01"),
+		B:          b.Format("2006-01"),
+		Currencies: make(map[string]models.CurrencyDelta),
+		Categories: make(map[string]models.CategoryDelta),
+	}
+
+	income := moneyDelta(reportA.TotalIncome, reportB.TotalIncome)
+	expense := moneyDelta(reportA.TotalExpense, reportB.TotalExpense)
+	balance := moneyDelta(reportA.Balance, reportB.Balance)
+	for currency := range balance {
+		report.Currencies[currency] = models.CurrencyDelta{
+			Income:  income[currency],
+			Expense: expense[currency],
+			Balance: balance[currency],
+		}
+	}
+
+	for _, breakdown := range []map[string]models.CategoryTotal{reportA.Summary.CategoryBreakdown, reportB.Summary.CategoryBreakdown} {
+		for category := range breakdown {
+			if _, done := report.Categories[category]; done {
+				continue
+			}
+			totalsA := categoryTotals(reportA.Summary.CategoryBreakdown, category)
+			totalsB := categoryTotals(reportB.Summary.CategoryBreakdown, category)
+			report.Categories[category] = models.CategoryDelta{
+				A:     totalsA,
+				B:     totalsB,
+				Delta: moneyDelta(totalsA, totalsB),
+			}
+		}
+	}
+
+	s.logger.Service("GetMonthDiff completed successfully",
+		zap.Int("currencies_count", len(report.Currencies)),
+		zap.Int("categories_count", len(report.Categories)),
+		zap.Duration("total_duration", time.Since(start)),
+	)
+
+	return report, nil
+}
+
+// moneyDelta returns b minus a for every currency in either, a missing one being zero
+func moneyDelta(a, b map[string]models.Money) map[string]models.Money {
+	sums := currencySums{}
+	for currency, amount := range b {
+		sums.add(currency, amount)
+	}
+	for currency, amount := range a {
+		sums.sub(currency, amount)
+	}
+	return sums.money()
+}
+
+// categoryTotals returns a category's totals from a breakdown, empty when it is absent
+func categoryTotals(breakdown map[string]models.CategoryTotal, category string) map[string]models.Money {
+	if total, ok := breakdown[category]; ok && total.Totals != nil {
+		return total.Totals
+	}
+	return map[string]models.Money{}
+}
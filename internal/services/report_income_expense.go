@@ -0,0 +1,41 @@
+package services
+
+import (
+	"context"
+	"time"
+
+	"github.com/maximicciullo/personal-finance-api/internal/models"
+	"go.uber.org/zap"
+)
+
+// GetIncomeExpense trims the monthly report down to its income and expense totals per
+// currency, for lightweight chart requests
+func (s *reportService) GetIncomeExpense(ctx context.Context, year, month int, includePending bool, location *time.Location) (*models.IncomeExpenseReport, error) {
+	s.logger.Service("GetIncomeExpense started",
+		zap.Int("year", year),
+		zap.Int("month", month),
+	)
+
+	start := time.Now()
+	monthly, err := s.GetFilteredMonthlyReport(ctx, year, month, models.TransactionFilters{IncludePending: includePending, Location: location})
+	if err != nil {
+		s.logger.Error("service", "GetIncomeExpense - monthly report failed", err,
+			zap.Int("year", year),
+			zap.Int("month", month),
+		)
+		return nil, err
+	}
+
+	report := &models.IncomeExpenseReport{
+		Income:  monthly.TotalIncome,
+		Expense: monthly.TotalExpense,
+	}
+
+	s.logger.Service("GetIncomeExpense completed successfully",
+		zap.Int("income_currencies", len(report.Income)),
+		zap.Int("expense_currencies", len(report.Expense)),
+		zap.Duration("total_duration", time.Since(start)),
+	)
+
+	return report, nil
+}
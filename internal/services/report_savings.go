@@ -0,0 +1,56 @@
+package services
+
+import (
+	"context"
+	"time"
+
+	"github.com/maximicciullo/personal-finance-api/internal/models"
+	"go.uber.org/zap"
+)
+
+// GetSavingsRate computes each currency's savings rate for a month from the monthly
+// report's income and expense totals
+func (s *reportService) GetSavingsRate(ctx context.Context, year, month int, includePending bool, location *time.Location) (*models.SavingsRateReport, error) {
+	s.logger.Service("GetSavingsRate started",
+		zap.Int("year", year),
+		zap.Int("month", month),
+	)
+
+	start := time.Now()
+	monthly, err := s.GetFilteredMonthlyReport(ctx, year, month, models.TransactionFilters{IncludePending: includePending, Location: location})
+	if err != nil {
+		s.logger.Error("service", "GetSavingsRate - monthly report failed", err,
+			zap.Int("year", year),
+			zap.Int("month", month),
+		)
+		return nil, err
+	}
+
+	report := &models.SavingsRateReport{
+		Month: monthly.Month,
+		Year:  monthly.Year,
+		Rates: make(map[string]models.SavingsRate),
+	}
+	for currency := range s.getAllCurrencies(monthly.TotalIncome, monthly.TotalExpense) {
+		report.Rates[currency] = savingsRate(monthly.TotalIncome[currency], monthly.TotalExpense[currency])
+	}
+
+	s.logger.Service("GetSavingsRate completed successfully",
+		zap.Int("currencies_count", len(report.Rates)),
+		zap.Duration("total_duration", time.Since(start)),
+	)
+
+	return report, nil
+}
+
+func savingsRate(income, expense models.Money) models.SavingsRate {
+	rate := models.SavingsRate{Income: income, Expense: expense}
+	if income == 0 {
+		rate.NoIncome = true
+		return rate
+	}
+
+	value := float64((income - expense) / income)
+	rate.Rate = &value
+	return rate
+}
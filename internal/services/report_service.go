@@ -1,7 +1,9 @@
 package services
 
 import (
+	"context"
 	"errors"
+	"fmt"
 	"time"
 
 	"github.com/maximicciullo/personal-finance-api/internal/middleware"
@@ -10,52 +12,92 @@ import (
 	"go.uber.org/zap"
 )
 
+// maxNetWorthPeriods bounds the size of a net worth report, e.g. a decade of daily periods
+const maxNetWorthPeriods = 1000
+
+// ReportServiceConfig holds report configuration
+type ReportServiceConfig struct {
+	OpeningBalances map[string]float64                  // Net worth starting balance, by currency
+	ExchangeRates   map[string]float64                  // Value of one unit per currency, for consolidated balances
+	Clock           Clock                               // Defines "today" for the current-month report; nil means RealClock
+	CategoryMeta    repositories.CategoryMetaRepository // Optional colors and icons for the category breakdown
+	ClosedMonths    repositories.ClosedMonthRepository  // Backs CloseMonth and ReopenMonth; nil disables them
+}
+
+// DefaultReportServiceConfig returns a default report service configuration
+func DefaultReportServiceConfig() ReportServiceConfig {
+	return ReportServiceConfig{
+		OpeningBalances: map[string]float64{},
+		ExchangeRates:   map[string]float64{},
+		Clock:           RealClock(),
+	}
+}
+
 type reportService struct {
-	repo   repositories.TransactionRepository
-	logger *middleware.BusinessLoggerInstance
+	repo      repositories.TransactionRepository
+	config    ReportServiceConfig
+	converter *CurrencyConverter
+	logger    *middleware.BusinessLoggerInstance
 }
 
 func NewReportService(repo repositories.TransactionRepository) ReportService {
+	return NewReportServiceWithConfig(repo, DefaultReportServiceConfig())
+}
+
+func NewReportServiceWithConfig(repo repositories.TransactionRepository, config ReportServiceConfig) ReportService {
+	if config.Clock == nil {
+		config.Clock = RealClock()
+	}
 	return &reportService{
-		repo:   repo,
-		logger: middleware.BusinessLogger(),
+		repo:      repo,
+		config:    config,
+		converter: NewCurrencyConverter(config.ExchangeRates),
+		logger:    middleware.BusinessLogger(),
 	}
 }
 
-func (s *reportService) GetMonthlyReport(year, month int) (*models.MonthlyReport, error) {
+func (s *reportService) GetMonthlyReport(ctx context.Context, year, month int) (*models.MonthlyReport, error) {
+	return s.GetFilteredMonthlyReport(ctx, year, month, models.TransactionFilters{})
+}
+
+// GetFilteredMonthlyReport builds a monthly report scoped to the transactions matching
+// the type, category, currency and account filters. Date filters are ignored; the month
+// defines the range. Pending transactions are left out unless filters.IncludePending.
+func (s *reportService) GetFilteredMonthlyReport(ctx context.Context, year, month int, filters models.TransactionFilters) (*models.MonthlyReport, error) {
 	s.logger.Service("GetMonthlyReport started",
 		zap.Int("year", year),
 		zap.Int("month", month),
+		zap.String("type_filter", filters.Type),
+		zap.String("category_filter", filters.Category),
+		zap.String("currency_filter", filters.Currency),
+		zap.String("account_filter", filters.Account),
 	)
 
-	if year < 1900 || year > time.Now().Year()+10 {
-		err := errors.New("invalid year")
-		s.logger.Error("service", "GetMonthlyReport - invalid year", err,
+	// Calculate date range for the month
+	startDate, endDate, err := s.monthRange(year, month, filters.Location)
+	if err != nil {
+		s.logger.Error("service", "GetMonthlyReport - invalid year or month", err,
 			zap.Int("year", year),
-		)
-		return nil, err
-	}
-
-	if month < 1 || month > 12 {
-		err := errors.New("month must be between 1 and 12")
-		s.logger.Error("service", "GetMonthlyReport - invalid month", err,
 			zap.Int("month", month),
 		)
 		return nil, err
 	}
 
-	// Calculate date range for the month
-	startDate := time.Date(year, time.Month(month), 1, 0, 0, 0, 0, time.UTC)
-	endDate := startDate.AddDate(0, 1, 0).Add(-time.Second)
-
 	s.logger.Service("GetMonthlyReport - date range calculated",
 		zap.Time("start_date", startDate),
 		zap.Time("end_date", endDate),
 	)
 
-	// Get transactions for the month
+	// Get transactions for the month, narrowed by the scope filters when present
 	repoStart := time.Now()
-	transactions, err := s.repo.GetByDateRange(startDate, endDate)
+	var transactions []models.Transaction
+	if filters.Type == "" && filters.Category == "" && filters.Currency == "" && filters.Account == "" && len(filters.ExcludeCategories) == 0 {
+		transactions, err = s.repo.GetByDateRange(ctx, startDate, endDate)
+	} else {
+		filters.FromDate = &startDate
+		filters.ToDate = &endDate
+		transactions, err = s.repo.GetByFilters(ctx, filters)
+	}
 	repoDuration := time.Since(repoStart)
 
 	s.logger.Performance("GetMonthlyReport repository call", repoDuration,
@@ -71,12 +113,21 @@ func (s *reportService) GetMonthlyReport(year, month int) (*models.MonthlyReport
 		return nil, err
 	}
 
+	if !filters.IncludePending {
+		transactions = withoutPending(transactions)
+	}
+
 	s.logger.Service("GetMonthlyReport - building report",
 		zap.Int("transaction_count", len(transactions)),
 	)
 
 	buildStart := time.Now()
-	report := s.buildMonthlyReport(year, month, transactions)
+	report := s.buildMonthlyReport(year, month, transactions, filters.HideZero)
+	report.Source = &models.ReportSource{
+		StartDate:       startDate,
+		EndDate:         endDate,
+		QueryDurationMs: float64(repoDuration.Microseconds()) / 1000,
+	}
 	buildDuration := time.Since(buildStart)
 
 	s.logger.Performance("GetMonthlyReport report building", buildDuration,
@@ -84,6 +135,11 @@ func (s *reportService) GetMonthlyReport(year, month int) (*models.MonthlyReport
 		zap.Int("categories_count", len(report.Summary.CategoryBreakdown)),
 	)
 
+	if err := s.attachCategoryMeta(ctx, report.Summary.CategoryBreakdown); err != nil {
+		s.logger.Error("service", "GetMonthlyReport - category meta error", err)
+		return nil, err
+	}
+
 	totalDuration := time.Since(repoStart)
 	s.logger.Service("GetMonthlyReport completed successfully",
 		zap.Int("year", year),
@@ -96,26 +152,497 @@ func (s *reportService) GetMonthlyReport(year, month int) (*models.MonthlyReport
 	return report, nil
 }
 
-func (s *reportService) GetCurrentMonthReport() (*models.MonthlyReport, error) {
-	now := time.Now()
+// monthRange validates a report month and returns its first and last instant in
+// location, UTC when nil
+func (s *reportService) monthRange(year, month int, location *time.Location) (time.Time, time.Time, error) {
+	if year < 1900 || year > s.config.Clock.Now().Year()+10 {
+		return time.Time{}, time.Time{}, errors.New("invalid year")
+	}
+	if month < 1 || month > 12 {
+		return time.Time{}, time.Time{}, errors.New("month must be between 1 and 12")
+	}
+
+	if location == nil {
+		location = time.UTC
+	}
+	start := time.Date(year, time.Month(month), 1, 0, 0, 0, 0, location)
+	return start, start.AddDate(0, 1, 0).Add(-time.Nanosecond), nil
+}
+
+// GetReportMonths lists the months that have transactions, oldest first, so clients
+// only offer monthly reports that contain data
+func (s *reportService) GetReportMonths(ctx context.Context, includePending bool) ([]models.MonthCount, error) {
+	s.logger.Service("GetReportMonths started",
+		zap.Bool("include_pending", includePending),
+	)
+
+	months, err := s.repo.GetMonthCounts(ctx, includePending)
+	if err != nil {
+		s.logger.Error("service", "GetReportMonths - repository error", err)
+		return nil, err
+	}
+
+	s.logger.Service("GetReportMonths completed successfully",
+		zap.Int("month_count", len(months)),
+	)
+
+	return months, nil
+}
+
+// attachCategoryMeta copies the stored color and icon onto each category in the breakdown
+func (s *reportService) attachCategoryMeta(ctx context.Context, breakdown map[string]models.CategoryTotal) error {
+	if s.config.CategoryMeta == nil || len(breakdown) == 0 {
+		return nil
+	}
+
+	all, err := s.config.CategoryMeta.GetAll(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, meta := range all {
+		category, exists := breakdown[meta.Category]
+		if !exists {
+			continue
+		}
+		category.Color = meta.Color
+		category.Icon = meta.Icon
+		breakdown[meta.Category] = category
+	}
+	return nil
+}
+
+// GetCurrentMonthReport builds this month's report, "this month" being the one it is now
+// in location (nil means UTC). With includeProjected, pending transactions dated from
+// today to the end of the month are summed into a separate projected block; they only
+// count towards the actual totals too when includePending is also set.
+func (s *reportService) GetCurrentMonthReport(ctx context.Context, includePending, includeProjected bool, location *time.Location) (*models.MonthlyReport, error) {
+	if location == nil {
+		location = time.UTC
+	}
+	now := s.config.Clock.Now().In(location)
 	s.logger.Service("GetCurrentMonthReport started",
 		zap.Int("current_year", now.Year()),
 		zap.Int("current_month", int(now.Month())),
+		zap.Bool("include_projected", includeProjected),
+		zap.String("timezone", location.String()),
 	)
 	
-	return s.GetMonthlyReport(now.Year(), int(now.Month()))
+	report, err := s.GetFilteredMonthlyReport(ctx, now.Year(), int(now.Month()), models.TransactionFilters{IncludePending: includePending, Location: location})
+	if err != nil {
+		return nil, err
+	}
+
+	report.ProjectedExpense = ProjectMonthlyExpense(report.TotalExpense, now)
+
+	if includeProjected {
+		report.Projected, err = s.projectedTotals(ctx, now)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return report, nil
+}
+
+// projectedTotals sums the pending transactions dated from the start of today to the end
+// of now's month, in now's location like the report boundaries. Pending transactions
+// from earlier days are overdue and left out; today's count even if dated before now.
+func (s *reportService) projectedTotals(ctx context.Context, now time.Time) (*models.ProjectedTotals, error) {
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
+	monthEnd := time.Date(now.Year(), now.Month()+1, 1, 0, 0, 0, 0, now.Location()).Add(-time.Nanosecond)
+	filters := models.TransactionFilters{
+		Status:   models.TransactionStatusPending,
+		FromDate: &today,
+		ToDate:   &monthEnd,
+	}
+
+	start := time.Now()
+	transactions, err := s.repo.GetByFilters(ctx, filters)
+	duration := time.Since(start)
+
+	s.logger.Performance("GetCurrentMonthReport projected repository call", duration,
+		zap.Int("transaction_count", len(transactions)),
+		zap.Bool("success", err == nil),
+	)
+
+	if err != nil {
+		s.logger.Error("service", "GetCurrentMonthReport - projected repository error", err)
+		return nil, err
+	}
+
+	income := currencySums{}
+	expense := currencySums{}
+	balance := currencySums{}
+	for _, transaction := range transactions {
+		if transaction.Type == models.TransactionTypeIncome {
+			income.add(transaction.Currency, transaction.Amount)
+			balance.add(transaction.Currency, transaction.Amount)
+		} else {
+			expense.add(transaction.Currency, transaction.Amount)
+			balance.sub(transaction.Currency, transaction.Amount)
+		}
+	}
+
+	return &models.ProjectedTotals{
+		Income:           income.money(),
+		Expense:          expense.money(),
+		Balance:          balance.money(),
+		TransactionCount: len(transactions),
+	}, nil
+}
+
+// ProjectMonthlyExpense extrapolates the month-to-date expense per currency to the
+// whole month, assuming spending continues at the pace of the days elapsed so far
+func ProjectMonthlyExpense(expense map[string]models.Money, now time.Time) map[string]models.Money {
+	daysInMonth := time.Date(now.Year(), now.Month()+1, 0, 0, 0, 0, 0, now.Location()).Day()
+	daysElapsed := now.Day()
+
+	projected := make(map[string]models.Money, len(expense))
+	for currency, amount := range expense {
+		projected[currency] = amount / models.Money(daysElapsed) * models.Money(daysInMonth)
+	}
+	return projected
 }
 
-func (s *reportService) buildMonthlyReport(year, month int, transactions []models.Transaction) *models.MonthlyReport {
+func (s *reportService) GetGroupedReport(ctx context.Context, field string, fromDate, toDate *time.Time, includePending bool) (*models.GroupedReport, error) {
+	s.logger.Service("GetGroupedReport started",
+		zap.String("field", field),
+	)
+
+	keyFn, ok := groupKeyFuncs[field]
+	if !ok {
+		err := models.ErrInvalidGroupField
+		s.logger.Error("service", "GetGroupedReport - invalid field", err,
+			zap.String("field", field),
+		)
+		return nil, err
+	}
+
+	filters := models.TransactionFilters{
+		FromDate: fromDate,
+		ToDate:   toDate,
+	}
+
+	repoStart := time.Now()
+	transactions, err := s.repo.GetByFilters(ctx, filters)
+	repoDuration := time.Since(repoStart)
+
+	s.logger.Performance("GetGroupedReport repository call", repoDuration,
+		zap.Int("transaction_count", len(transactions)),
+		zap.Bool("success", err == nil),
+	)
+
+	if err != nil {
+		s.logger.Error("service", "GetGroupedReport - repository error", err,
+			zap.String("field", field),
+		)
+		return nil, err
+	}
+
+	if !includePending {
+		transactions = withoutPending(transactions)
+	}
+
+	report := &models.GroupedReport{
+		Field:            field,
+		FromDate:         fromDate,
+		ToDate:           toDate,
+		TransactionCount: len(transactions),
+		Groups:           groupTransactions(transactions, keyFn),
+	}
+
+	s.logger.Service("GetGroupedReport completed successfully",
+		zap.String("field", field),
+		zap.Int("groups_count", len(report.Groups)),
+		zap.Duration("total_duration", time.Since(repoStart)),
+	)
+
+	return report, nil
+}
+
+// GetBalanceAsOf returns income minus expense per currency over every transaction
+// dated up to and including asOf. A non-empty base adds the balance consolidated into
+// that currency when every needed exchange rate is configured.
+func (s *reportService) GetBalanceAsOf(ctx context.Context, asOf time.Time, base string, includePending bool) (*models.BalanceReport, error) {
+	s.logger.Service("GetBalanceAsOf started",
+		zap.Time("as_of", asOf),
+		zap.String("base_currency", base),
+	)
+
+	repoStart := time.Now()
+	transactions, err := s.repo.GetByDateRange(ctx, time.Time{}, asOf)
+	repoDuration := time.Since(repoStart)
+
+	s.logger.Performance("GetBalanceAsOf repository call", repoDuration,
+		zap.Int("transaction_count", len(transactions)),
+		zap.Bool("success", err == nil),
+	)
+
+	if err != nil {
+		s.logger.Error("service", "GetBalanceAsOf - repository error", err,
+			zap.Time("as_of", asOf),
+		)
+		return nil, err
+	}
+
+	if !includePending {
+		transactions = withoutPending(transactions)
+	}
+
+	sums := currencySums{}
+	for _, transaction := range transactions {
+		if transaction.Type == models.TransactionTypeIncome {
+			sums.add(transaction.Currency, transaction.Amount)
+		} else {
+			sums.sub(transaction.Currency, transaction.Amount)
+		}
+	}
+	balance := sums.money()
+
+	s.logger.Service("GetBalanceAsOf completed successfully",
+		zap.Int("transaction_count", len(transactions)),
+		zap.Int("currencies_count", len(balance)),
+		zap.Duration("total_duration", time.Since(repoStart)),
+	)
+
+	report := &models.BalanceReport{
+		AsOf:             asOf,
+		TransactionCount: len(transactions),
+		Balance:          balance,
+	}
+	if base != "" {
+		s.consolidateBalance(report, base)
+	}
+
+	return report, nil
+}
+
+// consolidateBalance converts every currency balance into base and sums them, or lists
+// the currencies lacking a rate instead
+func (s *reportService) consolidateBalance(report *models.BalanceReport, base string) {
+	report.BaseCurrency = base
+
+	currencies := make([]string, 0, len(report.Balance))
+	for currency := range report.Balance {
+		currencies = append(currencies, currency)
+	}
+
+	if missing := s.converter.MissingRates(currencies, base); len(missing) > 0 {
+		s.logger.Service("GetBalanceAsOf - consolidated balance omitted",
+			zap.String("base_currency", base),
+			zap.Strings("missing_rates", missing),
+		)
+		report.MissingRates = missing
+		return
+	}
+
+	var total models.Money
+	for currency, amount := range report.Balance {
+		converted, err := s.converter.Convert(amount, currency, base)
+		if err != nil {
+			// MissingRates already covered every currency
+			s.logger.Error("service", "GetBalanceAsOf - conversion failed", err)
+			return
+		}
+		total += converted
+	}
+	report.ConsolidatedBalance = &total
+}
+
+// GetNetWorthReport applies each period's net to a running balance that starts at the
+// opening balance. Opening balances passed in override the configured ones per currency.
+func (s *reportService) GetNetWorthReport(ctx context.Context, from, to time.Time, granularity string, openingBalance map[string]float64, includePending bool) (*models.NetWorthReport, error) {
+	if granularity == "" {
+		granularity = models.GranularityMonth
+	}
+
+	s.logger.Service("GetNetWorthReport started",
+		zap.Time("from", from),
+		zap.Time("to", to),
+		zap.String("granularity", granularity),
+	)
+
+	if _, ok := periodSteps[granularity]; !ok {
+		err := errors.New("granularity must be one of: day, week, month, year")
+		s.logger.Error("service", "GetNetWorthReport - invalid granularity", err,
+			zap.String("granularity", granularity),
+		)
+		return nil, err
+	}
+
+	if from.After(to) {
+		err := errors.New("from must not be after to")
+		s.logger.Error("service", "GetNetWorthReport - invalid date range", err,
+			zap.Time("from", from),
+			zap.Time("to", to),
+		)
+		return nil, err
+	}
+
+	periods, err := buildNetWorthPeriods(from, to, granularity)
+	if err != nil {
+		s.logger.Error("service", "GetNetWorthReport - too many periods", err,
+			zap.String("granularity", granularity),
+		)
+		return nil, err
+	}
+
+	repoStart := time.Now()
+	transactions, err := s.repo.GetByFilters(ctx, models.TransactionFilters{
+		FromDate: &from,
+		ToDate:   &to,
+	})
+	repoDuration := time.Since(repoStart)
+
+	s.logger.Performance("GetNetWorthReport repository call", repoDuration,
+		zap.Int("transaction_count", len(transactions)),
+		zap.Bool("success", err == nil),
+	)
+
+	if err != nil {
+		s.logger.Error("service", "GetNetWorthReport - repository error", err)
+		return nil, err
+	}
+
+	if !includePending {
+		transactions = withoutPending(transactions)
+	}
+
+	// Net per period
+	periodIndex := make(map[int64]int, len(periods))
+	nets := make([]currencySums, len(periods))
+	for i, period := range periods {
+		periodIndex[period.PeriodStart.Unix()] = i
+		nets[i] = currencySums{}
+	}
+	for _, transaction := range transactions {
+		start := periodStart(transaction.Date.In(from.Location()), granularity)
+		i, ok := periodIndex[start.Unix()]
+		if !ok {
+			continue
+		}
+		if transaction.Type == models.TransactionTypeIncome {
+			nets[i].add(transaction.Currency, transaction.Amount)
+		} else {
+			nets[i].sub(transaction.Currency, transaction.Amount)
+		}
+	}
+
+	// Running balance
+	opening := make(map[string]models.Money)
+	for currency, amount := range s.config.OpeningBalances {
+		opening[currency] = models.Money(amount)
+	}
+	for currency, amount := range openingBalance {
+		opening[currency] = models.Money(amount)
+	}
+
+	running := currencySums{}
+	for currency, amount := range opening {
+		running.add(currency, amount)
+	}
+	for i := range periods {
+		for currency, net := range nets[i] {
+			running[currency] = running[currency].Add(net)
+		}
+		periods[i].Net = nets[i].money()
+		periods[i].Balance = running.money()
+	}
+
+	report := &models.NetWorthReport{
+		From:           from,
+		To:             to,
+		Granularity:    granularity,
+		OpeningBalance: opening,
+		Periods:        periods,
+	}
+
+	s.logger.Service("GetNetWorthReport completed successfully",
+		zap.Int("periods_count", len(periods)),
+		zap.Duration("total_duration", time.Since(repoStart)),
+	)
+
+	return report, nil
+}
+
+// withoutPending returns the cleared transactions, which are the only ones reports
+// count by default
+func withoutPending(transactions []models.Transaction) []models.Transaction {
+	cleared := make([]models.Transaction, 0, len(transactions))
+	for _, transaction := range transactions {
+		if !transaction.IsPending() {
+			cleared = append(cleared, transaction)
+		}
+	}
+	return cleared
+}
+
+// periodSteps advances a period start to the next period start for each granularity
+var periodSteps = map[string]func(time.Time) time.Time{
+	models.GranularityDay:   func(t time.Time) time.Time { return t.AddDate(0, 0, 1) },
+	models.GranularityWeek:  func(t time.Time) time.Time { return t.AddDate(0, 0, 7) },
+	models.GranularityMonth: func(t time.Time) time.Time { return t.AddDate(0, 1, 0) },
+	models.GranularityYear:  func(t time.Time) time.Time { return t.AddDate(1, 0, 0) },
+}
+
+// periodStart returns the start of the period containing t. Weeks start on Monday.
+func periodStart(t time.Time, granularity string) time.Time {
+	year, month, day := t.Date()
+	switch granularity {
+	case models.GranularityWeek:
+		midnight := time.Date(year, month, day, 0, 0, 0, 0, t.Location())
+		return midnight.AddDate(0, 0, -((int(midnight.Weekday()) + 6) % 7))
+	case models.GranularityMonth:
+		return time.Date(year, month, 1, 0, 0, 0, 0, t.Location())
+	case models.GranularityYear:
+		return time.Date(year, time.January, 1, 0, 0, 0, 0, t.Location())
+	default:
+		return time.Date(year, month, day, 0, 0, 0, 0, t.Location())
+	}
+}
+
+// buildNetWorthPeriods returns empty periods covering from through to
+func buildNetWorthPeriods(from, to time.Time, granularity string) ([]models.NetWorthPeriod, error) {
+	next := periodSteps[granularity]
+
+	periods := make([]models.NetWorthPeriod, 0)
+	for start := periodStart(from, granularity); !start.After(to); start = next(start) {
+		if len(periods) == maxNetWorthPeriods {
+			return nil, fmt.Errorf("date range spans more than %d periods, use a coarser granularity", maxNetWorthPeriods)
+		}
+		periods = append(periods, models.NetWorthPeriod{
+			PeriodStart: start,
+			PeriodEnd:   next(start).Add(-time.Nanosecond),
+			Net:         make(map[string]models.Money),
+			Balance:     make(map[string]models.Money),
+		})
+	}
+	return periods, nil
+}
+
+// buildMonthlyReport aggregates the month's transactions. With hideZero, categories whose
+// income and expense cancel out in every currency, e.g. a purchase fully refunded, are
+// left out of the breakdown.
+func (s *reportService) buildMonthlyReport(year, month int, transactions []models.Transaction, hideZero bool) *models.MonthlyReport {
 	s.logger.Debug("service", "Building monthly report",
 		zap.Int("year", year),
 		zap.Int("month", month),
 		zap.Int("transaction_count", len(transactions)),
 	)
 
-	totalIncome := make(map[string]float64)
-	totalExpense := make(map[string]float64)
-	categoryBreakdown := make(map[string]models.CategoryTotal)
+	// Empty collections must serialize as [] and {} rather than null
+	if transactions == nil {
+		transactions = []models.Transaction{}
+	}
+
+	// Amounts are summed as decimals and only converted to Money once final
+	incomeSums := currencySums{}
+	expenseSums := currencySums{}
+	accountSums := make(map[string]currencySums)
+	categoryTotals := make(map[string]*models.CategoryTotal)
+	categorySums := make(map[string]currencySums)
+	categoryIncome := make(map[string]currencySums)
+	categoryExpense := make(map[string]currencySums)
 
 	incomeCount := 0
 	expenseCount := 0
@@ -124,38 +651,62 @@ func (s *reportService) buildMonthlyReport(year, month int, transactions []model
 		s.logger.Debug("service", "Processing transaction",
 			zap.Int("transaction_id", transaction.ID),
 			zap.String("type", transaction.Type),
-			zap.Float64("amount", transaction.Amount),
+			zap.Float64("amount", float64(transaction.Amount)),
 			zap.String("currency", transaction.Currency),
 			zap.String("category", transaction.Category),
 		)
 
 		// Calculate totals by currency
 		if transaction.Type == models.TransactionTypeIncome {
-			totalIncome[transaction.Currency] += transaction.Amount
+			incomeSums.add(transaction.Currency, transaction.Amount)
 			incomeCount++
 		} else {
-			totalExpense[transaction.Currency] += transaction.Amount
+			expenseSums.add(transaction.Currency, transaction.Amount)
 			expenseCount++
 		}
 
-		// Category breakdown
-		if category, exists := categoryBreakdown[transaction.Category]; exists {
-			category.Count++
-			if category.Totals[transaction.Currency] == 0 {
-				category.Totals[transaction.Currency] = 0
+		// Balance by account
+		if transaction.Account != "" {
+			if accountSums[transaction.Account] == nil {
+				accountSums[transaction.Account] = currencySums{}
 			}
-			category.Totals[transaction.Currency] += transaction.Amount
-			categoryBreakdown[transaction.Category] = category
-		} else {
-			categoryBreakdown[transaction.Category] = models.CategoryTotal{
-				Count:  1,
-				Totals: map[string]float64{transaction.Currency: transaction.Amount},
+			if transaction.Type == models.TransactionTypeIncome {
+				accountSums[transaction.Account].add(transaction.Currency, transaction.Amount)
+			} else {
+				accountSums[transaction.Account].sub(transaction.Currency, transaction.Amount)
 			}
 		}
+
+		// Category totals by type, used for percentages
+		byType := categoryExpense
+		if transaction.Type == models.TransactionTypeIncome {
+			byType = categoryIncome
+		}
+		if byType[transaction.Category] == nil {
+			byType[transaction.Category] = currencySums{}
+		}
+		byType[transaction.Category].add(transaction.Currency, transaction.Amount)
+
+		// Category breakdown, accumulated through pointers so no copy can drop an update
+		category, exists := categoryTotals[transaction.Category]
+		if !exists {
+			category = &models.CategoryTotal{}
+			categoryTotals[transaction.Category] = category
+			categorySums[transaction.Category] = currencySums{}
+		}
+		category.Count++
+		categorySums[transaction.Category].add(transaction.Currency, transaction.Amount)
+	}
+
+	totalIncome := incomeSums.money()
+	totalExpense := expenseSums.money()
+	accountBalances := make(map[string]models.CurrencyAmounts, len(accountSums))
+	for account, sums := range accountSums {
+		accountBalances[account] = sums.money()
 	}
 
 	// Calculate balance by currency
-	balance := make(map[string]float64)
+	balanceSums := currencySums{}
 	allCurrencies := s.getAllCurrencies(totalIncome, totalExpense)
 
 	s.logger.Debug("service", "Calculating balances",
@@ -163,22 +714,56 @@ func (s *reportService) buildMonthlyReport(year, month int, transactions []model
 	)
 
 	for currency := range allCurrencies {
-		balance[currency] = totalIncome[currency] - totalExpense[currency]
+		balanceSums[currency] = incomeSums[currency].Sub(expenseSums[currency])
+	}
+	balance := balanceSums.money()
+
+	// Every currency gets an explicit entry in each map, so a zero total is told apart
+	// from a currency the report does not cover
+	for currency := range allCurrencies {
+		if _, ok := totalIncome[currency]; !ok {
+			totalIncome[currency] = 0
+		}
+		if _, ok := totalExpense[currency]; !ok {
+			totalExpense[currency] = 0
+		}
+	}
+	for currency := range allCurrencies {
 		s.logger.Debug("service", "Currency balance calculated",
 			zap.String("currency", currency),
-			zap.Float64("income", totalIncome[currency]),
-			zap.Float64("expense", totalExpense[currency]),
-			zap.Float64("balance", balance[currency]),
+			zap.Float64("income", float64(totalIncome[currency])),
+			zap.Float64("expense", float64(totalExpense[currency])),
+			zap.Float64("balance", float64(balance[currency])),
 		)
 	}
 
+	categoryBreakdown := make(map[string]models.CategoryTotal, len(categoryTotals))
+	for name, category := range categoryTotals {
+		category.Totals = categorySums[name].money()
+		category.PercentOfIncome = percentagesOf(categoryIncome[name].money(), totalIncome)
+		category.PercentOfExpense = percentagesOf(categoryExpense[name].money(), totalExpense)
+		categoryBreakdown[name] = *category
+	}
+
+	if hideZero {
+		for name := range categoryBreakdown {
+			if categoryIncome[name].equal(categoryExpense[name]) {
+				s.logger.Debug("service", "Zero net category hidden",
+					zap.String("category", name),
+				)
+				delete(categoryBreakdown, name)
+			}
+		}
+	}
+
 	report := &models.MonthlyReport{
-		Month:        time.Month(month).String(),
-		Year:         year,
-		TotalIncome:  totalIncome,
-		TotalExpense: totalExpense,
-		Balance:      balance,
-		Transactions: transactions,
+		Month:           time.Month(month).String(),
+		Year:            year,
+		TotalIncome:     totalIncome,
+		TotalExpense:    totalExpense,
+		Balance:         balance,
+		AccountBalances: accountBalances,
+		Transactions:    transactions,
 		Summary: models.ReportSummary{
 			TransactionCount:  len(transactions),
 			IncomeCount:       incomeCount,
@@ -198,7 +783,20 @@ func (s *reportService) buildMonthlyReport(year, month int, transactions []model
 	return report
 }
 
-func (s *reportService) getAllCurrencies(totalIncome, totalExpense map[string]float64) map[string]bool {
+// percentagesOf returns each currency's amount as a percentage of the matching total.
+// Currencies whose total is zero are left out to avoid dividing by zero.
+func percentagesOf(amounts, totals map[string]models.Money) map[string]float64 {
+	percentages := make(map[string]float64)
+	for currency, amount := range amounts {
+		if totals[currency] == 0 {
+			continue
+		}
+		percentages[currency] = float64(amount / totals[currency] * 100)
+	}
+	return percentages
+}
+
+func (s *reportService) getAllCurrencies(totalIncome, totalExpense map[string]models.Money) map[string]bool {
 	currencies := make(map[string]bool)
 
 	for currency := range totalIncome {
@@ -220,4 +818,54 @@ func (s *reportService) getAllCurrencies(totalIncome, totalExpense map[string]fl
 	)
 
 	return currencies
-}
\ No newline at end of file
+}
+
+// groupKeyFuncs maps each supported group-by field to its key extractor
+var groupKeyFuncs = map[string]func(models.Transaction) string{
+	models.GroupByCategory: func(t models.Transaction) string { return t.Category },
+	models.GroupByCurrency: func(t models.Transaction) string { return t.Currency },
+	models.GroupByType:     func(t models.Transaction) string { return t.Type },
+}
+
+// groupSums accumulates one group's totals by currency
+type groupSums struct {
+	count   int
+	income  currencySums
+	expense currencySums
+	balance currencySums
+}
+
+// groupTransactions counts transactions per group key and sums their income, expense
+// and balance by currency
+func groupTransactions(transactions []models.Transaction, keyFn func(models.Transaction) string) map[string]models.GroupTotal {
+	groups := make(map[string]*groupSums)
+
+	for _, transaction := range transactions {
+		key := keyFn(transaction)
+		group, exists := groups[key]
+		if !exists {
+			group = &groupSums{income: currencySums{}, expense: currencySums{}, balance: currencySums{}}
+			groups[key] = group
+		}
+		group.count++
+		if transaction.Type == models.TransactionTypeIncome {
+			group.income.add(transaction.Currency, transaction.Amount)
+			group.balance.add(transaction.Currency, transaction.Amount)
+		} else {
+			group.expense.add(transaction.Currency, transaction.Amount)
+			group.balance.sub(transaction.Currency, transaction.Amount)
+		}
+	}
+
+	result := make(map[string]models.GroupTotal, len(groups))
+	for key, group := range groups {
+		result[key] = models.GroupTotal{
+			Count:   group.count,
+			Income:  group.income.money(),
+			Expense: group.expense.money(),
+			Balance: group.balance.money(),
+		}
+	}
+
+	return result
+}
@@ -1,11 +1,14 @@
 package services_test
 
 import (
+	"context"
+	"encoding/json"
 	"testing"
 	"time"
 
 	"github.com/maximicciullo/personal-finance-api/internal/middleware"
 	"github.com/maximicciullo/personal-finance-api/internal/models"
+	"github.com/maximicciullo/personal-finance-api/internal/repositories"
 	"github.com/maximicciullo/personal-finance-api/internal/services"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
@@ -37,7 +40,7 @@ func (suite *ReportServiceTestSuite) TestGetMonthlyReport_Success() {
 	year := 2024
 	month := 6
 	startDate := time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)
-	endDate := time.Date(2024, 7, 1, 0, 0, 0, 0, time.UTC).Add(-time.Second)
+	endDate := time.Date(2024, 7, 1, 0, 0, 0, 0, time.UTC).Add(-time.Nanosecond)
 
 	transactions := []models.Transaction{
 		{
@@ -66,14 +69,14 @@ func (suite *ReportServiceTestSuite) TestGetMonthlyReport_Success() {
 		},
 	}
 
-	suite.mockRepo.On("GetByDateRange", mock.MatchedBy(func(start time.Time) bool {
+	suite.mockRepo.On("GetByDateRange", mock.Anything, mock.MatchedBy(func(start time.Time) bool {
 		return start.Equal(startDate)
 	}), mock.MatchedBy(func(end time.Time) bool {
 		return end.Equal(endDate)
 	})).Return(transactions, nil)
 
 	// When
-	result, err := suite.service.GetMonthlyReport(year, month)
+	result, err := suite.service.GetMonthlyReport(context.Background(), year, month)
 
 	// Then
 	assert.NoError(suite.T(), err)
@@ -84,13 +87,13 @@ func (suite *ReportServiceTestSuite) TestGetMonthlyReport_Success() {
 	assert.Equal(suite.T(), 2024, result.Year)
 	
 	// Check totals by currency
-	assert.Equal(suite.T(), 50000.0, result.TotalIncome["ARS"])
-	assert.Equal(suite.T(), 15000.0, result.TotalExpense["ARS"])
-	assert.Equal(suite.T(), 200.0, result.TotalExpense["USD"])
+	assert.Equal(suite.T(), models.Money(50000.0), result.TotalIncome["ARS"])
+	assert.Equal(suite.T(), models.Money(15000.0), result.TotalExpense["ARS"])
+	assert.Equal(suite.T(), models.Money(200.0), result.TotalExpense["USD"])
 	
 	// Check balances
-	assert.Equal(suite.T(), 35000.0, result.Balance["ARS"]) // 50000 - 15000
-	assert.Equal(suite.T(), -200.0, result.Balance["USD"])  // 0 - 200
+	assert.Equal(suite.T(), models.Money(35000.0), result.Balance["ARS"]) // 50000 - 15000
+	assert.Equal(suite.T(), models.Money(-200.0), result.Balance["USD"])  // 0 - 200
 	
 	// Check summary
 	assert.Equal(suite.T(), 3, result.Summary.TransactionCount)
@@ -104,29 +107,46 @@ func (suite *ReportServiceTestSuite) TestGetMonthlyReport_Success() {
 	
 	salaryCategory := result.Summary.CategoryBreakdown["salary"]
 	assert.Equal(suite.T(), 1, salaryCategory.Count)
-	assert.Equal(suite.T(), 50000.0, salaryCategory.Totals["ARS"])
+	assert.Equal(suite.T(), models.Money(50000.0), salaryCategory.Totals["ARS"])
 	
 	// Check transactions are included
 	assert.Len(suite.T(), result.Transactions, 3)
 }
 
+func (suite *ReportServiceTestSuite) TestGetMonthlyReport_SourceMatchesMonthBoundaries() {
+	// Given
+	suite.mockRepo.On("GetByDateRange", mock.Anything, mock.Anything, mock.Anything).Return([]models.Transaction{}, nil)
+
+	// When
+	result, err := suite.service.GetMonthlyReport(context.Background(), 2024, 2)
+
+	// Then
+	assert.NoError(suite.T(), err)
+	if assert.NotNil(suite.T(), result.Source) {
+		assert.Equal(suite.T(), time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC), result.Source.StartDate)
+		assert.Equal(suite.T(), time.Date(2024, 2, 29, 23, 59, 59, 999999999, time.UTC), result.Source.EndDate)
+		assert.GreaterOrEqual(suite.T(), result.Source.QueryDurationMs, 0.0)
+		suite.mockRepo.AssertCalled(suite.T(), "GetByDateRange", mock.Anything, result.Source.StartDate, result.Source.EndDate)
+	}
+}
+
 func (suite *ReportServiceTestSuite) TestGetMonthlyReport_EmptyData() {
 	// Given
 	year := 2024
 	month := 12
 	startDate := time.Date(2024, 12, 1, 0, 0, 0, 0, time.UTC)
-	endDate := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC).Add(-time.Second)
+	endDate := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC).Add(-time.Nanosecond)
 
 	emptyTransactions := []models.Transaction{}
 
-	suite.mockRepo.On("GetByDateRange", mock.MatchedBy(func(start time.Time) bool {
+	suite.mockRepo.On("GetByDateRange", mock.Anything, mock.MatchedBy(func(start time.Time) bool {
 		return start.Equal(startDate)
 	}), mock.MatchedBy(func(end time.Time) bool {
 		return end.Equal(endDate)
 	})).Return(emptyTransactions, nil)
 
 	// When
-	result, err := suite.service.GetMonthlyReport(year, month)
+	result, err := suite.service.GetMonthlyReport(context.Background(), year, month)
 
 	// Then
 	assert.NoError(suite.T(), err)
@@ -135,7 +155,10 @@ func (suite *ReportServiceTestSuite) TestGetMonthlyReport_EmptyData() {
 	assert.Equal(suite.T(), "December", result.Month)
 	assert.Equal(suite.T(), 2024, result.Year)
 	
-	// Should have empty maps
+	// Should have empty, non-nil maps
+	assert.NotNil(suite.T(), result.TotalIncome)
+	assert.NotNil(suite.T(), result.Summary.CategoryBreakdown)
+	assert.NotNil(suite.T(), result.Transactions)
 	assert.Empty(suite.T(), result.TotalIncome)
 	assert.Empty(suite.T(), result.TotalExpense)
 	assert.Empty(suite.T(), result.Balance)
@@ -149,6 +172,202 @@ func (suite *ReportServiceTestSuite) TestGetMonthlyReport_EmptyData() {
 	assert.Empty(suite.T(), result.Transactions)
 }
 
+func (suite *ReportServiceTestSuite) TestGetMonthlyReport_AccountBalances() {
+	// Given
+	transactions := []models.Transaction{
+		{ID: 1, Type: "income", Amount: 50000, Currency: "ARS", Category: "salary", Account: "bank", Date: time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)},
+		{ID: 2, Type: "expense", Amount: 15000, Currency: "ARS", Category: "rent", Account: "bank", Date: time.Date(2024, 6, 2, 0, 0, 0, 0, time.UTC)},
+		{ID: 3, Type: "expense", Amount: 2000, Currency: "ARS", Category: "food", Account: "cash", Date: time.Date(2024, 6, 3, 0, 0, 0, 0, time.UTC)},
+		{ID: 4, Type: "expense", Amount: 30, Currency: "USD", Category: "food", Account: "credit_card", Date: time.Date(2024, 6, 4, 0, 0, 0, 0, time.UTC)},
+		{ID: 5, Type: "expense", Amount: 500, Currency: "ARS", Category: "food", Date: time.Date(2024, 6, 5, 0, 0, 0, 0, time.UTC)},
+	}
+
+	suite.mockRepo.On("GetByDateRange", mock.Anything, mock.Anything, mock.Anything).Return(transactions, nil)
+
+	// When
+	result, err := suite.service.GetMonthlyReport(context.Background(), 2024, 6)
+
+	// Then
+	assert.NoError(suite.T(), err)
+	assert.Len(suite.T(), result.AccountBalances, 3)
+	assert.Equal(suite.T(), models.Money(35000.0), result.AccountBalances["bank"]["ARS"])
+	assert.Equal(suite.T(), models.Money(-2000.0), result.AccountBalances["cash"]["ARS"])
+	assert.Equal(suite.T(), models.Money(-30.0), result.AccountBalances["credit_card"]["USD"])
+	assert.NotContains(suite.T(), result.AccountBalances, "")
+
+	// Overall balance still includes transactions without an account
+	assert.Equal(suite.T(), models.Money(32500.0), result.Balance["ARS"])
+}
+
+func (suite *ReportServiceTestSuite) TestGetMonthlyReport_SameCategoryAndCurrencySums() {
+	// Given
+	transactions := []models.Transaction{
+		{ID: 1, Type: "expense", Amount: 1200, Currency: "ARS", Category: "food", Date: time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)},
+		{ID: 2, Type: "expense", Amount: 800, Currency: "ARS", Category: "food", Date: time.Date(2024, 6, 2, 0, 0, 0, 0, time.UTC)},
+		{ID: 3, Type: "expense", Amount: 15, Currency: "USD", Category: "food", Date: time.Date(2024, 6, 3, 0, 0, 0, 0, time.UTC)},
+	}
+
+	suite.mockRepo.On("GetByDateRange", mock.Anything, mock.Anything, mock.Anything).Return(transactions, nil)
+
+	// When
+	result, err := suite.service.GetMonthlyReport(context.Background(), 2024, 6)
+
+	// Then
+	assert.NoError(suite.T(), err)
+
+	food := result.Summary.CategoryBreakdown["food"]
+	assert.Equal(suite.T(), 3, food.Count)
+	assert.Equal(suite.T(), models.Money(2000.0), food.Totals["ARS"])
+	assert.Equal(suite.T(), models.Money(15.0), food.Totals["USD"])
+}
+
+func (suite *ReportServiceTestSuite) TestGetMonthlyReport_CategoryPercentages() {
+	// Given
+	transactions := []models.Transaction{
+		{ID: 1, Type: "income", Amount: 50000, Currency: "ARS", Category: "salary", Date: time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)},
+		{ID: 2, Type: "expense", Amount: 15000, Currency: "ARS", Category: "rent", Date: time.Date(2024, 6, 2, 0, 0, 0, 0, time.UTC)},
+		{ID: 3, Type: "expense", Amount: 3000, Currency: "ARS", Category: "food", Date: time.Date(2024, 6, 3, 0, 0, 0, 0, time.UTC)},
+		{ID: 4, Type: "expense", Amount: 2000, Currency: "ARS", Category: "transport", Date: time.Date(2024, 6, 4, 0, 0, 0, 0, time.UTC)},
+		{ID: 5, Type: "expense", Amount: 20, Currency: "USD", Category: "food", Date: time.Date(2024, 6, 5, 0, 0, 0, 0, time.UTC)},
+		{ID: 6, Type: "expense", Amount: 10, Currency: "USD", Category: "transport", Date: time.Date(2024, 6, 6, 0, 0, 0, 0, time.UTC)},
+	}
+
+	suite.mockRepo.On("GetByDateRange", mock.Anything, mock.Anything, mock.Anything).Return(transactions, nil)
+
+	// When
+	result, err := suite.service.GetMonthlyReport(context.Background(), 2024, 6)
+
+	// Then
+	assert.NoError(suite.T(), err)
+
+	breakdown := result.Summary.CategoryBreakdown
+	assert.InDelta(suite.T(), 75.0, breakdown["rent"].PercentOfExpense["ARS"], 0.001)
+	assert.InDelta(suite.T(), 15.0, breakdown["food"].PercentOfExpense["ARS"], 0.001)
+	assert.InDelta(suite.T(), 100.0, breakdown["salary"].PercentOfIncome["ARS"], 0.001)
+	assert.Empty(suite.T(), breakdown["salary"].PercentOfExpense)
+
+	sums := make(map[string]float64)
+	for _, category := range breakdown {
+		for currency, percent := range category.PercentOfExpense {
+			sums[currency] += percent
+		}
+	}
+	assert.InDelta(suite.T(), 100.0, sums["ARS"], 0.001)
+	assert.InDelta(suite.T(), 100.0, sums["USD"], 0.001)
+}
+
+func (suite *ReportServiceTestSuite) TestGetMonthlyReport_AttachesCategoryMeta() {
+	// Given
+	metaRepo := repositories.NewMemoryCategoryMetaRepository()
+	suite.Require().NoError(metaRepo.Set(context.Background(), &models.CategoryMeta{Category: "food", Color: "#ff8800", Icon: "utensils"}))
+	suite.Require().NoError(metaRepo.Set(context.Background(), &models.CategoryMeta{Category: "travel", Color: "#0088ff"}))
+
+	config := services.DefaultReportServiceConfig()
+	config.CategoryMeta = metaRepo
+	service := services.NewReportServiceWithConfig(suite.mockRepo, config)
+
+	transactions := []models.Transaction{
+		{ID: 1, Type: "expense", Amount: 3000, Currency: "ARS", Category: "food", Date: time.Date(2024, 6, 3, 0, 0, 0, 0, time.UTC)},
+		{ID: 2, Type: "expense", Amount: 2000, Currency: "ARS", Category: "transport", Date: time.Date(2024, 6, 4, 0, 0, 0, 0, time.UTC)},
+	}
+	suite.mockRepo.On("GetByDateRange", mock.Anything, mock.Anything, mock.Anything).Return(transactions, nil)
+
+	// When
+	result, err := service.GetMonthlyReport(context.Background(), 2024, 6)
+
+	// Then
+	assert.NoError(suite.T(), err)
+	breakdown := result.Summary.CategoryBreakdown
+	assert.Equal(suite.T(), "#ff8800", breakdown["food"].Color)
+	assert.Equal(suite.T(), "utensils", breakdown["food"].Icon)
+	assert.Equal(suite.T(), models.Money(3000), breakdown["food"].Totals["ARS"])
+	assert.Empty(suite.T(), breakdown["transport"].Color)
+	assert.NotContains(suite.T(), breakdown, "travel")
+}
+
+func (suite *ReportServiceTestSuite) TestGetFilteredMonthlyReport_CategoryScope() {
+	// Given
+	startDate := time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)
+	endDate := time.Date(2024, 7, 1, 0, 0, 0, 0, time.UTC).Add(-time.Nanosecond)
+	foodTransactions := []models.Transaction{
+		{ID: 1, Type: "expense", Amount: 1500, Currency: "ARS", Category: "food", Date: time.Date(2024, 6, 3, 0, 0, 0, 0, time.UTC)},
+		{ID: 2, Type: "expense", Amount: 2500, Currency: "ARS", Category: "food", Date: time.Date(2024, 6, 9, 0, 0, 0, 0, time.UTC)},
+	}
+
+	suite.mockRepo.On("GetByFilters", mock.Anything, mock.MatchedBy(func(filters models.TransactionFilters) bool {
+		return filters.Category == "food" &&
+			filters.Type == "expense" &&
+			filters.FromDate != nil && filters.FromDate.Equal(startDate) &&
+			filters.ToDate != nil && filters.ToDate.Equal(endDate)
+	})).Return(foodTransactions, nil)
+
+	// When
+	result, err := suite.service.GetFilteredMonthlyReport(context.Background(), 2024, 6, models.TransactionFilters{
+		Type:     "expense",
+		Category: "food",
+	})
+
+	// Then
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), 2, result.Summary.TransactionCount)
+	assert.Equal(suite.T(), models.Money(4000.0), result.TotalExpense["ARS"])
+	assert.Len(suite.T(), result.Summary.CategoryBreakdown, 1)
+	assert.Contains(suite.T(), result.Summary.CategoryBreakdown, "food")
+	suite.mockRepo.AssertNotCalled(suite.T(), "GetByDateRange", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (suite *ReportServiceTestSuite) TestGetFilteredMonthlyReport_ExcludesOtherCategories() {
+	// Given - a real repository with mixed categories
+	repo := repositories.NewMemoryTransactionRepository()
+	service := services.NewReportService(repo)
+	june := time.Date(2024, 6, 10, 0, 0, 0, 0, time.UTC)
+	for _, tx := range []*models.Transaction{
+		{Type: "expense", Amount: 1000, Currency: "ARS", Category: "food", Date: june},
+		{Type: "expense", Amount: 9000, Currency: "ARS", Category: "rent", Date: june},
+		{Type: "income", Amount: 50000, Currency: "ARS", Category: "salary", Date: june},
+		{Type: "expense", Amount: 700, Currency: "ARS", Category: "food", Date: june.AddDate(0, 1, 0)},
+	} {
+		repo.Create(context.Background(), tx)
+	}
+
+	// When
+	result, err := service.GetFilteredMonthlyReport(context.Background(), 2024, 6, models.TransactionFilters{Category: "food"})
+
+	// Then
+	assert.NoError(suite.T(), err)
+	assert.Len(suite.T(), result.Transactions, 1)
+	assert.Equal(suite.T(), "food", result.Transactions[0].Category)
+	assert.Equal(suite.T(), models.Money(1000.0), result.TotalExpense["ARS"])
+	assert.Equal(suite.T(), models.CurrencyAmounts{"ARS": 0}, result.TotalIncome)
+	assert.NotContains(suite.T(), result.Summary.CategoryBreakdown, "rent")
+}
+
+func (suite *ReportServiceTestSuite) TestGetMonthlyReport_ExplicitZeroTotalsPerCurrency() {
+	// Given - USD only has income and ARS only has expenses
+	repo := repositories.NewMemoryTransactionRepository()
+	service := services.NewReportService(repo)
+	june := time.Date(2024, 6, 10, 0, 0, 0, 0, time.UTC)
+	for _, tx := range []*models.Transaction{
+		{Type: "income", Amount: 300, Currency: "USD", Category: "freelance", Date: june},
+		{Type: "expense", Amount: 1000, Currency: "ARS", Category: "food", Date: june},
+	} {
+		repo.Create(context.Background(), tx)
+	}
+
+	// When
+	result, err := service.GetMonthlyReport(context.Background(), 2024, 6)
+
+	// Then
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), models.CurrencyAmounts{"USD": 300, "ARS": 0}, result.TotalIncome)
+	assert.Equal(suite.T(), models.CurrencyAmounts{"USD": 0, "ARS": 1000}, result.TotalExpense)
+	assert.Equal(suite.T(), models.CurrencyAmounts{"USD": 300, "ARS": -1000}, result.Balance)
+
+	encoded, err := json.Marshal(result)
+	suite.Require().NoError(err)
+	assert.Contains(suite.T(), string(encoded), `"total_expense":{"ARS":1000.00,"USD":0.00}`)
+}
+
 func (suite *ReportServiceTestSuite) TestGetMonthlyReport_InvalidYear() {
 	testCases := []struct {
 		name string
@@ -161,7 +380,7 @@ func (suite *ReportServiceTestSuite) TestGetMonthlyReport_InvalidYear() {
 	for _, tc := range testCases {
 		suite.T().Run(tc.name, func(t *testing.T) {
 			// When
-			result, err := suite.service.GetMonthlyReport(tc.year, 6)
+			result, err := suite.service.GetMonthlyReport(context.Background(), tc.year, 6)
 
 			// Then
 			assert.Error(t, err)
@@ -185,7 +404,7 @@ func (suite *ReportServiceTestSuite) TestGetMonthlyReport_InvalidMonth() {
 	for _, tc := range testCases {
 		suite.T().Run(tc.name, func(t *testing.T) {
 			// When
-			result, err := suite.service.GetMonthlyReport(2024, tc.month)
+			result, err := suite.service.GetMonthlyReport(context.Background(), 2024, tc.month)
 
 			// Then
 			assert.Error(t, err)
@@ -202,7 +421,7 @@ func (suite *ReportServiceTestSuite) TestGetCurrentMonthReport_Success() {
 	currentMonth := int(now.Month())
 	
 	startDate := time.Date(currentYear, time.Month(currentMonth), 1, 0, 0, 0, 0, time.UTC)
-	endDate := startDate.AddDate(0, 1, 0).Add(-time.Second)
+	endDate := startDate.AddDate(0, 1, 0).Add(-time.Nanosecond)
 
 	transactions := []models.Transaction{
 		{
@@ -215,14 +434,14 @@ func (suite *ReportServiceTestSuite) TestGetCurrentMonthReport_Success() {
 		},
 	}
 
-	suite.mockRepo.On("GetByDateRange", mock.MatchedBy(func(start time.Time) bool {
+	suite.mockRepo.On("GetByDateRange", mock.Anything, mock.MatchedBy(func(start time.Time) bool {
 		return start.Equal(startDate)
 	}), mock.MatchedBy(func(end time.Time) bool {
 		return end.Equal(endDate)
 	})).Return(transactions, nil)
 
 	// When
-	result, err := suite.service.GetCurrentMonthReport()
+	result, err := suite.service.GetCurrentMonthReport(context.Background(), false, false, nil)
 
 	// Then
 	assert.NoError(suite.T(), err)
@@ -230,7 +449,767 @@ func (suite *ReportServiceTestSuite) TestGetCurrentMonthReport_Success() {
 	assert.Equal(suite.T(), now.Month().String(), result.Month)
 	assert.Equal(suite.T(), currentYear, result.Year)
 	assert.Equal(suite.T(), 1, result.Summary.TransactionCount)
-	assert.Equal(suite.T(), 3000.0, result.TotalIncome["ARS"])
+	assert.Equal(suite.T(), models.Money(3000.0), result.TotalIncome["ARS"])
+	assert.NotNil(suite.T(), result.ProjectedExpense)
+}
+
+func (suite *ReportServiceTestSuite) TestGetCurrentMonthReport_FollowsClockAcrossMonthBoundary() {
+	testCases := []struct {
+		name          string
+		now           time.Time
+		expectedStart time.Time
+		expectedMonth string
+		projected     float64
+	}{
+		{
+			name:          "last minute of January",
+			now:           time.Date(2024, 1, 31, 23, 59, 0, 0, time.UTC),
+			expectedStart: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+			expectedMonth: "January",
+			projected:     10, // Whole month elapsed, nothing to extrapolate
+		},
+		{
+			name:          "first minute of February",
+			now:           time.Date(2024, 2, 1, 0, 1, 0, 0, time.UTC),
+			expectedStart: time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC),
+			expectedMonth: "February",
+			projected:     290, // 1 day elapsed of 29
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			// Given
+			mockRepo := new(MockTransactionRepository)
+			service := services.NewReportServiceWithConfig(mockRepo, services.ReportServiceConfig{
+				Clock: fixedClock{now: tc.now},
+			})
+			transactions := []models.Transaction{
+				{ID: 1, Type: "expense", Amount: 10, Currency: "ARS", Category: "food", Date: tc.now},
+			}
+			expectedEnd := tc.expectedStart.AddDate(0, 1, 0).Add(-time.Nanosecond)
+			mockRepo.On("GetByDateRange", mock.Anything, tc.expectedStart, expectedEnd).Return(transactions, nil)
+
+			// When
+			result, err := service.GetCurrentMonthReport(context.Background(), false, false, nil)
+
+			// Then
+			assert.NoError(suite.T(), err)
+			assert.Equal(suite.T(), tc.expectedMonth, result.Month)
+			assert.Equal(suite.T(), 2024, result.Year)
+			assert.InDelta(suite.T(), tc.projected, float64(result.ProjectedExpense["ARS"]), 0.001)
+			mockRepo.AssertExpectations(suite.T())
+		})
+	}
+}
+
+func (suite *ReportServiceTestSuite) TestGetCurrentMonthReport_ProjectedKeptApartFromActuals() {
+	// Given - June 10th at noon, with pending transactions overdue, due earlier today, later this month and next month
+	now := time.Date(2024, 6, 10, 12, 0, 0, 0, time.UTC)
+	repo := repositories.NewMemoryTransactionRepository()
+	service := services.NewReportServiceWithConfig(repo, services.ReportServiceConfig{Clock: fixedClock{now: now}})
+	for _, tx := range []*models.Transaction{
+		{Type: "income", Amount: 1000, Currency: "USD", Category: "salary", Status: models.TransactionStatusCleared, Date: now.AddDate(0, 0, -9)},
+		{Type: "expense", Amount: 200, Currency: "USD", Category: "food", Status: models.TransactionStatusCleared, Date: now.AddDate(0, 0, -1)},
+		{Type: "expense", Amount: 50, Currency: "USD", Category: "food", Status: models.TransactionStatusPending, Date: now.AddDate(0, 0, -2)},
+		{Type: "income", Amount: 1000, Currency: "USD", Category: "salary", Status: models.TransactionStatusPending, Date: now.AddDate(0, 0, 15)},
+		{Type: "expense", Amount: 400, Currency: "USD", Category: "rent", Status: models.TransactionStatusPending, Date: now.AddDate(0, 0, 5)},
+		{Type: "expense", Amount: 30, Currency: "USD", Category: "food", Status: models.TransactionStatusPending, Date: time.Date(2024, 6, 10, 0, 0, 0, 0, time.UTC)},
+		{Type: "expense", Amount: 9999, Currency: "USD", Category: "rent", Status: models.TransactionStatusPending, Date: now.AddDate(0, 1, 0)},
+	} {
+		repo.Create(context.Background(), tx)
+	}
+
+	// When
+	withoutProjected, err := service.GetCurrentMonthReport(context.Background(), false, false, nil)
+	suite.Require().NoError(err)
+	withProjected, err := service.GetCurrentMonthReport(context.Background(), false, true, nil)
+	suite.Require().NoError(err)
+
+	// Then
+	assert.Nil(suite.T(), withoutProjected.Projected)
+	assert.Equal(suite.T(), &models.ProjectedTotals{
+		Income:           models.CurrencyAmounts{"USD": 1000},
+		Expense:          models.CurrencyAmounts{"USD": 430},
+		Balance:          models.CurrencyAmounts{"USD": 570},
+		TransactionCount: 3,
+	}, withProjected.Projected)
+
+	// Then - the actuals are the same either way
+	assert.Equal(suite.T(), withoutProjected.TotalIncome, withProjected.TotalIncome)
+	assert.Equal(suite.T(), withoutProjected.TotalExpense, withProjected.TotalExpense)
+	assert.Equal(suite.T(), withoutProjected.Balance, withProjected.Balance)
+	assert.Equal(suite.T(), models.CurrencyAmounts{"USD": 800}, withProjected.Balance)
+}
+
+func (suite *ReportServiceTestSuite) TestProjectMonthlyExpense_ExtrapolatesToFullMonth() {
+	// Given: 300 ARS and 15 USD spent by June 10th, a 30-day month
+	now := time.Date(2024, 6, 10, 12, 0, 0, 0, time.UTC)
+	expense := models.CurrencyAmounts{"ARS": 300, "USD": 15}
+
+	// When
+	projected := services.ProjectMonthlyExpense(expense, now)
+
+	// Then
+	assert.InDelta(suite.T(), 900.0, float64(projected["ARS"]), 0.001)
+	assert.InDelta(suite.T(), 45.0, float64(projected["USD"]), 0.001)
+}
+
+func (suite *ReportServiceTestSuite) TestGetMonthlyReport_HistoricalMonthHasNoProjection() {
+	// Given
+	transactions := []models.Transaction{
+		{ID: 1, Type: "expense", Amount: 500, Currency: "ARS", Category: "food", Date: time.Date(2024, 1, 5, 0, 0, 0, 0, time.UTC)},
+	}
+	suite.mockRepo.On("GetByDateRange", mock.Anything, mock.Anything, mock.Anything).Return(transactions, nil)
+
+	// When
+	result, err := suite.service.GetMonthlyReport(context.Background(), 2024, 1)
+
+	// Then
+	assert.NoError(suite.T(), err)
+	assert.Nil(suite.T(), result.ProjectedExpense)
+}
+
+// Test GetGroupedReport
+func groupedReportTransactions() []models.Transaction {
+	return []models.Transaction{
+		{ID: 1, Type: "income", Amount: 50000, Currency: "ARS", Category: "salary"},
+		{ID: 2, Type: "expense", Amount: 15000, Currency: "ARS", Category: "food"},
+		{ID: 3, Type: "expense", Amount: 5000, Currency: "ARS", Category: "food"},
+		{ID: 4, Type: "expense", Amount: 200, Currency: "USD", Category: "food"},
+		{ID: 5, Type: "income", Amount: 1000, Currency: "USD", Category: "freelance"},
+	}
+}
+
+func (suite *ReportServiceTestSuite) TestGetGroupedReport_ByCategory() {
+	// Given
+	suite.mockRepo.On("GetByFilters", mock.Anything, mock.Anything).Return(groupedReportTransactions(), nil)
+
+	// When
+	result, err := suite.service.GetGroupedReport(context.Background(), "category", nil, nil, false)
+
+	// Then
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), "category", result.Field)
+	assert.Equal(suite.T(), 5, result.TransactionCount)
+	assert.Len(suite.T(), result.Groups, 3)
+
+	food := result.Groups["food"]
+	assert.Equal(suite.T(), 3, food.Count)
+	assert.Equal(suite.T(), models.CurrencyAmounts{"ARS": 20000, "USD": 200}, food.Expense)
+	assert.Empty(suite.T(), food.Income)
+	assert.Equal(suite.T(), models.CurrencyAmounts{"ARS": -20000, "USD": -200}, food.Balance)
+
+	assert.Equal(suite.T(), 1, result.Groups["salary"].Count)
+	assert.Equal(suite.T(), models.Money(50000.0), result.Groups["salary"].Income["ARS"])
+	assert.Equal(suite.T(), models.Money(1000.0), result.Groups["freelance"].Income["USD"])
+}
+
+func (suite *ReportServiceTestSuite) TestGetGroupedReport_ByCurrency() {
+	// Given
+	suite.mockRepo.On("GetByFilters", mock.Anything, mock.Anything).Return(groupedReportTransactions(), nil)
+
+	// When
+	result, err := suite.service.GetGroupedReport(context.Background(), "currency", nil, nil, false)
+
+	// Then
+	assert.NoError(suite.T(), err)
+	assert.Len(suite.T(), result.Groups, 2)
+
+	ars := result.Groups["ARS"]
+	assert.Equal(suite.T(), 3, ars.Count)
+	assert.Equal(suite.T(), models.Money(50000.0), ars.Income["ARS"])
+	assert.Equal(suite.T(), models.Money(20000.0), ars.Expense["ARS"])
+	assert.Equal(suite.T(), models.Money(30000.0), ars.Balance["ARS"])
+
+	usd := result.Groups["USD"]
+	assert.Equal(suite.T(), 2, usd.Count)
+	assert.Equal(suite.T(), models.Money(1000.0), usd.Income["USD"])
+	assert.Equal(suite.T(), models.Money(200.0), usd.Expense["USD"])
+	assert.Equal(suite.T(), models.Money(800.0), usd.Balance["USD"])
+}
+
+func (suite *ReportServiceTestSuite) TestGetGroupedReport_ByType() {
+	// Given
+	suite.mockRepo.On("GetByFilters", mock.Anything, mock.Anything).Return(groupedReportTransactions(), nil)
+
+	// When
+	result, err := suite.service.GetGroupedReport(context.Background(), "type", nil, nil, false)
+
+	// Then
+	assert.NoError(suite.T(), err)
+	assert.Len(suite.T(), result.Groups, 2)
+
+	income := result.Groups["income"]
+	assert.Equal(suite.T(), 2, income.Count)
+	assert.Equal(suite.T(), models.Money(50000.0), income.Income["ARS"])
+	assert.Equal(suite.T(), models.Money(1000.0), income.Income["USD"])
+
+	expense := result.Groups["expense"]
+	assert.Equal(suite.T(), 3, expense.Count)
+	assert.Equal(suite.T(), models.Money(20000.0), expense.Expense["ARS"])
+	assert.Equal(suite.T(), models.Money(200.0), expense.Expense["USD"])
+}
+
+func (suite *ReportServiceTestSuite) TestGetGroupedReport_PassesDateRange() {
+	// Given
+	fromDate := time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)
+	toDate := time.Date(2024, 6, 30, 0, 0, 0, 0, time.UTC)
+
+	suite.mockRepo.On("GetByFilters", mock.Anything, mock.MatchedBy(func(filters models.TransactionFilters) bool {
+		return filters.FromDate != nil && filters.FromDate.Equal(fromDate) &&
+			filters.ToDate != nil && filters.ToDate.Equal(toDate)
+	})).Return([]models.Transaction{}, nil)
+
+	// When
+	result, err := suite.service.GetGroupedReport(context.Background(), "type", &fromDate, &toDate, false)
+
+	// Then
+	assert.NoError(suite.T(), err)
+	assert.Empty(suite.T(), result.Groups)
+	assert.Equal(suite.T(), 0, result.TransactionCount)
+}
+
+func (suite *ReportServiceTestSuite) TestGetGroupedReport_InvalidField() {
+	// When
+	result, err := suite.service.GetGroupedReport(context.Background(), "description", nil, nil, false)
+
+	// Then
+	assert.ErrorIs(suite.T(), err, models.ErrInvalidGroupField)
+	assert.Nil(suite.T(), result)
+}
+
+// Test GetBalanceAsOf
+func (suite *ReportServiceTestSuite) TestGetBalanceAsOf_MidHistory() {
+	// Given
+	asOf := time.Date(2024, 6, 30, 23, 59, 59, 0, time.UTC)
+	transactions := []models.Transaction{
+		{ID: 1, Type: "income", Amount: 10000, Currency: "ARS", Date: time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC)},
+		{ID: 2, Type: "expense", Amount: 2500, Currency: "ARS", Date: time.Date(2024, 6, 30, 0, 0, 0, 0, time.UTC)},
+		{ID: 3, Type: "expense", Amount: 40, Currency: "USD", Date: time.Date(2024, 6, 15, 0, 0, 0, 0, time.UTC)},
+	}
+	suite.mockRepo.On("GetByDateRange", mock.Anything, mock.MatchedBy(func(start time.Time) bool {
+		return start.IsZero()
+	}), asOf).Return(transactions, nil)
+
+	// When
+	result, err := suite.service.GetBalanceAsOf(context.Background(), asOf, "", false)
+
+	// Then
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), 3, result.TransactionCount)
+	assert.Equal(suite.T(), models.Money(7500.0), result.Balance["ARS"])
+	assert.Equal(suite.T(), models.Money(-40.0), result.Balance["USD"])
+}
+
+// anomalyTransactions returns a steady run of ARS coffee expenses
+func anomalyTransactions() []models.Transaction {
+	transactions := make([]models.Transaction, 0)
+	for i := 1; i <= 20; i++ {
+		transactions = append(transactions, models.Transaction{
+			ID: i, Type: "expense", Amount: models.Money(1400 + (i%3)*100), Currency: "ARS", Category: "coffee",
+		})
+	}
+	transactions = append(transactions,
+		models.Transaction{ID: 21, Type: "expense", Amount: 20, Currency: "USD", Category: "software"},
+		models.Transaction{ID: 22, Type: "expense", Amount: 25, Currency: "USD", Category: "software"},
+	)
+	return transactions
+}
+
+func (suite *ReportServiceTestSuite) TestGetAnomalyReport_FlagsOutlierAndMixedCurrency() {
+	// Given - a coffee entered with an extra zero and a subscription logged in ARS
+	transactions := append(anomalyTransactions(),
+		models.Transaction{ID: 23, Type: "expense", Amount: 15000, Currency: "ARS", Category: "coffee"},
+		models.Transaction{ID: 24, Type: "expense", Amount: 22, Currency: "ARS", Category: "software"},
+	)
+	suite.mockRepo.On("GetByFilters", mock.Anything, mock.Anything).Return(transactions, nil)
+
+	// When
+	result, err := suite.service.GetAnomalyReport(context.Background(), nil, nil, false)
+
+	// Then
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), 24, result.TransactionCount)
+
+	assert.Len(suite.T(), result.Outliers, 1)
+	assert.Equal(suite.T(), 23, result.Outliers[0].Transaction.ID)
+	assert.Greater(suite.T(), result.Outliers[0].ZScore, 3.0)
+
+	assert.Len(suite.T(), result.MixedCurrencyCategories, 1)
+	mixed := result.MixedCurrencyCategories[0]
+	assert.Equal(suite.T(), "software", mixed.Category)
+	assert.Equal(suite.T(), "USD", mixed.DominantCurrency)
+	assert.Equal(suite.T(), map[string]int{"USD": 2, "ARS": 1}, mixed.CurrencyCounts)
+	assert.Equal(suite.T(), []int{24}, mixed.OtherTransactionIDs)
+
+	assert.Len(suite.T(), result.Stats, 3)
+	assert.Equal(suite.T(), "coffee", result.Stats[0].Category)
+	assert.Equal(suite.T(), 21, result.Stats[0].Count)
+}
+
+func (suite *ReportServiceTestSuite) TestGetAnomalyReport_CleanDataset() {
+	// Given
+	suite.mockRepo.On("GetByFilters", mock.Anything, mock.Anything).Return(anomalyTransactions(), nil)
+
+	// When
+	result, err := suite.service.GetAnomalyReport(context.Background(), nil, nil, false)
+
+	// Then
+	assert.NoError(suite.T(), err)
+	assert.Empty(suite.T(), result.Outliers)
+	assert.Empty(suite.T(), result.MixedCurrencyCategories)
+	assert.Len(suite.T(), result.Stats, 2)
+	assert.InDelta(suite.T(), 1505, float64(result.Stats[0].Mean), 0.01)
+}
+
+func balanceTransactions() []models.Transaction {
+	return []models.Transaction{
+		{ID: 1, Type: "income", Amount: 100000, Currency: "ARS", Date: time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC)},
+		{ID: 2, Type: "income", Amount: 50, Currency: "USD", Date: time.Date(2024, 5, 2, 0, 0, 0, 0, time.UTC)},
+		{ID: 3, Type: "expense", Amount: 20, Currency: "EUR", Date: time.Date(2024, 5, 3, 0, 0, 0, 0, time.UTC)},
+	}
+}
+
+func (suite *ReportServiceTestSuite) TestGetBalanceAsOf_ConsolidatedWithAllRates() {
+	// Given
+	asOf := time.Date(2024, 6, 30, 23, 59, 59, 0, time.UTC)
+	service := services.NewReportServiceWithConfig(suite.mockRepo, services.ReportServiceConfig{
+		ExchangeRates: map[string]float64{"USD": 1, "ARS": 0.001, "EUR": 1.5},
+	})
+	suite.mockRepo.On("GetByDateRange", mock.Anything, mock.Anything, asOf).Return(balanceTransactions(), nil)
+
+	// When
+	result, err := service.GetBalanceAsOf(context.Background(), asOf, "USD", false)
+
+	// Then: 100000 ARS is 100 USD, plus 50 USD, minus 20 EUR (30 USD)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), "USD", result.BaseCurrency)
+	if assert.NotNil(suite.T(), result.ConsolidatedBalance) {
+		assert.InDelta(suite.T(), 120.0, float64(*result.ConsolidatedBalance), 0.001)
+	}
+	assert.Empty(suite.T(), result.MissingRates)
+}
+
+func (suite *ReportServiceTestSuite) TestGetBalanceAsOf_ConsolidatedOmittedWhenRateMissing() {
+	// Given
+	asOf := time.Date(2024, 6, 30, 23, 59, 59, 0, time.UTC)
+	service := services.NewReportServiceWithConfig(suite.mockRepo, services.ReportServiceConfig{
+		ExchangeRates: map[string]float64{"USD": 1},
+	})
+	suite.mockRepo.On("GetByDateRange", mock.Anything, mock.Anything, asOf).Return(balanceTransactions(), nil)
+
+	// When
+	result, err := service.GetBalanceAsOf(context.Background(), asOf, "USD", false)
+
+	// Then
+	assert.NoError(suite.T(), err)
+	assert.Nil(suite.T(), result.ConsolidatedBalance)
+	assert.Equal(suite.T(), []string{"ARS", "EUR"}, result.MissingRates)
+	assert.Len(suite.T(), result.Balance, 3)
+}
+
+func (suite *ReportServiceTestSuite) TestGetBalanceAsOf_BeforeAnyTransactions() {
+	// Given
+	asOf := time.Date(2020, 1, 1, 23, 59, 59, 0, time.UTC)
+	suite.mockRepo.On("GetByDateRange", mock.Anything, mock.Anything, asOf).Return([]models.Transaction{}, nil)
+
+	// When
+	result, err := suite.service.GetBalanceAsOf(context.Background(), asOf, "", false)
+
+	// Then
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), 0, result.TransactionCount)
+	assert.NotNil(suite.T(), result.Balance)
+	assert.Empty(suite.T(), result.Balance)
+}
+
+// Test GetNetWorthReport
+func (suite *ReportServiceTestSuite) TestGetNetWorthReport_RunningBalanceAcrossMonths() {
+	// Given - nets per month: +4000, -3000, +500 (ARS)
+	from := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2024, 3, 31, 23, 59, 59, 0, time.UTC)
+	transactions := []models.Transaction{
+		{ID: 1, Type: "income", Amount: 5000, Currency: "ARS", Date: time.Date(2024, 1, 5, 0, 0, 0, 0, time.UTC)},
+		{ID: 2, Type: "expense", Amount: 1000, Currency: "ARS", Date: time.Date(2024, 1, 20, 0, 0, 0, 0, time.UTC)},
+		{ID: 3, Type: "expense", Amount: 3000, Currency: "ARS", Date: time.Date(2024, 2, 10, 0, 0, 0, 0, time.UTC)},
+		{ID: 4, Type: "income", Amount: 500, Currency: "ARS", Date: time.Date(2024, 3, 31, 0, 0, 0, 0, time.UTC)},
+		{ID: 5, Type: "expense", Amount: 20, Currency: "USD", Date: time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC)},
+	}
+	suite.mockRepo.On("GetByFilters", mock.Anything, mock.MatchedBy(func(filters models.TransactionFilters) bool {
+		return filters.FromDate.Equal(from) && filters.ToDate.Equal(to)
+	})).Return(transactions, nil)
+
+	// When
+	result, err := suite.service.GetNetWorthReport(context.Background(), from, to, models.GranularityMonth, map[string]float64{"ARS": 1000}, false)
+
+	// Then
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), models.Money(1000.0), result.OpeningBalance["ARS"])
+	assert.Len(suite.T(), result.Periods, 3)
+
+	assert.Equal(suite.T(), models.Money(4000.0), result.Periods[0].Net["ARS"])
+	assert.Equal(suite.T(), models.Money(5000.0), result.Periods[0].Balance["ARS"])
+
+	assert.Equal(suite.T(), models.Money(-3000.0), result.Periods[1].Net["ARS"])
+	assert.Equal(suite.T(), models.Money(2000.0), result.Periods[1].Balance["ARS"])
+	assert.Equal(suite.T(), models.Money(-20.0), result.Periods[1].Balance["USD"])
+
+	assert.Equal(suite.T(), models.Money(500.0), result.Periods[2].Net["ARS"])
+	assert.Equal(suite.T(), models.Money(2500.0), result.Periods[2].Balance["ARS"])
+	assert.Equal(suite.T(), models.Money(-20.0), result.Periods[2].Balance["USD"], "balance carries over periods without activity")
+
+	assert.Equal(suite.T(), time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC), result.Periods[1].PeriodStart)
+	assert.Equal(suite.T(), time.Date(2024, 2, 29, 23, 59, 59, 999999999, time.UTC), result.Periods[1].PeriodEnd)
+}
+
+func (suite *ReportServiceTestSuite) TestGetNetWorthReport_ConfiguredOpeningBalance() {
+	// Given
+	service := services.NewReportServiceWithConfig(suite.mockRepo, services.ReportServiceConfig{
+		OpeningBalances: map[string]float64{"ARS": 500, "USD": 100},
+	})
+	from := time.Date(2024, 6, 3, 0, 0, 0, 0, time.UTC) // Monday
+	to := time.Date(2024, 6, 16, 23, 59, 59, 0, time.UTC)
+	suite.mockRepo.On("GetByFilters", mock.Anything, mock.Anything).Return([]models.Transaction{
+		{ID: 1, Type: "expense", Amount: 800, Currency: "ARS", Date: time.Date(2024, 6, 12, 0, 0, 0, 0, time.UTC)},
+	}, nil)
+
+	// When - the request overrides USD only
+	result, err := service.GetNetWorthReport(context.Background(), from, to, models.GranularityWeek, map[string]float64{"USD": 40}, false)
+
+	// Then
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), models.CurrencyAmounts{"ARS": 500, "USD": 40}, result.OpeningBalance)
+	assert.Len(suite.T(), result.Periods, 2)
+	assert.Equal(suite.T(), models.Money(500.0), result.Periods[0].Balance["ARS"])
+	assert.Equal(suite.T(), models.Money(-300.0), result.Periods[1].Balance["ARS"])
+	assert.Equal(suite.T(), models.Money(40.0), result.Periods[1].Balance["USD"])
+}
+
+func (suite *ReportServiceTestSuite) TestGetNetWorthReport_InvalidParams() {
+	from := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	testCases := []struct {
+		name        string
+		to          time.Time
+		granularity string
+		errContains string
+	}{
+		{"invalid granularity", from.AddDate(0, 1, 0), "quarter", "granularity must be one of"},
+		{"from after to", from.AddDate(0, 0, -1), models.GranularityMonth, "from must not be after to"},
+		{"too many periods", from.AddDate(10, 0, 0), models.GranularityDay, "more than 1000 periods"},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			// When
+			result, err := suite.service.GetNetWorthReport(context.Background(), from, tc.to, tc.granularity, nil, false)
+
+			// Then
+			assert.Error(suite.T(), err)
+			assert.Contains(suite.T(), err.Error(), tc.errContains)
+			assert.Nil(suite.T(), result)
+		})
+	}
+}
+
+func (suite *ReportServiceTestSuite) TestGetCategoryTrend_SeriesPerMonth() {
+	// Given
+	now := time.Date(2024, 2, 10, 12, 0, 0, 0, time.UTC)
+	service := services.NewReportServiceWithConfig(suite.mockRepo, services.ReportServiceConfig{
+		Clock: fixedClock{now: now},
+	})
+	transactions := []models.Transaction{
+		{ID: 1, Type: "expense", Amount: 100, Currency: "ARS", Category: "food", Date: time.Date(2023, 11, 5, 0, 0, 0, 0, time.UTC)},
+		{ID: 2, Type: "expense", Amount: 50, Currency: "ARS", Category: "food", Date: time.Date(2023, 11, 20, 0, 0, 0, 0, time.UTC)},
+		{ID: 3, Type: "expense", Amount: 20, Currency: "USD", Category: "food", Date: time.Date(2024, 1, 3, 0, 0, 0, 0, time.UTC)},
+		{ID: 4, Type: "expense", Amount: 80, Currency: "ARS", Category: "food", Date: time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC)},
+		{ID: 5, Type: "expense", Amount: 999, Currency: "ARS", Category: "food", Status: models.TransactionStatusPending, Date: time.Date(2024, 2, 2, 0, 0, 0, 0, time.UTC)},
+	}
+	from := time.Date(2023, 9, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC).Add(-time.Nanosecond)
+	suite.mockRepo.On("GetByFilters", mock.Anything, models.TransactionFilters{
+		Type: models.TransactionTypeExpense, Category: "food", FromDate: &from, ToDate: &to,
+	}).Return(transactions, nil)
+
+	// When
+	result, err := service.GetCategoryTrend(context.Background(), "food", 6, false)
+
+	// Then
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), 6, result.Months)
+	assert.Len(suite.T(), result.Series, 6)
+
+	expected := []struct {
+		year    int
+		month   int
+		expense models.CurrencyAmounts
+	}{
+		{2023, 9, models.CurrencyAmounts{}},
+		{2023, 10, models.CurrencyAmounts{}},
+		{2023, 11, models.CurrencyAmounts{"ARS": 150}},
+		{2023, 12, models.CurrencyAmounts{}},
+		{2024, 1, models.CurrencyAmounts{"USD": 20}},
+		{2024, 2, models.CurrencyAmounts{"ARS": 80}},
+	}
+	for i, point := range result.Series {
+		assert.Equal(suite.T(), expected[i].year, point.Year)
+		assert.Equal(suite.T(), expected[i].month, point.Month)
+		assert.Equal(suite.T(), expected[i].expense, point.Expense)
+	}
+	suite.mockRepo.AssertExpectations(suite.T())
+}
+
+func (suite *ReportServiceTestSuite) TestGetCategoryTrend_InvalidMonths() {
+	for _, months := range []int{0, -1, 37} {
+		// When
+		result, err := suite.service.GetCategoryTrend(context.Background(), "food", months, false)
+
+		// Then
+		assert.EqualError(suite.T(), err, "months must be between 1 and 36")
+		assert.Nil(suite.T(), result)
+	}
+	suite.mockRepo.AssertNotCalled(suite.T(), "GetByFilters", mock.Anything, mock.Anything)
+}
+
+func (suite *ReportServiceTestSuite) savingsRateFor(transactions []models.Transaction) *models.SavingsRateReport {
+	start := time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)
+	end := start.AddDate(0, 1, 0).Add(-time.Nanosecond)
+	suite.mockRepo.On("GetByDateRange", mock.Anything, start, end).Return(transactions, nil)
+
+	result, err := suite.service.GetSavingsRate(context.Background(), 2024, 6, false, nil)
+	assert.NoError(suite.T(), err)
+	return result
+}
+
+func (suite *ReportServiceTestSuite) TestGetSavingsRate_Positive() {
+	// Given
+	date := time.Date(2024, 6, 10, 0, 0, 0, 0, time.UTC)
+	transactions := []models.Transaction{
+		{ID: 1, Type: "income", Amount: 1000, Currency: "ARS", Category: "salary", Date: date},
+		{ID: 2, Type: "expense", Amount: 750, Currency: "ARS", Category: "rent", Date: date},
+	}
+
+	// When
+	result := suite.savingsRateFor(transactions)
+
+	// Then
+	assert.Equal(suite.T(), "June", result.Month)
+	rate := result.Rates["ARS"]
+	assert.Equal(suite.T(), models.Money(1000), rate.Income)
+	assert.Equal(suite.T(), models.Money(750), rate.Expense)
+	assert.InDelta(suite.T(), 0.25, *rate.Rate, 0.0001)
+	assert.False(suite.T(), rate.NoIncome)
+}
+
+func (suite *ReportServiceTestSuite) TestGetSavingsRate_Overspend() {
+	// Given
+	date := time.Date(2024, 6, 10, 0, 0, 0, 0, time.UTC)
+	transactions := []models.Transaction{
+		{ID: 1, Type: "income", Amount: 800, Currency: "USD", Category: "salary", Date: date},
+		{ID: 2, Type: "expense", Amount: 1000, Currency: "USD", Category: "travel", Date: date},
+		{ID: 3, Type: "expense", Amount: 500, Currency: "USD", Category: "travel", Status: models.TransactionStatusPending, Date: date},
+	}
+
+	// When
+	result := suite.savingsRateFor(transactions)
+
+	// Then
+	rate := result.Rates["USD"]
+	assert.InDelta(suite.T(), -0.25, *rate.Rate, 0.0001)
+	assert.False(suite.T(), rate.NoIncome)
+}
+
+func (suite *ReportServiceTestSuite) TestGetSavingsRate_ZeroIncome() {
+	// Given
+	date := time.Date(2024, 6, 10, 0, 0, 0, 0, time.UTC)
+	transactions := []models.Transaction{
+		{ID: 1, Type: "income", Amount: 1000, Currency: "ARS", Category: "salary", Date: date},
+		{ID: 2, Type: "expense", Amount: 40, Currency: "EUR", Category: "books", Date: date},
+	}
+
+	// When
+	result := suite.savingsRateFor(transactions)
+
+	// Then
+	assert.Len(suite.T(), result.Rates, 2)
+	eur := result.Rates["EUR"]
+	assert.Nil(suite.T(), eur.Rate)
+	assert.True(suite.T(), eur.NoIncome)
+	assert.Equal(suite.T(), models.Money(40), eur.Expense)
+	assert.InDelta(suite.T(), 1.0, *result.Rates["ARS"].Rate, 0.0001)
+
+	data, err := json.Marshal(eur)
+	assert.NoError(suite.T(), err)
+	assert.JSONEq(suite.T(), `{"income": 0, "expense": 40, "rate": null, "no_income": true}`, string(data))
+}
+
+func (suite *ReportServiceTestSuite) TestGetSavingsRate_InvalidMonth() {
+	// When
+	result, err := suite.service.GetSavingsRate(context.Background(), 2024, 13, false, nil)
+
+	// Then
+	assert.EqualError(suite.T(), err, "month must be between 1 and 12")
+	assert.Nil(suite.T(), result)
+}
+
+func (suite *ReportServiceTestSuite) TestGetMonthlyTotals_MatchesFullReport() {
+	// Given - mixed currencies, a pending expense and amounts that drift as floats
+	date := time.Date(2024, 6, 10, 0, 0, 0, 0, time.UTC)
+	transactions := append(tenCents(),
+		models.Transaction{ID: 11, Type: "income", Amount: 1000, Currency: "ARS", Category: "salary", Date: date},
+		models.Transaction{ID: 12, Type: "expense", Amount: 250.5, Currency: "ARS", Category: "food", Date: date},
+		models.Transaction{ID: 13, Type: "expense", Amount: 99, Currency: "ARS", Category: "food", Status: models.TransactionStatusPending, Date: date},
+		models.Transaction{ID: 14, Type: "income", Amount: 300, Currency: "EUR", Category: "gift", Date: date},
+	)
+	suite.mockRepo.On("GetByDateRange", mock.Anything, mock.Anything, mock.Anything).Return(transactions, nil)
+
+	for _, includePending := range []bool{false, true} {
+		// When
+		full, err := suite.service.GetFilteredMonthlyReport(context.Background(), 2024, 6, models.TransactionFilters{IncludePending: includePending})
+		suite.Require().NoError(err)
+		totals, err := suite.service.GetMonthlyTotals(context.Background(), 2024, 6, includePending, nil)
+		suite.Require().NoError(err)
+
+		// Then
+		assert.Equal(suite.T(), full.Month, totals.Month)
+		assert.Equal(suite.T(), full.Year, totals.Year)
+		assert.Equal(suite.T(), full.TotalIncome, totals.TotalIncome)
+		assert.Equal(suite.T(), full.TotalExpense, totals.TotalExpense)
+		assert.Equal(suite.T(), full.Balance, totals.Balance)
+		assert.Equal(suite.T(), full.Summary.TransactionCount, totals.TransactionCount)
+	}
+}
+
+func (suite *ReportServiceTestSuite) TestGetMonthlyTotals_InvalidMonth() {
+	// When
+	result, err := suite.service.GetMonthlyTotals(context.Background(), 2024, 13, false, nil)
+
+	// Then
+	assert.EqualError(suite.T(), err, "month must be between 1 and 12")
+	assert.Nil(suite.T(), result)
+	suite.mockRepo.AssertNotCalled(suite.T(), "GetByDateRange", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (suite *ReportServiceTestSuite) TestGetMonthlyTotals_RoundsToCurrencyDecimals() {
+	// Given - JPY has no minor unit, USD keeps cents
+	date := time.Date(2024, 6, 10, 0, 0, 0, 0, time.UTC)
+	suite.mockRepo.On("GetByDateRange", mock.Anything, mock.Anything, mock.Anything).Return([]models.Transaction{
+		{ID: 1, Type: "expense", Amount: 100.4, Currency: "JPY", Category: "food", Date: date},
+		{ID: 2, Type: "expense", Amount: 100.4, Currency: "JPY", Category: "food", Date: date},
+		{ID: 3, Type: "expense", Amount: 10.25, Currency: "USD", Category: "food", Date: date},
+	}, nil)
+
+	// When
+	result, err := suite.service.GetMonthlyTotals(context.Background(), 2024, 6, false, nil)
+
+	// Then
+	suite.Require().NoError(err)
+	assert.Equal(suite.T(), models.Money(201), result.TotalExpense["JPY"])
+	assert.Equal(suite.T(), models.Money(10.25), result.TotalExpense["USD"])
+}
+
+func (suite *ReportServiceTestSuite) TestGetMonthDiff_CurrenciesAndCategories() {
+	// Given - May and June both in ARS; rent only in May, travel only in June
+	may := time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC)
+	june := time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)
+	suite.mockRepo.On("GetByDateRange", mock.Anything, may, june.Add(-time.Nanosecond)).Return([]models.Transaction{
+		{ID: 1, Type: "income", Amount: 1000, Currency: "ARS", Category: "salary", Date: may},
+		{ID: 2, Type: "expense", Amount: 300, Currency: "ARS", Category: "rent", Date: may},
+		{ID: 3, Type: "expense", Amount: 100, Currency: "ARS", Category: "food", Date: may},
+	}, nil)
+	suite.mockRepo.On("GetByDateRange", mock.Anything, june, june.AddDate(0, 1, 0).Add(-time.Nanosecond)).Return([]models.Transaction{
+		{ID: 4, Type: "income", Amount: 1200, Currency: "ARS", Category: "salary", Date: june},
+		{ID: 5, Type: "expense", Amount: 150, Currency: "ARS", Category: "food", Date: june},
+		{ID: 6, Type: "expense", Amount: 0.3, Currency: "ARS", Category: "food", Date: june},
+		{ID: 7, Type: "expense", Amount: 80, Currency: "USD", Category: "travel", Date: june},
+	}, nil)
+
+	// When
+	result, err := suite.service.GetMonthDiff(context.Background(), may, june, false, nil)
+
+	// Then
+	suite.Require().NoError(err)
+	assert.Equal(suite.T(), "2024-05", result.A)
+	assert.Equal(suite.T(), "2024-06", result.B)
+
+	assert.Equal(suite.T(), models.CurrencyDelta{Income: 200, Expense: -249.7, Balance: 449.7}, result.Currencies["ARS"])
+	assert.Equal(suite.T(), models.CurrencyDelta{Income: 0, Expense: 80, Balance: -80}, result.Currencies["USD"])
+
+	rent := result.Categories["rent"]
+	assert.Equal(suite.T(), models.CurrencyAmounts{"ARS": 300}, rent.A)
+	assert.Empty(suite.T(), rent.B)
+	assert.Equal(suite.T(), models.CurrencyAmounts{"ARS": -300}, rent.Delta)
+
+	travel := result.Categories["travel"]
+	assert.Empty(suite.T(), travel.A)
+	assert.Equal(suite.T(), models.CurrencyAmounts{"USD": 80}, travel.Delta)
+
+	assert.Equal(suite.T(), models.CurrencyAmounts{"ARS": 50.3}, result.Categories["food"].Delta)
+	assert.Len(suite.T(), result.Categories, 4)
+}
+
+func (suite *ReportServiceTestSuite) TestGetMonthDiff_InvalidYear() {
+	// When
+	result, err := suite.service.GetMonthDiff(context.Background(),
+		time.Date(1800, 1, 1, 0, 0, 0, 0, time.UTC), time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC), false, nil)
+
+	// Then
+	assert.EqualError(suite.T(), err, "invalid year")
+	assert.Nil(suite.T(), result)
+}
+
+// tenCents returns ten 0.1 expenses, which add up to 0.9999999999999999 in float64
+func tenCents() []models.Transaction {
+	transactions := make([]models.Transaction, 10)
+	for i := range transactions {
+		transactions[i] = models.Transaction{
+			ID: i + 1, Type: "expense", Amount: 0.1, Currency: "USD", Category: "fees", Account: "bank",
+			Date: time.Date(2024, 6, 1+i, 0, 0, 0, 0, time.UTC),
+		}
+	}
+	return transactions
+}
+
+func (suite *ReportServiceTestSuite) TestGetMonthlyReport_SumsWithoutFloatDrift() {
+	// Given
+	suite.mockRepo.On("GetByDateRange", mock.Anything, mock.Anything, mock.Anything).Return(tenCents(), nil)
+
+	// When
+	result, err := suite.service.GetMonthlyReport(context.Background(), 2024, 6)
+
+	// Then
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), models.Money(1), result.TotalExpense["USD"])
+	assert.Equal(suite.T(), models.Money(-1), result.Balance["USD"])
+	assert.Equal(suite.T(), models.Money(-1), result.AccountBalances["bank"]["USD"])
+	assert.Equal(suite.T(), models.Money(1), result.Summary.CategoryBreakdown["fees"].Totals["USD"])
+	assert.Equal(suite.T(), 100.0, result.Summary.CategoryBreakdown["fees"].PercentOfExpense["USD"])
+}
+
+func (suite *ReportServiceTestSuite) TestGetGroupedReport_SumsWithoutFloatDrift() {
+	// Given
+	suite.mockRepo.On("GetByFilters", mock.Anything, mock.Anything).Return(tenCents(), nil)
+
+	// When
+	result, err := suite.service.GetGroupedReport(context.Background(), "currency", nil, nil, false)
+
+	// Then
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), models.Money(1), result.Groups["USD"].Expense["USD"])
+}
+
+func (suite *ReportServiceTestSuite) TestGetBalanceAsOf_SumsWithoutFloatDrift() {
+	// Given
+	transactions := append(tenCents(), models.Transaction{ID: 11, Type: "income", Amount: 0.3, Currency: "USD"})
+	suite.mockRepo.On("GetByDateRange", mock.Anything, mock.Anything, mock.Anything).Return(transactions, nil)
+
+	// When
+	result, err := suite.service.GetBalanceAsOf(context.Background(), time.Date(2024, 6, 30, 0, 0, 0, 0, time.UTC), "", false)
+
+	// Then
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), models.Money(-0.7), result.Balance["USD"])
 }
 
 func TestReportServiceTestSuite(t *testing.T) {
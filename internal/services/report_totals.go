@@ -0,0 +1,96 @@
+package services
+
+import (
+	"context"
+	"time"
+
+	"github.com/maximicciullo/personal-finance-api/internal/models"
+	"go.uber.org/zap"
+)
+
+// GetMonthlyTotals sums a month's income, expense and balance per currency in one pass
+// over its transactions. It returns the same totals as the monthly report without
+// building the breakdowns, percentages or transaction list.
+func (s *reportService) GetMonthlyTotals(ctx context.Context, year, month int, includePending bool, location *time.Location) (*models.MonthlyTotals, error) {
+	s.logger.Service("GetMonthlyTotals started",
+		zap.Int("year", year),
+		zap.Int("month", month),
+	)
+
+	startDate, endDate, err := s.monthRange(year, month, location)
+	if err != nil {
+		s.logger.Error("service", "GetMonthlyTotals - invalid year or month", err,
+			zap.Int("year", year),
+			zap.Int("month", month),
+		)
+		return nil, err
+	}
+
+	repoStart := time.Now()
+	transactions, err := s.repo.GetByDateRange(ctx, startDate, endDate)
+	repoDuration := time.Since(repoStart)
+
+	s.logger.Performance("GetMonthlyTotals repository call", repoDuration,
+		zap.Int("transaction_count", len(transactions)),
+		zap.Bool("success", err == nil),
+	)
+
+	if err != nil {
+		s.logger.Error("service", "GetMonthlyTotals - repository error", err,
+			zap.Int("year", year),
+			zap.Int("month", month),
+		)
+		return nil, err
+	}
+
+	income := currencySums{}
+	expense := currencySums{}
+	count := 0
+	for _, transaction := range transactions {
+		if transaction.IsPending() && !includePending {
+			continue
+		}
+		count++
+		if transaction.Type == models.TransactionTypeIncome {
+			income.add(transaction.Currency, transaction.Amount)
+		} else {
+			expense.add(transaction.Currency, transaction.Amount)
+		}
+	}
+
+	balance := currencySums{}
+	for _, sums := range []currencySums{income, expense} {
+		for currency := range sums {
+			balance[currency] = income[currency].Sub(expense[currency])
+		}
+	}
+
+	// Like the monthly report, every currency gets an entry in each map
+	totalIncome := income.money()
+	totalExpense := expense.money()
+	for currency := range balance {
+		if _, ok := totalIncome[currency]; !ok {
+			totalIncome[currency] = 0
+		}
+		if _, ok := totalExpense[currency]; !ok {
+			totalExpense[currency] = 0
+		}
+	}
+
+	totals := &models.MonthlyTotals{
+		Month:            time.Month(month).String(),
+		Year:             year,
+		TotalIncome:      totalIncome,
+		TotalExpense:     totalExpense,
+		Balance:          balance.money(),
+		TransactionCount: count,
+	}
+
+	s.logger.Service("GetMonthlyTotals completed successfully",
+		zap.Int("currencies_count", len(totals.Balance)),
+		zap.Int("transaction_count", count),
+		zap.Duration("total_duration", time.Since(repoStart)),
+	)
+
+	return totals, nil
+}
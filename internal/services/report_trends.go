@@ -0,0 +1,91 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/maximicciullo/personal-finance-api/internal/models"
+	"go.uber.org/zap"
+)
+
+// maxTrendMonths bounds how far back a category trend can reach
+const maxTrendMonths = 36
+
+// GetCategoryTrend returns the category's expense per currency for each of the last
+// months, ending with the current month
+func (s *reportService) GetCategoryTrend(ctx context.Context, category string, months int, includePending bool) (*models.CategoryTrendReport, error) {
+	s.logger.Service("GetCategoryTrend started",
+		zap.String("category", category),
+		zap.Int("months", months),
+	)
+
+	if months < 1 || months > maxTrendMonths {
+		err := fmt.Errorf("months must be between 1 and %d", maxTrendMonths)
+		s.logger.Error("service", "GetCategoryTrend - invalid months", err,
+			zap.Int("months", months),
+		)
+		return nil, err
+	}
+
+	now := s.config.Clock.Now()
+	current := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, now.Location())
+	from := current.AddDate(0, -(months - 1), 0)
+	to := current.AddDate(0, 1, 0).Add(-time.Nanosecond)
+
+	repoStart := time.Now()
+	transactions, err := s.repo.GetByFilters(ctx, models.TransactionFilters{
+		Type:     models.TransactionTypeExpense,
+		Category: category,
+		FromDate: &from,
+		ToDate:   &to,
+	})
+	repoDuration := time.Since(repoStart)
+
+	s.logger.Performance("GetCategoryTrend repository call", repoDuration,
+		zap.Int("transaction_count", len(transactions)),
+		zap.Bool("success", err == nil),
+	)
+
+	if err != nil {
+		s.logger.Error("service", "GetCategoryTrend - repository error", err)
+		return nil, err
+	}
+
+	if !includePending {
+		transactions = withoutPending(transactions)
+	}
+
+	series := make([]models.CategoryTrendPoint, months)
+	for i := range series {
+		month := from.AddDate(0, i, 0)
+		series[i] = models.CategoryTrendPoint{
+			Year:    month.Year(),
+			Month:   int(month.Month()),
+			Expense: make(map[string]models.Money),
+		}
+	}
+
+	for _, transaction := range transactions {
+		date := transaction.Date.In(from.Location())
+		i := (date.Year()-from.Year())*12 + int(date.Month()) - int(from.Month())
+		if i < 0 || i >= months {
+			continue
+		}
+		series[i].Expense[transaction.Currency] += transaction.Amount
+	}
+
+	report := &models.CategoryTrendReport{
+		Category: category,
+		Months:   months,
+		Series:   series,
+	}
+
+	s.logger.Service("GetCategoryTrend completed successfully",
+		zap.String("category", category),
+		zap.Int("transaction_count", len(transactions)),
+		zap.Duration("total_duration", time.Since(repoStart)),
+	)
+
+	return report, nil
+}
@@ -0,0 +1,248 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/maximicciullo/personal-finance-api/internal/middleware"
+	"github.com/maximicciullo/personal-finance-api/internal/models"
+	"github.com/maximicciullo/personal-finance-api/internal/repositories"
+	"github.com/maximicciullo/personal-finance-api/internal/utils"
+	"go.uber.org/zap"
+)
+
+type templateService struct {
+	repo         repositories.TemplateRepository
+	transactions TransactionService
+	logger       *middleware.BusinessLoggerInstance
+}
+
+// NewTemplateService creates templates in repo; applied templates are created as
+// transactions through the given transaction service, so the usual rules apply.
+func NewTemplateService(repo repositories.TemplateRepository, transactions TransactionService) TemplateService {
+	return &templateService{
+		repo:         repo,
+		transactions: transactions,
+		logger:       middleware.BusinessLogger(),
+	}
+}
+
+func (s *templateService) CreateTemplate(ctx context.Context, req *models.TemplateRequest) (*models.Template, error) {
+	s.logger.Service("CreateTemplate started",
+		zap.String("name", req.Name),
+	)
+
+	template, err := s.buildTemplate(req)
+	if err != nil {
+		s.logger.Error("service", "CreateTemplate - validation failed", err,
+			zap.Any("request", req),
+		)
+		return nil, err
+	}
+
+	if err := s.repo.Create(ctx, template); err != nil {
+		s.logger.Error("service", "CreateTemplate - repository error", err)
+		return nil, err
+	}
+
+	s.logger.Service("CreateTemplate completed successfully",
+		zap.Int("template_id", template.ID),
+	)
+
+	return template, nil
+}
+
+func (s *templateService) GetTemplate(ctx context.Context, id int) (*models.Template, error) {
+	s.logger.Service("GetTemplate started",
+		zap.Int("template_id", id),
+	)
+
+	template, err := s.repo.GetByID(ctx, id)
+	if err != nil {
+		s.logger.Error("service", "GetTemplate - repository error", err,
+			zap.Int("template_id", id),
+		)
+		return nil, err
+	}
+
+	s.logger.Service("GetTemplate completed successfully",
+		zap.Int("template_id", id),
+	)
+
+	return template, nil
+}
+
+func (s *templateService) GetTemplates(ctx context.Context) ([]models.Template, error) {
+	s.logger.Service("GetTemplates started")
+
+	templates, err := s.repo.GetAll(ctx)
+	if err != nil {
+		s.logger.Error("service", "GetTemplates - repository error", err)
+		return nil, err
+	}
+
+	s.logger.Service("GetTemplates completed successfully",
+		zap.Int("template_count", len(templates)),
+	)
+
+	return templates, nil
+}
+
+// UpdateTemplate replaces every field of the template with the request's values
+func (s *templateService) UpdateTemplate(ctx context.Context, id int, req *models.TemplateRequest) (*models.Template, error) {
+	s.logger.Service("UpdateTemplate started",
+		zap.Int("template_id", id),
+	)
+
+	template, err := s.buildTemplate(req)
+	if err != nil {
+		s.logger.Error("service", "UpdateTemplate - validation failed", err,
+			zap.Any("request", req),
+		)
+		return nil, err
+	}
+
+	template.ID = id
+	if err := s.repo.Update(ctx, template); err != nil {
+		s.logger.Error("service", "UpdateTemplate - repository error", err,
+			zap.Int("template_id", id),
+		)
+		return nil, err
+	}
+
+	s.logger.Service("UpdateTemplate completed successfully",
+		zap.Int("template_id", id),
+	)
+
+	return template, nil
+}
+
+func (s *templateService) DeleteTemplate(ctx context.Context, id int) error {
+	s.logger.Service("DeleteTemplate started",
+		zap.Int("template_id", id),
+	)
+
+	if err := s.repo.Delete(ctx, id); err != nil {
+		s.logger.Error("service", "DeleteTemplate - repository error", err,
+			zap.Int("template_id", id),
+		)
+		return err
+	}
+
+	s.logger.Service("DeleteTemplate completed successfully",
+		zap.Int("template_id", id),
+	)
+
+	return nil
+}
+
+// ApplyTemplate creates a transaction from the template's fields, with any non-nil
+// override taking precedence. Fields the template leaves empty must be overridden.
+func (s *templateService) ApplyTemplate(ctx context.Context, id int, overrides *models.UpdateTransactionRequest) (*models.Transaction, error) {
+	s.logger.Service("ApplyTemplate started",
+		zap.Int("template_id", id),
+	)
+
+	template, err := s.repo.GetByID(ctx, id)
+	if err != nil {
+		s.logger.Error("service", "ApplyTemplate - repository error", err,
+			zap.Int("template_id", id),
+		)
+		return nil, err
+	}
+
+	req := &models.CreateTransactionRequest{
+		Type:        template.Type,
+		Amount:      float64(template.Amount),
+		Currency:    template.Currency,
+		Description: template.Description,
+		Category:    template.Category,
+		Account:     template.Account,
+	}
+	if overrides != nil {
+		applyOverrides(req, overrides)
+	}
+
+	transaction, err := s.transactions.CreateTransaction(ctx, req)
+	if err != nil {
+		s.logger.Error("service", "ApplyTemplate - transaction creation failed", err,
+			zap.Int("template_id", id),
+		)
+		return nil, err
+	}
+
+	s.logger.Service("ApplyTemplate completed successfully",
+		zap.Int("template_id", id),
+		zap.Int("transaction_id", transaction.ID),
+	)
+
+	return transaction, nil
+}
+
+// applyOverrides copies every field set in overrides onto req
+func applyOverrides(req *models.CreateTransactionRequest, overrides *models.UpdateTransactionRequest) {
+	if overrides.Type != nil {
+		req.Type = *overrides.Type
+	}
+	if overrides.Amount != nil {
+		req.Amount = *overrides.Amount
+	}
+	if overrides.Currency != nil {
+		req.Currency = *overrides.Currency
+	}
+	if overrides.Description != nil {
+		req.Description = *overrides.Description
+	}
+	if overrides.Category != nil {
+		req.Category = *overrides.Category
+	}
+	if overrides.Account != nil {
+		req.Account = *overrides.Account
+	}
+	if overrides.Pinned != nil {
+		req.Pinned = *overrides.Pinned
+	}
+	if overrides.Status != nil {
+		req.Status = *overrides.Status
+	}
+	if overrides.Tags != nil {
+		req.Tags = *overrides.Tags
+	}
+	if overrides.Metadata != nil {
+		req.Metadata = *overrides.Metadata
+	}
+	if overrides.Date != nil {
+		req.Date = overrides.Date
+	}
+}
+
+// buildTemplate validates a template request and returns the template to store
+func (s *templateService) buildTemplate(req *models.TemplateRequest) (*models.Template, error) {
+	name := strings.TrimSpace(req.Name)
+	if name == "" {
+		return nil, errors.New("name is required")
+	}
+
+	if req.Type != "" && req.Type != models.TransactionTypeExpense && req.Type != models.TransactionTypeIncome {
+		return nil, errors.New("type must be 'expense' or 'income'")
+	}
+
+	if req.Amount < 0 {
+		return nil, errors.New("amount must be positive")
+	}
+
+	if err := utils.ValidateCurrency(req.Currency); err != nil {
+		return nil, err
+	}
+
+	return &models.Template{
+		Name:        name,
+		Type:        req.Type,
+		Amount:      models.Money(req.Amount),
+		Currency:    strings.ToUpper(req.Currency),
+		Description: req.Description,
+		Category:    req.Category,
+		Account:     req.Account,
+	}, nil
+}
@@ -0,0 +1,148 @@
+package services_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/maximicciullo/personal-finance-api/internal/middleware"
+	"github.com/maximicciullo/personal-finance-api/internal/models"
+	"github.com/maximicciullo/personal-finance-api/internal/repositories"
+	"github.com/maximicciullo/personal-finance-api/internal/services"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+// TemplateServiceTestSuite is the test suite for TemplateService
+type TemplateServiceTestSuite struct {
+	suite.Suite
+	mockRepo *MockTransactionRepository
+	service  services.TemplateService
+}
+
+func (suite *TemplateServiceTestSuite) SetupTest() {
+	middleware.InitLogger("test")
+
+	suite.mockRepo = new(MockTransactionRepository)
+	suite.service = services.NewTemplateService(
+		repositories.NewMemoryTemplateRepository(),
+		services.NewTransactionService(suite.mockRepo),
+	)
+}
+
+func (suite *TemplateServiceTestSuite) TearDownTest() {
+	suite.mockRepo.AssertExpectations(suite.T())
+}
+
+func (suite *TemplateServiceTestSuite) createCoffeeTemplate() *models.Template {
+	template, err := suite.service.CreateTemplate(context.Background(), &models.TemplateRequest{
+		Name:        "coffee",
+		Type:        "expense",
+		Amount:      1500,
+		Currency:    "ARS",
+		Description: "Coffee",
+		Category:    "food",
+	})
+	suite.Require().NoError(err)
+	return template
+}
+
+func (suite *TemplateServiceTestSuite) TestApplyTemplate_WithoutOverrides() {
+	// Given
+	template := suite.createCoffeeTemplate()
+	suite.mockRepo.On("Create", mock.Anything, mock.AnythingOfType("*models.Transaction")).Return(nil).Run(func(args mock.Arguments) {
+		args.Get(1).(*models.Transaction).ID = 1
+	})
+
+	// When
+	result, err := suite.service.ApplyTemplate(context.Background(), template.ID, &models.UpdateTransactionRequest{})
+
+	// Then
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), 1, result.ID)
+	assert.Equal(suite.T(), "expense", result.Type)
+	assert.Equal(suite.T(), models.Money(1500), result.Amount)
+	assert.Equal(suite.T(), "ARS", result.Currency)
+	assert.Equal(suite.T(), "Coffee", result.Description)
+	assert.Equal(suite.T(), "food", result.Category)
+}
+
+func (suite *TemplateServiceTestSuite) TestApplyTemplate_WithOverrides() {
+	// Given
+	template := suite.createCoffeeTemplate()
+	suite.mockRepo.On("Create", mock.Anything, mock.AnythingOfType("*models.Transaction")).Return(nil)
+
+	amount := 2200.0
+	description := "Coffee and croissant"
+	date := "2024-05-03"
+
+	// When
+	result, err := suite.service.ApplyTemplate(context.Background(), template.ID, &models.UpdateTransactionRequest{
+		Amount:      &amount,
+		Description: &description,
+		Date:        &date,
+	})
+
+	// Then
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), models.Money(2200), result.Amount)
+	assert.Equal(suite.T(), "Coffee and croissant", result.Description)
+	assert.Equal(suite.T(), "2024-05-03", result.Date.Format("2006-01-02"))
+	// Fields not overridden come from the template
+	assert.Equal(suite.T(), "food", result.Category)
+	assert.Equal(suite.T(), "ARS", result.Currency)
+}
+
+func (suite *TemplateServiceTestSuite) TestApplyTemplate_MissingRequiredFields() {
+	// Given - a template without an amount
+	template, err := suite.service.CreateTemplate(context.Background(), &models.TemplateRequest{
+		Name: "groceries", Type: "expense", Description: "Groceries", Category: "food",
+	})
+	suite.Require().NoError(err)
+
+	// When
+	result, err := suite.service.ApplyTemplate(context.Background(), template.ID, nil)
+
+	// Then
+	assert.EqualError(suite.T(), err, "amount must be positive")
+	assert.Nil(suite.T(), result)
+	suite.mockRepo.AssertNotCalled(suite.T(), "Create", mock.Anything, mock.Anything)
+}
+
+func (suite *TemplateServiceTestSuite) TestApplyTemplate_NotFound() {
+	// When
+	result, err := suite.service.ApplyTemplate(context.Background(), 99, nil)
+
+	// Then
+	assert.True(suite.T(), errors.Is(err, models.ErrTemplateNotFound))
+	assert.Nil(suite.T(), result)
+}
+
+func (suite *TemplateServiceTestSuite) TestCreateTemplate_ValidationErrors() {
+	testCases := []struct {
+		name    string
+		request models.TemplateRequest
+		err     string
+	}{
+		{"blank name", models.TemplateRequest{Name: "  "}, "name is required"},
+		{"invalid type", models.TemplateRequest{Name: "x", Type: "transfer"}, "type must be 'expense' or 'income'"},
+		{"negative amount", models.TemplateRequest{Name: "x", Amount: -1}, "amount must be positive"},
+		{"invalid currency", models.TemplateRequest{Name: "x", Currency: "PESOS"}, "currency must be a valid 3-letter ISO code (e.g., USD, ARS, EUR)"},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			// When
+			result, err := suite.service.CreateTemplate(context.Background(), &tc.request)
+
+			// Then
+			assert.EqualError(suite.T(), err, tc.err)
+			assert.Nil(suite.T(), result)
+		})
+	}
+}
+
+func TestTemplateServiceTestSuite(t *testing.T) {
+	suite.Run(t, new(TemplateServiceTestSuite))
+}
@@ -1,37 +1,252 @@
 package services
 
 import (
+	"context"
 	"errors"
+	"fmt"
+	"maps"
+	"slices"
+	"sort"
+	"strings"
 	"time"
 
 	"github.com/maximicciullo/personal-finance-api/internal/middleware"
 	"github.com/maximicciullo/personal-finance-api/internal/models"
 	"github.com/maximicciullo/personal-finance-api/internal/repositories"
+	"github.com/maximicciullo/personal-finance-api/internal/utils"
 	"go.uber.org/zap"
 )
 
+const (
+	DefaultRecentLimit = 10
+	MaxRecentLimit     = 100
+	MaxBatchIDs        = 100
+	MaxBulkUpdateItems = 100
+
+	DefaultTopExpensesLimit = 10
+	MaxTopExpensesLimit     = 100
+
+	MaxMetadataKeys        = 20
+	MaxMetadataKeyLength   = 64
+	MaxMetadataValueLength = 256
+)
+
+// DefaultFarDateWarning is how far a new transaction's date may be from today before
+// the create response carries a warning
+const DefaultFarDateWarning = 365 * 24 * time.Hour
+
+// TransactionServiceConfig holds transaction business rule configuration
+type TransactionServiceConfig struct {
+	DefaultCurrency     string
+	SupportedCurrencies []string                           // Empty means any ISO 4217 code is accepted
+	CategoryAliases     map[string]string                  // Alias -> canonical category, applied before storage
+	ReservedCategories  []string                           // Categories that cannot be recategorized away
+	ExchangeRates       map[string]float64                 // Value of one unit per currency, for base amounts
+	Clock               Clock                              // Dates transactions created without one; nil means RealClock
+	FarDateWarning      time.Duration                      // Creates dated further than this from today get a warning; 0 disables
+	ClosedMonths        repositories.ClosedMonthRepository // Optional; writes dated in a closed month are rejected
+}
+
+// DefaultTransactionServiceConfig returns a default transaction service configuration
+func DefaultTransactionServiceConfig() TransactionServiceConfig {
+	return TransactionServiceConfig{
+		DefaultCurrency:     models.CurrencyARS,
+		SupportedCurrencies: []string{},
+		CategoryAliases:     map[string]string{},
+		ExchangeRates:       map[string]float64{},
+		Clock:               RealClock(),
+		FarDateWarning:      DefaultFarDateWarning,
+	}
+}
+
 type transactionService struct {
-	repo   repositories.TransactionRepository
-	logger *middleware.BusinessLoggerInstance
+	repo      repositories.TransactionRepository
+	auditRepo repositories.AuditRepository
+	config    TransactionServiceConfig
+	converter *CurrencyConverter
+	logger    *middleware.BusinessLoggerInstance
 }
 
 func NewTransactionService(repo repositories.TransactionRepository) TransactionService {
+	return NewTransactionServiceWithConfig(repo, repositories.NewMemoryAuditRepository(), DefaultTransactionServiceConfig())
+}
+
+func NewTransactionServiceWithConfig(repo repositories.TransactionRepository, auditRepo repositories.AuditRepository, config TransactionServiceConfig) TransactionService {
+	if config.Clock == nil {
+		config.Clock = RealClock()
+	}
 	return &transactionService{
-		repo:   repo,
-		logger: middleware.BusinessLogger(),
+		repo:      repo,
+		auditRepo: auditRepo,
+		config:    config,
+		converter: NewCurrencyConverter(config.ExchangeRates),
+		logger:    middleware.BusinessLogger(),
 	}
 }
 
-func (s *transactionService) CreateTransaction(req *models.CreateTransactionRequest) (*models.Transaction, error) {
+func (s *transactionService) CreateTransaction(ctx context.Context, req *models.CreateTransactionRequest) (*models.Transaction, error) {
+	transaction, _, err := s.CreateTransactionWithWarnings(ctx, req)
+	return transaction, err
+}
+
+// CreateTransactionWithWarnings creates a transaction like CreateTransaction and also
+// returns non-blocking warnings about it, such as a date suspiciously far from today
+func (s *transactionService) CreateTransactionWithWarnings(ctx context.Context, req *models.CreateTransactionRequest) (*models.Transaction, []models.Warning, error) {
 	s.logger.Service("CreateTransaction started",
 		zap.String("type", req.Type),
-		zap.Float64("amount", req.Amount),
+		zap.Float64("amount", float64(req.Amount)),
 		zap.String("currency", req.Currency),
 		zap.String("category", req.Category),
 	)
 
 	start := time.Now()
 
+	transaction, err := s.prepareTransaction(req)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if err := s.ensureMonthsOpen(ctx, transaction.Date); err != nil {
+		s.logger.Error("service", "CreateTransaction - month closed", err)
+		return nil, nil, err
+	}
+
+	s.logger.Service("CreateTransaction - calling repository",
+		zap.Any("transaction", transaction),
+	)
+
+	repoStart := time.Now()
+	err = s.repo.Create(ctx, transaction)
+	repoDuration := time.Since(repoStart)
+
+	s.logger.Performance("CreateTransaction repository call", repoDuration,
+		zap.Bool("success", err == nil),
+		zap.Int("transaction_id", transaction.ID),
+	)
+
+	if err != nil {
+		s.logger.Error("service", "CreateTransaction - repository error", err,
+			zap.Any("transaction", transaction),
+		)
+		return nil, nil, err
+	}
+
+	s.recordAudit(ctx, models.AuditActionCreate, transaction.ID, nil, transaction)
+
+	totalDuration := time.Since(start)
+	s.logger.Service("CreateTransaction completed successfully",
+		zap.Int("transaction_id", transaction.ID),
+		zap.Duration("total_duration", totalDuration),
+		zap.Duration("repo_duration", repoDuration),
+	)
+
+	return transaction, s.collectWarnings(transaction), nil
+}
+
+// collectWarnings runs the soft checks on a transaction being created or updated. None
+// of them block the write; each one that fires adds a warning to the response.
+func (s *transactionService) collectWarnings(transaction *models.Transaction) []models.Warning {
+	var warnings []models.Warning
+	for _, check := range []func(*models.Transaction) *models.Warning{
+		s.farDateWarning,
+	} {
+		if warning := check(transaction); warning != nil {
+			warnings = append(warnings, *warning)
+		}
+	}
+
+	if len(warnings) > 0 {
+		s.logger.Service("Transaction warnings collected",
+			zap.Int("transaction_id", transaction.ID),
+			zap.Any("warnings", warnings),
+		)
+	}
+	return warnings
+}
+
+// farDateWarning flags a date further than FarDateWarning from when the transaction was
+// created, which is usually a typo in the year. Previews are not stored yet and are
+// measured from the configured clock's now.
+func (s *transactionService) farDateWarning(transaction *models.Transaction) *models.Warning {
+	if s.config.FarDateWarning <= 0 {
+		return nil
+	}
+
+	reference := transaction.CreatedAt
+	if reference.IsZero() {
+		reference = s.config.Clock.Now()
+	}
+	gap := transaction.Date.Sub(reference)
+	if gap < 0 {
+		gap = -gap
+	}
+	if gap <= s.config.FarDateWarning {
+		return nil
+	}
+
+	return &models.Warning{
+		Code: models.WarningFarDate,
+		Message: fmt.Sprintf("date %s is %d days from the creation date, check it is not a typo",
+			transaction.Date.Format("2006-01-02"), int(gap.Hours()/24)),
+	}
+}
+
+func (s *transactionService) PreviewCreateTransaction(ctx context.Context, req *models.CreateTransactionRequest) (*models.Transaction, []models.Warning, error) {
+	s.logger.Service("PreviewCreateTransaction started",
+		zap.String("type", req.Type),
+		zap.Float64("amount", float64(req.Amount)),
+		zap.String("currency", req.Currency),
+		zap.String("category", req.Category),
+	)
+
+	transaction, err := s.prepareTransaction(req)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if err := s.ensureMonthsOpen(ctx, transaction.Date); err != nil {
+		s.logger.Error("service", "PreviewCreateTransaction - month closed", err)
+		return nil, nil, err
+	}
+
+	s.logger.Service("PreviewCreateTransaction completed successfully",
+		zap.Any("transaction", transaction),
+	)
+
+	return transaction, s.collectWarnings(transaction), nil
+}
+
+// ValidateCreate runs the create validation alone, without storing anything or checking
+// closed months, and returns the message for each invalid field. An empty map means the
+// payload would be accepted.
+func (s *transactionService) ValidateCreate(ctx context.Context, req *models.CreateTransactionRequest) map[string]string {
+	s.logger.Service("ValidateCreate started",
+		zap.String("type", req.Type),
+		zap.String("category", req.Category),
+	)
+
+	fieldErrors := make(map[string]string)
+	for _, problem := range s.createRequestProblems(req) {
+		fieldErrors[problem.field] = problem.err.Error()
+	}
+
+	if req.Date != nil {
+		if _, err := time.Parse("2006-01-02", *req.Date); err != nil {
+			fieldErrors["date"] = "invalid date format, use YYYY-MM-DD"
+		}
+	}
+
+	s.logger.Service("ValidateCreate completed successfully",
+		zap.Int("error_count", len(fieldErrors)),
+	)
+
+	return fieldErrors
+}
+
+// prepareTransaction validates a create request and builds the transaction to be stored
+func (s *transactionService) prepareTransaction(req *models.CreateTransactionRequest) (*models.Transaction, error) {
+	start := time.Now()
+
 	// Validate request
 	if err := s.validateCreateRequest(req); err != nil {
 		s.logger.Error("service", "CreateTransaction - validation failed", err,
@@ -59,7 +274,7 @@ func (s *transactionService) CreateTransaction(req *models.CreateTransactionRequ
 			zap.Time("transaction_date", transactionDate),
 		)
 	} else {
-		transactionDate = time.Now()
+		transactionDate = s.config.Clock.Now()
 		s.logger.Service("CreateTransaction - using current date",
 			zap.Time("transaction_date", transactionDate),
 		)
@@ -68,185 +283,798 @@ func (s *transactionService) CreateTransaction(req *models.CreateTransactionRequ
 	// Set default currency if not provided
 	currency := req.Currency
 	if currency == "" {
-		currency = models.CurrencyARS
+		currency = s.config.DefaultCurrency
 		s.logger.Service("CreateTransaction - using default currency",
 			zap.String("default_currency", currency),
 		)
 	}
 
+	amount := models.Money(req.Amount)
+
+	// Store the amount in the requested base currency too
+	var baseAmount models.Money
+	baseCurrency := strings.ToUpper(req.BaseCurrency)
+	if baseCurrency == currency {
+		baseCurrency = ""
+	}
+	if baseCurrency != "" {
+		var err error
+		baseAmount, err = s.converter.Convert(amount, currency, baseCurrency)
+		if err != nil {
+			s.logger.Error("service", "CreateTransaction - base amount conversion failed", err,
+				zap.String("currency", currency),
+				zap.String("base_currency", baseCurrency),
+			)
+			return nil, err
+		}
+		s.logger.Service("CreateTransaction - base amount computed",
+			zap.String("base_currency", baseCurrency),
+			zap.Float64("base_amount", float64(baseAmount)),
+		)
+	}
+
+	status := req.Status
+	if status == "" {
+		status = models.TransactionStatusCleared
+	}
+
 	// Create transaction
 	transaction := &models.Transaction{
-		Type:        req.Type,
-		Amount:      req.Amount,
-		Currency:    currency,
-		Description: req.Description,
-		Category:    req.Category,
-		Date:        transactionDate,
+		Type:         req.Type,
+		Amount:       amount,
+		Currency:     currency,
+		BaseAmount:   baseAmount,
+		BaseCurrency: baseCurrency,
+		Description:  req.Description,
+		Category:     s.canonicalCategory(req.Category),
+		Account:      req.Account,
+		Pinned:       req.Pinned,
+		Status:       status,
+		Tags:         slices.Clone(req.Tags),
+		Metadata:     maps.Clone(req.Metadata),
+		Date:         transactionDate,
 	}
 
-	s.logger.Service("CreateTransaction - calling repository",
-		zap.Any("transaction", transaction),
+	return transaction, nil
+}
+
+func (s *transactionService) GetTransaction(ctx context.Context, id int) (*models.Transaction, error) {
+	s.logger.Service("GetTransaction started",
+		zap.Int("transaction_id", id),
 	)
 
-	repoStart := time.Now()
-	err := s.repo.Create(transaction)
-	repoDuration := time.Since(repoStart)
+	if id <= 0 {
+		err := errors.New("invalid transaction ID")
+		s.logger.Error("service", "GetTransaction - invalid ID", err,
+			zap.Int("transaction_id", id),
+		)
+		return nil, err
+	}
 
-	s.logger.Performance("CreateTransaction repository call", repoDuration,
+	start := time.Now()
+	transaction, err := s.repo.GetByID(ctx, id)
+	duration := time.Since(start)
+
+	s.logger.Performance("GetTransaction repository call", duration,
+		zap.Int("transaction_id", id),
 		zap.Bool("success", err == nil),
-		zap.Int("transaction_id", transaction.ID),
 	)
 
 	if err != nil {
-		s.logger.Error("service", "CreateTransaction - repository error", err,
-			zap.Any("transaction", transaction),
+		s.logger.Error("service", "GetTransaction - repository error", err,
+			zap.Int("transaction_id", id),
 		)
 		return nil, err
 	}
 
-	totalDuration := time.Since(start)
-	s.logger.Service("CreateTransaction completed successfully",
+	s.logger.Service("GetTransaction completed successfully",
+		zap.Int("transaction_id", id),
+		zap.Duration("duration", duration),
+	)
+
+	return transaction, nil
+}
+
+func (s *transactionService) GetTransactions(ctx context.Context, filters models.TransactionFilters) ([]models.Transaction, error) {
+	s.logger.Service("GetTransactions started",
+		zap.String("type_filter", filters.Type),
+		zap.String("category_filter", filters.Category),
+		zap.String("currency_filter", filters.Currency),
+		zap.String("account_filter", filters.Account),
+	)
+
+	start := time.Now()
+	transactions, err := s.repo.GetByFilters(ctx, filters)
+	duration := time.Since(start)
+
+	s.logger.Performance("GetTransactions repository call", duration,
+		zap.Int("transaction_count", len(transactions)),
+		zap.Bool("success", err == nil),
+	)
+
+	if err != nil {
+		s.logger.Error("service", "GetTransactions - repository error", err,
+			zap.Any("filters", filters),
+		)
+		return nil, err
+	}
+
+	s.logger.Service("GetTransactions completed successfully",
+		zap.Int("transaction_count", len(transactions)),
+		zap.Duration("duration", duration),
+	)
+
+	return transactions, nil
+}
+
+// GetLedger returns the matching transactions oldest first, each with the running
+// balance up to and including it. The balance is accumulated over every match before
+// Limit and Offset are applied, so a page continues from the previous one. Mixing
+// currencies would make the sum meaningless, so a currency filter is required.
+func (s *transactionService) GetLedger(ctx context.Context, filters models.TransactionFilters) ([]models.LedgerEntry, error) {
+	s.logger.Service("GetLedger started",
+		zap.String("currency_filter", filters.Currency),
+		zap.Int("limit", filters.Limit),
+		zap.Int("offset", filters.Offset),
+	)
+
+	if filters.Currency == "" {
+		s.logger.Error("service", "GetLedger - missing currency filter", models.ErrCurrencyRequired)
+		return nil, models.ErrCurrencyRequired
+	}
+
+	unpaged := filters
+	unpaged.Limit = 0
+	unpaged.Offset = 0
+
+	start := time.Now()
+	transactions, err := s.repo.GetByFilters(ctx, unpaged)
+	duration := time.Since(start)
+
+	s.logger.Performance("GetLedger repository call", duration,
+		zap.Int("transaction_count", len(transactions)),
+		zap.Bool("success", err == nil),
+	)
+
+	if err != nil {
+		s.logger.Error("service", "GetLedger - repository error", err,
+			zap.Any("filters", filters),
+		)
+		return nil, err
+	}
+
+	sort.Slice(transactions, func(i, j int) bool {
+		if !transactions[i].Date.Equal(transactions[j].Date) {
+			return transactions[i].Date.Before(transactions[j].Date)
+		}
+		return transactions[i].ID < transactions[j].ID
+	})
+
+	entries := make([]models.LedgerEntry, len(transactions))
+	var balance models.Money
+	for i, transaction := range transactions {
+		if transaction.Type == models.TransactionTypeIncome {
+			balance += transaction.Amount
+		} else {
+			balance -= transaction.Amount
+		}
+		entries[i] = models.LedgerEntry{Transaction: transaction, RunningBalance: balance}
+	}
+
+	if filters.Offset > 0 {
+		if filters.Offset >= len(entries) {
+			entries = entries[:0]
+		} else {
+			entries = entries[filters.Offset:]
+		}
+	}
+	if filters.Limit > 0 && len(entries) > filters.Limit {
+		entries = entries[:filters.Limit]
+	}
+
+	s.logger.Service("GetLedger completed successfully",
+		zap.Int("entry_count", len(entries)),
+		zap.Duration("duration", duration),
+	)
+
+	return entries, nil
+}
+
+// StreamTransactions streams every stored transaction for exports
+func (s *transactionService) StreamTransactions(ctx context.Context) (<-chan models.Transaction, <-chan error) {
+	s.logger.Service("StreamTransactions started")
+	return s.repo.StreamAll(ctx)
+}
+
+// GetTransactionsByIDs looks up each distinct id once. Found transactions and ids
+// reported in MissingIDs both follow the order each id first appears in the request.
+func (s *transactionService) GetTransactionsByIDs(ctx context.Context, ids []int) (*models.BatchTransactionsResponse, error) {
+	s.logger.Service("GetTransactionsByIDs started",
+		zap.Int("requested_count", len(ids)),
+	)
+
+	uniqueIDs := make([]int, 0, len(ids))
+	seen := make(map[int]bool, len(ids))
+	for _, id := range ids {
+		if !seen[id] {
+			seen[id] = true
+			uniqueIDs = append(uniqueIDs, id)
+		}
+	}
+
+	if len(uniqueIDs) == 0 {
+		err := errors.New("at least one id is required")
+		s.logger.Error("service", "GetTransactionsByIDs - no ids", err)
+		return nil, err
+	}
+
+	if len(uniqueIDs) > MaxBatchIDs {
+		err := fmt.Errorf("at most %d ids can be requested at once", MaxBatchIDs)
+		s.logger.Error("service", "GetTransactionsByIDs - too many ids", err,
+			zap.Int("requested_count", len(uniqueIDs)),
+		)
+		return nil, err
+	}
+
+	start := time.Now()
+	transactions, err := s.repo.GetByIDs(ctx, uniqueIDs)
+	duration := time.Since(start)
+
+	s.logger.Performance("GetTransactionsByIDs repository call", duration,
+		zap.Int("requested_count", len(uniqueIDs)),
+		zap.Int("found_count", len(transactions)),
+		zap.Bool("success", err == nil),
+	)
+
+	if err != nil {
+		s.logger.Error("service", "GetTransactionsByIDs - repository error", err)
+		return nil, err
+	}
+
+	found := make(map[int]bool, len(transactions))
+	for _, transaction := range transactions {
+		found[transaction.ID] = true
+	}
+
+	missingIDs := make([]int, 0)
+	for _, id := range uniqueIDs {
+		if !found[id] {
+			missingIDs = append(missingIDs, id)
+		}
+	}
+
+	s.logger.Service("GetTransactionsByIDs completed successfully",
+		zap.Int("found_count", len(transactions)),
+		zap.Int("missing_count", len(missingIDs)),
+		zap.Duration("duration", duration),
+	)
+
+	return &models.BatchTransactionsResponse{
+		Transactions: transactions,
+		MissingIDs:   missingIDs,
+	}, nil
+}
+
+// RecentLimit is the limit GetRecentTransactions applies for a requested one: a
+// non-positive limit falls back to DefaultRecentLimit and larger ones are capped at
+// MaxRecentLimit
+func RecentLimit(limit int) int {
+	if limit <= 0 {
+		return DefaultRecentLimit
+	}
+	if limit > MaxRecentLimit {
+		return MaxRecentLimit
+	}
+	return limit
+}
+
+// GetRecentTransactions returns the newest transactions by date, up to RecentLimit(limit)
+func (s *transactionService) GetRecentTransactions(ctx context.Context, limit int) ([]models.Transaction, error) {
+	s.logger.Service("GetRecentTransactions started",
+		zap.Int("requested_limit", limit),
+	)
+
+	limit = RecentLimit(limit)
+
+	start := time.Now()
+	transactions, err := s.repo.GetRecent(ctx, limit)
+	duration := time.Since(start)
+
+	s.logger.Performance("GetRecentTransactions repository call", duration,
+		zap.Int("limit", limit),
+		zap.Int("transaction_count", len(transactions)),
+		zap.Bool("success", err == nil),
+	)
+
+	if err != nil {
+		s.logger.Error("service", "GetRecentTransactions - repository error", err,
+			zap.Int("limit", limit),
+		)
+		return nil, err
+	}
+
+	s.logger.Service("GetRecentTransactions completed successfully",
+		zap.Int("transaction_count", len(transactions)),
+		zap.Duration("duration", duration),
+	)
+
+	return transactions, nil
+}
+
+// GetTransactionsByDay returns the month's transactions keyed by their UTC date as
+// YYYY-MM-DD, oldest first within each day. Days without transactions are absent.
+func (s *transactionService) GetTransactionsByDay(ctx context.Context, year, month int) (map[string][]models.Transaction, error) {
+	s.logger.Service("GetTransactionsByDay started",
+		zap.Int("year", year),
+		zap.Int("month", month),
+	)
+
+	if year < 1900 || year > s.config.Clock.Now().Year()+10 {
+		err := errors.New("invalid year")
+		s.logger.Error("service", "GetTransactionsByDay - invalid year", err,
+			zap.Int("year", year),
+		)
+		return nil, err
+	}
+
+	if month < 1 || month > 12 {
+		err := errors.New("month must be between 1 and 12")
+		s.logger.Error("service", "GetTransactionsByDay - invalid month", err,
+			zap.Int("month", month),
+		)
+		return nil, err
+	}
+
+	// Same UTC month range as the monthly report, so the calendar matches its totals
+	startDate := time.Date(year, time.Month(month), 1, 0, 0, 0, 0, time.UTC)
+	endDate := startDate.AddDate(0, 1, 0).Add(-time.Nanosecond)
+
+	start := time.Now()
+	transactions, err := s.repo.GetByDateRange(ctx, startDate, endDate)
+	duration := time.Since(start)
+
+	s.logger.Performance("GetTransactionsByDay repository call", duration,
+		zap.Int("transaction_count", len(transactions)),
+		zap.Bool("success", err == nil),
+	)
+
+	if err != nil {
+		s.logger.Error("service", "GetTransactionsByDay - repository error", err,
+			zap.Int("year", year),
+			zap.Int("month", month),
+		)
+		return nil, err
+	}
+
+	sort.Slice(transactions, func(i, j int) bool {
+		if !transactions[i].Date.Equal(transactions[j].Date) {
+			return transactions[i].Date.Before(transactions[j].Date)
+		}
+		return transactions[i].ID < transactions[j].ID
+	})
+
+	days := make(map[string][]models.Transaction)
+	for _, transaction := range transactions {
+		day := transaction.Date.UTC().Format("2006-01-02")
+		days[day] = append(days[day], transaction)
+	}
+
+	s.logger.Service("GetTransactionsByDay completed successfully",
+		zap.Int("transaction_count", len(transactions)),
+		zap.Int("day_count", len(days)),
+		zap.Duration("duration", duration),
+	)
+
+	return days, nil
+}
+
+// TopExpensesLimit is the limit GetTopExpenses applies for a requested one: a
+// non-positive limit falls back to DefaultTopExpensesLimit and larger ones are capped
+// at MaxTopExpensesLimit
+func TopExpensesLimit(limit int) int {
+	if limit <= 0 {
+		return DefaultTopExpensesLimit
+	}
+	if limit > MaxTopExpensesLimit {
+		return MaxTopExpensesLimit
+	}
+	return limit
+}
+
+// GetTopExpenses returns the largest expenses in one currency, largest first, up to
+// TopExpensesLimit(query.Limit)
+func (s *transactionService) GetTopExpenses(ctx context.Context, query models.TopExpensesQuery) ([]models.Transaction, error) {
+	s.logger.Service("GetTopExpenses started",
+		zap.String("currency", query.Currency),
+		zap.Int("requested_limit", query.Limit),
+	)
+
+	if query.Currency == "" {
+		s.logger.Error("service", "GetTopExpenses - missing currency", models.ErrTopExpensesCurrency)
+		return nil, models.ErrTopExpensesCurrency
+	}
+
+	query.Limit = TopExpensesLimit(query.Limit)
+
+	start := time.Now()
+	transactions, err := s.repo.GetTopExpenses(ctx, query)
+	duration := time.Since(start)
+
+	s.logger.Performance("GetTopExpenses repository call", duration,
+		zap.Int("limit", query.Limit),
+		zap.Int("transaction_count", len(transactions)),
+		zap.Bool("success", err == nil),
+	)
+
+	if err != nil {
+		s.logger.Error("service", "GetTopExpenses - repository error", err,
+			zap.String("currency", query.Currency),
+		)
+		return nil, err
+	}
+
+	s.logger.Service("GetTopExpenses completed successfully",
+		zap.Int("transaction_count", len(transactions)),
+		zap.Duration("duration", duration),
+	)
+
+	return transactions, nil
+}
+
+// SetPinned pins or unpins a transaction. It goes through UpdateTransaction so the
+// change is audited and pinning an already pinned transaction is a no-op.
+func (s *transactionService) SetPinned(ctx context.Context, id int, pinned bool) (*models.Transaction, error) {
+	s.logger.Service("SetPinned started",
+		zap.Int("transaction_id", id),
+		zap.Bool("pinned", pinned),
+	)
+
+	return s.UpdateTransaction(ctx, id, &models.UpdateTransactionRequest{Pinned: &pinned})
+}
+
+// CloneTransaction creates a new transaction with every field of an existing one, with
+// any non-nil override taking precedence. The clone is dated today unless the
+// overrides set a date. Warnings are those of the created transaction.
+func (s *transactionService) CloneTransaction(ctx context.Context, id int, overrides *models.UpdateTransactionRequest) (*models.Transaction, []models.Warning, error) {
+	s.logger.Service("CloneTransaction started",
+		zap.Int("source_id", id),
+	)
+
+	source, err := s.GetTransaction(ctx, id)
+	if err != nil {
+		s.logger.Error("service", "CloneTransaction - source lookup failed", err,
+			zap.Int("source_id", id),
+		)
+		return nil, nil, err
+	}
+
+	req := &models.CreateTransactionRequest{
+		Type:         source.Type,
+		Amount:       float64(source.Amount),
+		Currency:     source.Currency,
+		BaseCurrency: source.BaseCurrency,
+		Description:  source.Description,
+		Category:     source.Category,
+		Account:      source.Account,
+		Pinned:       source.Pinned,
+		Status:       source.Status,
+		Tags:         source.Tags,
+		Metadata:     source.Metadata,
+	}
+	if overrides != nil {
+		applyOverrides(req, overrides)
+	}
+
+	transaction, warnings, err := s.CreateTransactionWithWarnings(ctx, req)
+	if err != nil {
+		s.logger.Error("service", "CloneTransaction - transaction creation failed", err,
+			zap.Int("source_id", id),
+		)
+		return nil, nil, err
+	}
+
+	s.logger.Service("CloneTransaction completed successfully",
+		zap.Int("source_id", id),
 		zap.Int("transaction_id", transaction.ID),
-		zap.Duration("total_duration", totalDuration),
-		zap.Duration("repo_duration", repoDuration),
 	)
 
-	return transaction, nil
+	return transaction, warnings, nil
+}
+
+// GetTransactionHistory returns the audit trail of a transaction, oldest first.
+// History remains available after the transaction is deleted.
+func (s *transactionService) GetTransactionHistory(ctx context.Context, id int) ([]models.AuditEntry, error) {
+	s.logger.Service("GetTransactionHistory started",
+		zap.Int("transaction_id", id),
+	)
+
+	if id <= 0 {
+		err := errors.New("invalid transaction ID")
+		s.logger.Error("service", "GetTransactionHistory - invalid ID", err,
+			zap.Int("transaction_id", id),
+		)
+		return nil, err
+	}
+
+	start := time.Now()
+	entries, err := s.auditRepo.GetByTransactionID(ctx, id)
+	duration := time.Since(start)
+
+	s.logger.Performance("GetTransactionHistory repository call", duration,
+		zap.Int("transaction_id", id),
+		zap.Int("entry_count", len(entries)),
+		zap.Bool("success", err == nil),
+	)
+
+	if err != nil {
+		s.logger.Error("service", "GetTransactionHistory - repository error", err,
+			zap.Int("transaction_id", id),
+		)
+		return nil, err
+	}
+
+	if len(entries) == 0 {
+		s.logger.Service("GetTransactionHistory - no history found",
+			zap.Int("transaction_id", id),
+		)
+		return nil, models.ErrTransactionNotFound
+	}
+
+	s.logger.Service("GetTransactionHistory completed successfully",
+		zap.Int("transaction_id", id),
+		zap.Int("entry_count", len(entries)),
+		zap.Duration("duration", duration),
+	)
+
+	return entries, nil
 }
 
-func (s *transactionService) GetTransaction(id int) (*models.Transaction, error) {
-	s.logger.Service("GetTransaction started",
+func (s *transactionService) DeleteTransaction(ctx context.Context, id int) error {
+	s.logger.Service("DeleteTransaction started",
 		zap.Int("transaction_id", id),
 	)
 
 	if id <= 0 {
 		err := errors.New("invalid transaction ID")
-		s.logger.Error("service", "GetTransaction - invalid ID", err,
+		s.logger.Error("service", "DeleteTransaction - invalid ID", err,
 			zap.Int("transaction_id", id),
 		)
-		return nil, err
+		return err
 	}
 
 	start := time.Now()
-	transaction, err := s.repo.GetByID(id)
+
+	existingTransaction, err := s.repo.GetByID(ctx, id)
+	if err != nil {
+		s.logger.Error("service", "DeleteTransaction - transaction not found", err,
+			zap.Int("transaction_id", id),
+		)
+		return err
+	}
+
+	if err := s.ensureMonthsOpen(ctx, existingTransaction.Date); err != nil {
+		s.logger.Error("service", "DeleteTransaction - month closed", err,
+			zap.Int("transaction_id", id),
+		)
+		return err
+	}
+
+	err = s.repo.Delete(ctx, id)
 	duration := time.Since(start)
 
-	s.logger.Performance("GetTransaction repository call", duration,
+	s.logger.Performance("DeleteTransaction repository call", duration,
 		zap.Int("transaction_id", id),
 		zap.Bool("success", err == nil),
 	)
 
 	if err != nil {
-		s.logger.Error("service", "GetTransaction - repository error", err,
+		s.logger.Error("service", "DeleteTransaction - repository error", err,
 			zap.Int("transaction_id", id),
 		)
-		return nil, err
+		return err
 	}
 
-	s.logger.Service("GetTransaction completed successfully",
+	s.recordAudit(ctx, models.AuditActionDelete, id, existingTransaction, nil)
+
+	s.logger.Service("DeleteTransaction completed successfully",
 		zap.Int("transaction_id", id),
 		zap.Duration("duration", duration),
 	)
 
-	return transaction, nil
+	return nil
 }
 
-func (s *transactionService) GetTransactions(filters models.TransactionFilters) ([]models.Transaction, error) {
-	s.logger.Service("GetTransactions started",
-		zap.String("type_filter", filters.Type),
-		zap.String("category_filter", filters.Category),
-		zap.String("currency_filter", filters.Currency),
+// RecategorizeTransactions moves every transaction in req.From matching the optional
+// filters to req.To. Each moved transaction gets an update entry in its audit trail.
+func (s *transactionService) RecategorizeTransactions(ctx context.Context, req *models.RecategorizeRequest) (*models.RecategorizeResult, error) {
+	s.logger.Service("RecategorizeTransactions started",
+		zap.String("from", req.From),
+		zap.String("to", req.To),
 	)
 
+	to := s.canonicalCategory(req.To)
+	if to == req.From {
+		err := errors.New("from and to must be different categories")
+		s.logger.Error("service", "RecategorizeTransactions - validation failed", err,
+			zap.String("from", req.From),
+			zap.String("to", to),
+		)
+		return nil, err
+	}
+
+	if slices.Contains(s.config.ReservedCategories, req.From) {
+		err := fmt.Errorf("%w: %s", models.ErrReservedCategory, req.From)
+		s.logger.Error("service", "RecategorizeTransactions - reserved category", err,
+			zap.String("from", req.From),
+		)
+		return nil, err
+	}
+
+	filters := models.TransactionFilters{
+		Category: req.From,
+		Type:     req.Filters.Type,
+		Currency: strings.ToUpper(req.Filters.Currency),
+		Account:  req.Filters.Account,
+		Status:   req.Filters.Status,
+	}
+	var err error
+	if filters.FromDate, err = parseFilterDate(req.Filters.FromDate, "from_date"); err != nil {
+		s.logger.Error("service", "RecategorizeTransactions - invalid from_date", err)
+		return nil, err
+	}
+	if filters.ToDate, err = parseFilterDate(req.Filters.ToDate, "to_date"); err != nil {
+		s.logger.Error("service", "RecategorizeTransactions - invalid to_date", err)
+		return nil, err
+	}
+
+	if s.config.ClosedMonths != nil {
+		matching, err := s.repo.GetByFilters(ctx, filters)
+		if err != nil {
+			s.logger.Error("service", "RecategorizeTransactions - repository error", err)
+			return nil, err
+		}
+		for _, transaction := range matching {
+			if err := s.ensureMonthsOpen(ctx, transaction.Date); err != nil {
+				s.logger.Error("service", "RecategorizeTransactions - month closed", err,
+					zap.Int("transaction_id", transaction.ID),
+				)
+				return nil, err
+			}
+		}
+	}
+
 	start := time.Now()
-	transactions, err := s.repo.GetByFilters(filters)
+	changes, err := s.repo.Recategorize(ctx, filters, to)
 	duration := time.Since(start)
 
-	s.logger.Performance("GetTransactions repository call", duration,
-		zap.Int("transaction_count", len(transactions)),
+	s.logger.Performance("RecategorizeTransactions repository call", duration,
+		zap.Int("updated_count", len(changes)),
 		zap.Bool("success", err == nil),
 	)
 
 	if err != nil {
-		s.logger.Error("service", "GetTransactions - repository error", err,
-			zap.Any("filters", filters),
-		)
+		s.logger.Error("service", "RecategorizeTransactions - repository error", err)
 		return nil, err
 	}
 
-	s.logger.Service("GetTransactions completed successfully",
-		zap.Int("transaction_count", len(transactions)),
+	for i := range changes {
+		s.recordAudit(ctx, models.AuditActionUpdate, changes[i].After.ID, &changes[i].Before, &changes[i].After)
+	}
+
+	s.logger.Service("RecategorizeTransactions completed successfully",
+		zap.Int("updated_count", len(changes)),
 		zap.Duration("duration", duration),
 	)
 
-	return transactions, nil
+	return &models.RecategorizeResult{
+		From:    req.From,
+		To:      to,
+		Updated: len(changes),
+	}, nil
 }
 
-func (s *transactionService) DeleteTransaction(id int) error {
-	s.logger.Service("DeleteTransaction started",
+func (s *transactionService) UpdateTransaction(ctx context.Context, id int, req *models.UpdateTransactionRequest) (*models.Transaction, error) {
+	transaction, _, err := s.UpdateTransactionWithWarnings(ctx, id, req)
+	return transaction, err
+}
+
+// UpdateTransactionWithWarnings updates a transaction like UpdateTransaction and also
+// returns non-blocking warnings about the result
+func (s *transactionService) UpdateTransactionWithWarnings(ctx context.Context, id int, req *models.UpdateTransactionRequest) (*models.Transaction, []models.Warning, error) {
+	s.logger.Service("UpdateTransaction started",
 		zap.Int("transaction_id", id),
 	)
 
-	if id <= 0 {
-		err := errors.New("invalid transaction ID")
-		s.logger.Error("service", "DeleteTransaction - invalid ID", err,
+	start := time.Now()
+
+	existingTransaction, updatedTransaction, err := s.prepareUpdate(ctx, id, req)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if updatedTransaction.Equal(*existingTransaction) {
+		s.logger.Service("UpdateTransaction - no changes detected, skipping repository write",
 			zap.Int("transaction_id", id),
+			zap.Duration("total_duration", time.Since(start)),
 		)
-		return err
+		return existingTransaction, s.collectWarnings(existingTransaction), nil
 	}
 
-	start := time.Now()
-	err := s.repo.Delete(id)
-	duration := time.Since(start)
-
-	s.logger.Performance("DeleteTransaction repository call", duration,
+	s.logger.Service("UpdateTransaction - calling repository",
 		zap.Int("transaction_id", id),
+		zap.Any("updated_transaction", updatedTransaction),
+	)
+
+	repoStart := time.Now()
+	err = s.repo.Update(ctx, updatedTransaction)
+	repoDuration := time.Since(repoStart)
+
+	s.logger.Performance("UpdateTransaction repository call", repoDuration,
 		zap.Bool("success", err == nil),
+		zap.Int("transaction_id", id),
 	)
 
 	if err != nil {
-		s.logger.Error("service", "DeleteTransaction - repository error", err,
+		s.logger.Error("service", "UpdateTransaction - repository error", err,
 			zap.Int("transaction_id", id),
 		)
-		return err
+		return nil, nil, err
 	}
 
-	s.logger.Service("DeleteTransaction completed successfully",
+	s.recordAudit(ctx, models.AuditActionUpdate, id, existingTransaction, updatedTransaction)
+
+	totalDuration := time.Since(start)
+	s.logger.Service("UpdateTransaction completed successfully",
 		zap.Int("transaction_id", id),
-		zap.Duration("duration", duration),
+		zap.Duration("total_duration", totalDuration),
+		zap.Duration("repo_duration", repoDuration),
 	)
 
-	return nil
+	return updatedTransaction, s.collectWarnings(updatedTransaction), nil
 }
 
-func (s *transactionService) UpdateTransaction(id int, req *models.UpdateTransactionRequest) (*models.Transaction, error) {
-	s.logger.Service("UpdateTransaction started",
+func (s *transactionService) PreviewUpdateTransaction(ctx context.Context, id int, req *models.UpdateTransactionRequest) (*models.Transaction, []models.Warning, error) {
+	s.logger.Service("PreviewUpdateTransaction started",
+		zap.Int("transaction_id", id),
+	)
+
+	_, updatedTransaction, err := s.prepareUpdate(ctx, id, req)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	s.logger.Service("PreviewUpdateTransaction completed successfully",
 		zap.Int("transaction_id", id),
+		zap.Any("updated_transaction", updatedTransaction),
 	)
 
+	return updatedTransaction, s.collectWarnings(updatedTransaction), nil
+}
+
+// prepareUpdate loads the stored transaction, validates the patch and returns both
+// the existing record and the merged result that would be stored
+func (s *transactionService) prepareUpdate(ctx context.Context, id int, req *models.UpdateTransactionRequest) (*models.Transaction, *models.Transaction, error) {
 	if id <= 0 {
 		err := errors.New("invalid transaction ID")
 		s.logger.Error("service", "UpdateTransaction - invalid ID", err,
 			zap.Int("transaction_id", id),
 		)
-		return nil, err
+		return nil, nil, err
 	}
 
-	start := time.Now()
-
 	// Get existing transaction
-	existingTransaction, err := s.repo.GetByID(id)
+	existingTransaction, err := s.repo.GetByID(ctx, id)
 	if err != nil {
 		s.logger.Error("service", "UpdateTransaction - transaction not found", err,
 			zap.Int("transaction_id", id),
 		)
-		return nil, err
+		return nil, nil, err
 	}
 
 	s.logger.Service("UpdateTransaction - existing transaction found",
 		zap.Int("transaction_id", id),
 		zap.String("current_type", existingTransaction.Type),
-		zap.Float64("current_amount", existingTransaction.Amount),
+		zap.Float64("current_amount", float64(existingTransaction.Amount)),
 	)
 
 	// Validate update request
@@ -254,7 +1082,7 @@ func (s *transactionService) UpdateTransaction(id int, req *models.UpdateTransac
 		s.logger.Error("service", "UpdateTransaction - validation failed", err,
 			zap.Any("request", req),
 		)
-		return nil, err
+		return nil, nil, err
 	}
 
 	// Create updated transaction with merged values
@@ -270,9 +1098,9 @@ func (s *transactionService) UpdateTransaction(id int, req *models.UpdateTransac
 	}
 
 	if req.Amount != nil {
-		updatedTransaction.Amount = *req.Amount
+		updatedTransaction.Amount = models.Money(*req.Amount)
 		s.logger.Service("UpdateTransaction - updating amount",
-			zap.Float64("old_amount", existingTransaction.Amount),
+			zap.Float64("old_amount", float64(existingTransaction.Amount)),
 			zap.Float64("new_amount", *req.Amount),
 		)
 	}
@@ -291,20 +1119,63 @@ func (s *transactionService) UpdateTransaction(id int, req *models.UpdateTransac
 	}
 
 	if req.Category != nil {
-		updatedTransaction.Category = *req.Category
+		updatedTransaction.Category = s.canonicalCategory(*req.Category)
 		s.logger.Service("UpdateTransaction - updating category",
 			zap.String("old_category", existingTransaction.Category),
 			zap.String("new_category", *req.Category),
 		)
 	}
 
+	if req.Account != nil {
+		updatedTransaction.Account = *req.Account
+		s.logger.Service("UpdateTransaction - updating account",
+			zap.String("old_account", existingTransaction.Account),
+			zap.String("new_account", *req.Account),
+		)
+	}
+
+	if req.Pinned != nil {
+		updatedTransaction.Pinned = *req.Pinned
+		s.logger.Service("UpdateTransaction - updating pinned",
+			zap.Bool("old_pinned", existingTransaction.Pinned),
+			zap.Bool("new_pinned", *req.Pinned),
+		)
+	}
+
+	if req.Status != nil {
+		updatedTransaction.Status = *req.Status
+		s.logger.Service("UpdateTransaction - updating status",
+			zap.String("old_status", existingTransaction.Status),
+			zap.String("new_status", *req.Status),
+		)
+	}
+
+	if req.Tags != nil {
+		updatedTransaction.Tags = slices.Clone(*req.Tags)
+		s.logger.Service("UpdateTransaction - updating tags",
+			zap.Strings("old_tags", existingTransaction.Tags),
+			zap.Strings("new_tags", *req.Tags),
+		)
+	}
+
+	if req.Metadata != nil {
+		updatedTransaction.Metadata = maps.Clone(*req.Metadata)
+		if len(updatedTransaction.Metadata) == 0 {
+			updatedTransaction.Metadata = nil
+		}
+		s.logger.Service("UpdateTransaction - updating metadata",
+			zap.Int("old_keys", len(existingTransaction.Metadata)),
+			zap.Int("new_keys", len(*req.Metadata)),
+		)
+	}
+
 	if req.Date != nil {
 		transactionDate, err := time.Parse("2006-01-02", *req.Date)
 		if err != nil {
 			s.logger.Error("service", "UpdateTransaction - date parsing failed", err,
 				zap.String("date_string", *req.Date),
 			)
-			return nil, errors.New("invalid date format, use YYYY-MM-DD")
+			return nil, nil, errors.New("invalid date format, use YYYY-MM-DD")
 		}
 		updatedTransaction.Date = transactionDate
 		s.logger.Service("UpdateTransaction - updating date",
@@ -313,35 +1184,139 @@ func (s *transactionService) UpdateTransaction(id int, req *models.UpdateTransac
 		)
 	}
 
-	s.logger.Service("UpdateTransaction - calling repository",
-		zap.Int("transaction_id", id),
-		zap.Any("updated_transaction", updatedTransaction),
-	)
+	// Keep a stored base amount in step with the amount it was derived from
+	if updatedTransaction.BaseCurrency != "" &&
+		(updatedTransaction.Amount != existingTransaction.Amount || updatedTransaction.Currency != existingTransaction.Currency) {
+		if updatedTransaction.Currency == updatedTransaction.BaseCurrency {
+			updatedTransaction.BaseAmount = 0
+			updatedTransaction.BaseCurrency = ""
+		} else {
+			baseAmount, err := s.converter.Convert(updatedTransaction.Amount, updatedTransaction.Currency, updatedTransaction.BaseCurrency)
+			if err != nil {
+				s.logger.Error("service", "UpdateTransaction - base amount conversion failed", err,
+					zap.String("currency", updatedTransaction.Currency),
+					zap.String("base_currency", updatedTransaction.BaseCurrency),
+				)
+				return nil, nil, err
+			}
+			updatedTransaction.BaseAmount = baseAmount
+		}
+	}
 
-	repoStart := time.Now()
-	err = s.repo.Update(&updatedTransaction)
-	repoDuration := time.Since(repoStart)
+	// Neither the current nor the new date may fall in a closed month
+	if err := s.ensureMonthsOpen(ctx, existingTransaction.Date, updatedTransaction.Date); err != nil {
+		s.logger.Error("service", "UpdateTransaction - month closed", err,
+			zap.Int("transaction_id", id),
+		)
+		return nil, nil, err
+	}
 
-	s.logger.Performance("UpdateTransaction repository call", repoDuration,
-		zap.Bool("success", err == nil),
-		zap.Int("transaction_id", id),
+	return existingTransaction, &updatedTransaction, nil
+}
+
+// ensureMonthsOpen returns a MonthClosedError for the first date that falls in a
+// closed month. Months are taken in UTC, matching the monthly report ranges.
+func (s *transactionService) ensureMonthsOpen(ctx context.Context, dates ...time.Time) error {
+	if s.config.ClosedMonths == nil {
+		return nil
+	}
+
+	for _, date := range dates {
+		date = date.UTC()
+		closed, err := s.config.ClosedMonths.IsClosed(ctx, date.Year(), int(date.Month()))
+		if err != nil {
+			return err
+		}
+		if closed {
+			return &models.MonthClosedError{Year: date.Year(), Month: int(date.Month())}
+		}
+	}
+	return nil
+}
+
+func (s *transactionService) GetLastModified(ctx context.Context) (time.Time, error) {
+	lastModified, err := s.repo.LastModified(ctx)
+	if err != nil {
+		s.logger.Error("service", "GetLastModified - repository error", err)
+		return time.Time{}, err
+	}
+
+	s.logger.Debug("service", "GetLastModified completed",
+		zap.Time("last_modified", lastModified),
 	)
 
+	return lastModified, nil
+}
+
+// CheckIntegrity reports any broken storage invariants, for diagnosing the store after
+// bulk operations and imports
+func (s *transactionService) CheckIntegrity(ctx context.Context) (*models.IntegrityReport, error) {
+	s.logger.Service("CheckIntegrity started")
+
+	start := time.Now()
+	report, err := s.repo.CheckIntegrity(ctx)
 	if err != nil {
-		s.logger.Error("service", "UpdateTransaction - repository error", err,
-			zap.Int("transaction_id", id),
-		)
+		s.logger.Error("service", "CheckIntegrity - repository error", err)
 		return nil, err
 	}
 
-	totalDuration := time.Since(start)
-	s.logger.Service("UpdateTransaction completed successfully",
-		zap.Int("transaction_id", id),
-		zap.Duration("total_duration", totalDuration),
-		zap.Duration("repo_duration", repoDuration),
+	if !report.OK {
+		s.logger.Error("service", "CheckIntegrity - issues found", errors.New("storage integrity check failed"),
+			zap.Any("issues", report.Issues),
+		)
+	}
+
+	s.logger.Service("CheckIntegrity completed successfully",
+		zap.Bool("ok", report.OK),
+		zap.Int("issue_count", len(report.Issues)),
+		zap.Duration("total_duration", time.Since(start)),
 	)
 
-	return &updatedTransaction, nil
+	return report, nil
+}
+
+// parseFilterDate parses an optional YYYY-MM-DD filter value, returning nil when absent
+func parseFilterDate(value *string, name string) (*time.Time, error) {
+	if value == nil {
+		return nil, nil
+	}
+	date, err := time.Parse("2006-01-02", *value)
+	if err != nil {
+		return nil, fmt.Errorf("invalid %s format, use YYYY-MM-DD", name)
+	}
+	return &date, nil
+}
+
+// recordAudit appends a change to the audit trail. Failures are logged but do not
+// fail the operation, since the change itself has already been stored.
+func (s *transactionService) recordAudit(ctx context.Context, action string, id int, before, after *models.Transaction) {
+	entry := models.AuditEntry{
+		TransactionID: id,
+		Action:        action,
+		Timestamp:     s.config.Clock.Now(),
+		Before:        before,
+		After:         after,
+	}
+
+	if err := s.auditRepo.Append(ctx, entry); err != nil {
+		s.logger.Error("service", "recordAudit - failed to append audit entry", err,
+			zap.Int("transaction_id", id),
+			zap.String("action", action),
+		)
+	}
+}
+
+// canonicalCategory rewrites a configured alias to its canonical category.
+// Categories without an alias pass through unchanged.
+func (s *transactionService) canonicalCategory(category string) string {
+	if canonical, ok := s.config.CategoryAliases[category]; ok {
+		s.logger.Debug("service", "Category alias rewritten",
+			zap.String("alias", category),
+			zap.String("category", canonical),
+		)
+		return canonical
+	}
+	return category
 }
 
 func (s *transactionService) validateCreateRequest(req *models.CreateTransactionRequest) error {
@@ -349,24 +1324,60 @@ func (s *transactionService) validateCreateRequest(req *models.CreateTransaction
 		zap.Any("request", req),
 	)
 
+	if problems := s.createRequestProblems(req); len(problems) > 0 {
+		return problems[0].err
+	}
+
+	s.logger.Debug("service", "Validation completed successfully")
+	return nil
+}
+
+// fieldProblem is a validation error tied to the request field that caused it
+type fieldProblem struct {
+	field string
+	err   error
+}
+
+// createRequestProblems checks every field of a create request, in the order the first
+// error is reported by validateCreateRequest
+func (s *transactionService) createRequestProblems(req *models.CreateTransactionRequest) []fieldProblem {
+	var problems []fieldProblem
+
 	if req.Type != models.TransactionTypeExpense && req.Type != models.TransactionTypeIncome {
-		return errors.New("type must be 'expense' or 'income'")
+		problems = append(problems, fieldProblem{"type", errors.New("type must be 'expense' or 'income'")})
 	}
 
 	if req.Amount <= 0 {
-		return errors.New("amount must be positive")
+		problems = append(problems, fieldProblem{"amount", errors.New("amount must be positive")})
 	}
 
 	if req.Description == "" {
-		return errors.New("description is required")
+		problems = append(problems, fieldProblem{"description", errors.New("description is required")})
 	}
 
 	if req.Category == "" {
-		return errors.New("category is required")
+		problems = append(problems, fieldProblem{"category", errors.New("category is required")})
 	}
 
-	s.logger.Debug("service", "Validation completed successfully")
-	return nil
+	if err := s.validateCurrency(req.Currency); err != nil {
+		problems = append(problems, fieldProblem{"currency", err})
+	}
+
+	if err := utils.ValidateCurrency(req.BaseCurrency); err != nil {
+		problems = append(problems, fieldProblem{"base_currency", err})
+	}
+
+	if req.Status != "" {
+		if err := validateStatus(req.Status); err != nil {
+			problems = append(problems, fieldProblem{"status", err})
+		}
+	}
+
+	if err := validateMetadata(req.Metadata); err != nil {
+		problems = append(problems, fieldProblem{"metadata", err})
+	}
+
+	return problems
 }
 
 func (s *transactionService) validateUpdateRequest(req *models.UpdateTransactionRequest) error {
@@ -392,6 +1403,78 @@ func (s *transactionService) validateUpdateRequest(req *models.UpdateTransaction
 		return errors.New("category cannot be empty")
 	}
 
+	if req.Currency != nil {
+		if *req.Currency == "" {
+			return errors.New("currency cannot be empty")
+		}
+		if err := s.validateCurrency(*req.Currency); err != nil {
+			return err
+		}
+	}
+
+	if req.Status != nil {
+		if err := validateStatus(*req.Status); err != nil {
+			return err
+		}
+	}
+
+	if req.Metadata != nil {
+		if err := validateMetadata(*req.Metadata); err != nil {
+			return err
+		}
+	}
+
 	s.logger.Debug("service", "Update validation completed successfully")
 	return nil
-}
\ No newline at end of file
+}
+
+func validateStatus(status string) error {
+	if status != models.TransactionStatusPending && status != models.TransactionStatusCleared {
+		return errors.New("status must be 'pending' or 'cleared'")
+	}
+	return nil
+}
+
+// validateMetadata keeps metadata small enough to store and return with every transaction
+func validateMetadata(metadata map[string]string) error {
+	if len(metadata) > MaxMetadataKeys {
+		return fmt.Errorf("metadata cannot have more than %d keys", MaxMetadataKeys)
+	}
+
+	for key, value := range metadata {
+		if strings.TrimSpace(key) == "" {
+			return errors.New("metadata keys cannot be empty")
+		}
+		if len(key) > MaxMetadataKeyLength {
+			return fmt.Errorf("metadata key %q exceeds %d characters", key, MaxMetadataKeyLength)
+		}
+		if len(value) > MaxMetadataValueLength {
+			return fmt.Errorf("metadata value for %q exceeds %d characters", key, MaxMetadataValueLength)
+		}
+	}
+	return nil
+}
+
+// validateCurrency checks the ISO format and, when configured, the supported currency list
+func (s *transactionService) validateCurrency(currency string) error {
+	if currency == "" {
+		return nil // Default currency applies
+	}
+
+	if err := utils.ValidateCurrency(currency); err != nil {
+		return err
+	}
+
+	if len(s.config.SupportedCurrencies) == 0 {
+		return nil
+	}
+
+	for _, supported := range s.config.SupportedCurrencies {
+		if strings.EqualFold(currency, supported) {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("currency %s is not supported, allowed values: %s",
+		currency, strings.Join(s.config.SupportedCurrencies, ", "))
+}
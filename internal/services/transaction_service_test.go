@@ -1,6 +1,7 @@
 package services_test
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"testing"
@@ -8,6 +9,7 @@ import (
 
 	"github.com/maximicciullo/personal-finance-api/internal/middleware"
 	"github.com/maximicciullo/personal-finance-api/internal/models"
+	"github.com/maximicciullo/personal-finance-api/internal/repositories"
 	"github.com/maximicciullo/personal-finance-api/internal/services"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
@@ -19,44 +21,98 @@ type MockTransactionRepository struct {
 	mock.Mock
 }
 
-func (m *MockTransactionRepository) Create(transaction *models.Transaction) error {
-	args := m.Called(transaction)
+func (m *MockTransactionRepository) Create(ctx context.Context, transaction *models.Transaction) error {
+	args := m.Called(ctx, transaction)
 	return args.Error(0)
 }
 
-func (m *MockTransactionRepository) GetByID(id int) (*models.Transaction, error) {
-	args := m.Called(id)
+func (m *MockTransactionRepository) GetByID(ctx context.Context, id int) (*models.Transaction, error) {
+	args := m.Called(ctx, id)
 	if args.Get(0) == nil {
 		return nil, args.Error(1)
 	}
 	return args.Get(0).(*models.Transaction), args.Error(1)
 }
 
-func (m *MockTransactionRepository) GetAll() ([]models.Transaction, error) {
-	args := m.Called()
+func (m *MockTransactionRepository) GetAll(ctx context.Context) ([]models.Transaction, error) {
+	args := m.Called(ctx)
 	return args.Get(0).([]models.Transaction), args.Error(1)
 }
 
-func (m *MockTransactionRepository) GetByFilters(filters models.TransactionFilters) ([]models.Transaction, error) {
-	args := m.Called(filters)
+func (m *MockTransactionRepository) GetByFilters(ctx context.Context, filters models.TransactionFilters) ([]models.Transaction, error) {
+	args := m.Called(ctx, filters)
 	return args.Get(0).([]models.Transaction), args.Error(1)
 }
 
-func (m *MockTransactionRepository) GetByDateRange(startDate, endDate time.Time) ([]models.Transaction, error) {
-	args := m.Called(startDate, endDate)
+func (m *MockTransactionRepository) GetByDateRange(ctx context.Context, startDate, endDate time.Time) ([]models.Transaction, error) {
+	args := m.Called(ctx, startDate, endDate)
 	return args.Get(0).([]models.Transaction), args.Error(1)
 }
 
-func (m *MockTransactionRepository) Delete(id int) error {
-	args := m.Called(id)
+func (m *MockTransactionRepository) GetByIDs(ctx context.Context, ids []int) ([]models.Transaction, error) {
+	args := m.Called(ctx, ids)
+	return args.Get(0).([]models.Transaction), args.Error(1)
+}
+
+func (m *MockTransactionRepository) StreamAll(ctx context.Context) (<-chan models.Transaction, <-chan error) {
+	args := m.Called(ctx)
+	return args.Get(0).(<-chan models.Transaction), args.Get(1).(<-chan error)
+}
+
+func (m *MockTransactionRepository) GetRecent(ctx context.Context, limit int) ([]models.Transaction, error) {
+	args := m.Called(ctx, limit)
+	return args.Get(0).([]models.Transaction), args.Error(1)
+}
+
+func (m *MockTransactionRepository) GetTopExpenses(ctx context.Context, query models.TopExpensesQuery) ([]models.Transaction, error) {
+	args := m.Called(ctx, query)
+	return args.Get(0).([]models.Transaction), args.Error(1)
+}
+
+func (m *MockTransactionRepository) Delete(ctx context.Context, id int) error {
+	args := m.Called(ctx, id)
 	return args.Error(0)
 }
 
-func (m *MockTransactionRepository) Update(transaction *models.Transaction) error {
-	args := m.Called(transaction)
+func (m *MockTransactionRepository) Update(ctx context.Context, transaction *models.Transaction) error {
+	args := m.Called(ctx, transaction)
 	return args.Error(0)
 }
 
+func (m *MockTransactionRepository) Recategorize(ctx context.Context, filters models.TransactionFilters, category string) ([]models.TransactionChange, error) {
+	args := m.Called(ctx, filters, category)
+	return args.Get(0).([]models.TransactionChange), args.Error(1)
+}
+
+func (m *MockTransactionRepository) LastModified(ctx context.Context) (time.Time, error) {
+	args := m.Called(ctx)
+	return args.Get(0).(time.Time), args.Error(1)
+}
+
+func (m *MockTransactionRepository) GetMonthCounts(ctx context.Context, includePending bool) ([]models.MonthCount, error) {
+	args := m.Called(ctx, includePending)
+	return args.Get(0).([]models.MonthCount), args.Error(1)
+}
+
+func (m *MockTransactionRepository) CheckIntegrity(ctx context.Context) (*models.IntegrityReport, error) {
+	args := m.Called(ctx)
+	return args.Get(0).(*models.IntegrityReport), args.Error(1)
+}
+
+func (m *MockTransactionRepository) RunInTransaction(ctx context.Context, fn func(repositories.TransactionRepository) error) error {
+	args := m.Called(ctx, fn)
+	return args.Error(0)
+}
+
+// fixedClock is a services.Clock that always reports the same instant
+type fixedClock struct {
+	now time.Time
+}
+
+func (c fixedClock) Now() time.Time {
+	return c.now
+}
+
 // TransactionServiceTestSuite is the test suite for TransactionService
 type TransactionServiceTestSuite struct {
 	suite.Suite
@@ -96,7 +152,7 @@ func (suite *TransactionServiceTestSuite) TestCreateTransaction_Success() {
 	}
 
 	// Set expectation: repository Create should be called and should succeed
-	suite.mockRepo.On("Create", mock.MatchedBy(func(t *models.Transaction) bool {
+	suite.mockRepo.On("Create", mock.Anything, mock.MatchedBy(func(t *models.Transaction) bool {
 		return t.Type == expectedTransaction.Type &&
 			t.Amount == expectedTransaction.Amount &&
 			t.Currency == expectedTransaction.Currency &&
@@ -104,26 +160,139 @@ func (suite *TransactionServiceTestSuite) TestCreateTransaction_Success() {
 			t.Category == expectedTransaction.Category
 	})).Return(nil).Run(func(args mock.Arguments) {
 		// Simulate what repository does - set ID and timestamps
-		transaction := args.Get(0).(*models.Transaction)
+		transaction := args.Get(1).(*models.Transaction)
 		transaction.ID = 1
 		transaction.CreatedAt = time.Now()
 		transaction.UpdatedAt = time.Now()
 	})
 
 	// When
-	result, err := suite.service.CreateTransaction(request)
+	result, err := suite.service.CreateTransaction(context.Background(), request)
 
 	// Then
 	assert.NoError(suite.T(), err)
 	assert.NotNil(suite.T(), result)
 	assert.Equal(suite.T(), 1, result.ID)
 	assert.Equal(suite.T(), "expense", result.Type)
-	assert.Equal(suite.T(), 1500.50, result.Amount)
+	assert.Equal(suite.T(), models.Money(1500.50), result.Amount)
 	assert.Equal(suite.T(), "ARS", result.Currency)
 	assert.Equal(suite.T(), "Test expense", result.Description)
 	assert.Equal(suite.T(), "food", result.Category)
 }
 
+func (suite *TransactionServiceTestSuite) TestCreateTransaction_DefaultsDateToClock() {
+	// Given
+	now := time.Date(2024, 1, 31, 23, 59, 0, 0, time.UTC)
+	service := services.NewTransactionServiceWithConfig(suite.mockRepo, repositories.NewMemoryAuditRepository(), services.TransactionServiceConfig{
+		DefaultCurrency: models.CurrencyARS,
+		Clock:           fixedClock{now: now},
+	})
+	request := &models.CreateTransactionRequest{Type: "expense", Amount: 100, Description: "Late dinner", Category: "food"}
+
+	suite.mockRepo.On("Create", mock.Anything, mock.AnythingOfType("*models.Transaction")).Return(nil)
+
+	// When
+	result, err := service.CreateTransaction(context.Background(), request)
+
+	// Then
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), now, result.Date)
+}
+
+func (suite *TransactionServiceTestSuite) newServiceWithRates() services.TransactionService {
+	return services.NewTransactionServiceWithConfig(suite.mockRepo, repositories.NewMemoryAuditRepository(), services.TransactionServiceConfig{
+		DefaultCurrency: models.CurrencyARS,
+		ExchangeRates:   map[string]float64{"USD": 1000, "ARS": 1},
+	})
+}
+
+func (suite *TransactionServiceTestSuite) TestCreateTransaction_StoresBaseAmount() {
+	// Given
+	service := suite.newServiceWithRates()
+	request := &models.CreateTransactionRequest{
+		Type: "expense", Amount: 12.5, Currency: "USD", BaseCurrency: "ars", Description: "Book", Category: "books",
+	}
+
+	var stored *models.Transaction
+	suite.mockRepo.On("Create", mock.Anything, mock.AnythingOfType("*models.Transaction")).Return(nil).Run(func(args mock.Arguments) {
+		stored = args.Get(1).(*models.Transaction)
+	})
+
+	// When
+	result, err := service.CreateTransaction(context.Background(), request)
+
+	// Then
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), models.Money(12500), stored.BaseAmount)
+	assert.Equal(suite.T(), "ARS", stored.BaseCurrency)
+	assert.Equal(suite.T(), models.Money(12500), result.BaseAmount)
+}
+
+func (suite *TransactionServiceTestSuite) TestCreateTransaction_OmitsBaseAmount() {
+	testCases := []struct {
+		name         string
+		baseCurrency string
+	}{
+		{"no base currency", ""},
+		{"base currency equals currency", "USD"},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			// Given
+			service := suite.newServiceWithRates()
+			request := &models.CreateTransactionRequest{
+				Type: "expense", Amount: 12.5, Currency: "USD", BaseCurrency: tc.baseCurrency, Description: "Book", Category: "books",
+			}
+			suite.mockRepo.On("Create", mock.Anything, mock.AnythingOfType("*models.Transaction")).Return(nil)
+
+			// When
+			result, err := service.CreateTransaction(context.Background(), request)
+
+			// Then
+			assert.NoError(suite.T(), err)
+			assert.Zero(suite.T(), result.BaseAmount)
+			assert.Empty(suite.T(), result.BaseCurrency)
+		})
+	}
+}
+
+func (suite *TransactionServiceTestSuite) TestCreateTransaction_BaseAmountMissingRate() {
+	// Given
+	service := suite.newServiceWithRates()
+	request := &models.CreateTransactionRequest{
+		Type: "expense", Amount: 10, Currency: "EUR", BaseCurrency: "ARS", Description: "Museum", Category: "leisure",
+	}
+
+	// When
+	result, err := service.CreateTransaction(context.Background(), request)
+
+	// Then
+	assert.EqualError(suite.T(), err, "no exchange rate for [EUR]")
+	assert.Nil(suite.T(), result)
+	suite.mockRepo.AssertNotCalled(suite.T(), "Create", mock.Anything, mock.Anything)
+}
+
+func (suite *TransactionServiceTestSuite) TestUpdateTransaction_RecomputesBaseAmount() {
+	// Given
+	service := suite.newServiceWithRates()
+	existing := existingTransactionFixture()
+	existing.Currency = "USD"
+	existing.BaseAmount = 50000
+	existing.BaseCurrency = "ARS"
+	suite.mockRepo.On("GetByID", mock.Anything, existing.ID).Return(existing, nil)
+	suite.mockRepo.On("Update", mock.Anything, mock.AnythingOfType("*models.Transaction")).Return(nil)
+
+	amount := 80.0
+
+	// When
+	result, err := service.UpdateTransaction(context.Background(), existing.ID, &models.UpdateTransactionRequest{Amount: &amount})
+
+	// Then
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), models.Money(80000), result.BaseAmount)
+}
+
 func (suite *TransactionServiceTestSuite) TestCreateTransaction_WithCustomDate() {
 	// Given
 	customDate := "2024-06-15"
@@ -136,13 +305,13 @@ func (suite *TransactionServiceTestSuite) TestCreateTransaction_WithCustomDate()
 		Date:        &customDate,
 	}
 
-	suite.mockRepo.On("Create", mock.AnythingOfType("*models.Transaction")).Return(nil).Run(func(args mock.Arguments) {
-		transaction := args.Get(0).(*models.Transaction)
+	suite.mockRepo.On("Create", mock.Anything, mock.AnythingOfType("*models.Transaction")).Return(nil).Run(func(args mock.Arguments) {
+		transaction := args.Get(1).(*models.Transaction)
 		transaction.ID = 2
 	})
 
 	// When
-	result, err := suite.service.CreateTransaction(request)
+	result, err := suite.service.CreateTransaction(context.Background(), request)
 
 	// Then
 	assert.NoError(suite.T(), err)
@@ -161,15 +330,15 @@ func (suite *TransactionServiceTestSuite) TestCreateTransaction_DefaultCurrency(
 		// Currency not specified
 	}
 
-	suite.mockRepo.On("Create", mock.MatchedBy(func(t *models.Transaction) bool {
+	suite.mockRepo.On("Create", mock.Anything, mock.MatchedBy(func(t *models.Transaction) bool {
 		return t.Currency == "ARS" // Should default to ARS
 	})).Return(nil).Run(func(args mock.Arguments) {
-		transaction := args.Get(0).(*models.Transaction)
+		transaction := args.Get(1).(*models.Transaction)
 		transaction.ID = 3
 	})
 
 	// When
-	result, err := suite.service.CreateTransaction(request)
+	result, err := suite.service.CreateTransaction(context.Background(), request)
 
 	// Then
 	assert.NoError(suite.T(), err)
@@ -235,7 +404,7 @@ func (suite *TransactionServiceTestSuite) TestCreateTransaction_ValidationErrors
 	for _, tc := range testCases {
 		suite.T().Run(tc.name, func(t *testing.T) {
 			// When
-			result, err := suite.service.CreateTransaction(tc.request)
+			result, err := suite.service.CreateTransaction(context.Background(), tc.request)
 
 			// Then
 			assert.Error(t, err)
@@ -257,7 +426,7 @@ func (suite *TransactionServiceTestSuite) TestCreateTransaction_InvalidDate() {
 	}
 
 	// When
-	result, err := suite.service.CreateTransaction(request)
+	result, err := suite.service.CreateTransaction(context.Background(), request)
 
 	// Then
 	assert.Error(suite.T(), err)
@@ -274,10 +443,10 @@ func (suite *TransactionServiceTestSuite) TestCreateTransaction_RepositoryError(
 		Category:    "test",
 	}
 
-	suite.mockRepo.On("Create", mock.AnythingOfType("*models.Transaction")).Return(errors.New("database error"))
+	suite.mockRepo.On("Create", mock.Anything, mock.AnythingOfType("*models.Transaction")).Return(errors.New("database error"))
 
 	// When
-	result, err := suite.service.CreateTransaction(request)
+	result, err := suite.service.CreateTransaction(context.Background(), request)
 
 	// Then
 	assert.Error(suite.T(), err)
@@ -297,10 +466,10 @@ func (suite *TransactionServiceTestSuite) TestGetTransaction_Success() {
 		Category:    "test",
 	}
 
-	suite.mockRepo.On("GetByID", 1).Return(expectedTransaction, nil)
+	suite.mockRepo.On("GetByID", mock.Anything, 1).Return(expectedTransaction, nil)
 
 	// When
-	result, err := suite.service.GetTransaction(1)
+	result, err := suite.service.GetTransaction(context.Background(), 1)
 
 	// Then
 	assert.NoError(suite.T(), err)
@@ -314,7 +483,7 @@ func (suite *TransactionServiceTestSuite) TestGetTransaction_InvalidID() {
 	for _, id := range testCases {
 		suite.T().Run(fmt.Sprintf("ID_%d", id), func(t *testing.T) {
 			// When
-			result, err := suite.service.GetTransaction(id)
+			result, err := suite.service.GetTransaction(context.Background(), id)
 
 			// Then
 			assert.Error(t, err)
@@ -326,10 +495,10 @@ func (suite *TransactionServiceTestSuite) TestGetTransaction_InvalidID() {
 
 func (suite *TransactionServiceTestSuite) TestGetTransaction_NotFound() {
 	// Given
-	suite.mockRepo.On("GetByID", 999).Return(nil, errors.New("transaction not found"))
+	suite.mockRepo.On("GetByID", mock.Anything, 999).Return(nil, errors.New("transaction not found"))
 
 	// When
-	result, err := suite.service.GetTransaction(999)
+	result, err := suite.service.GetTransaction(context.Background(), 999)
 
 	// Then
 	assert.Error(suite.T(), err)
@@ -350,10 +519,10 @@ func (suite *TransactionServiceTestSuite) TestGetTransactions_Success() {
 		{ID: 2, Type: "expense", Amount: 200, Category: "food"},
 	}
 
-	suite.mockRepo.On("GetByFilters", filters).Return(expectedTransactions, nil)
+	suite.mockRepo.On("GetByFilters", mock.Anything, filters).Return(expectedTransactions, nil)
 
 	// When
-	result, err := suite.service.GetTransactions(filters)
+	result, err := suite.service.GetTransactions(context.Background(), filters)
 
 	// Then
 	assert.NoError(suite.T(), err)
@@ -366,10 +535,10 @@ func (suite *TransactionServiceTestSuite) TestGetTransactions_EmptyResult() {
 	filters := models.TransactionFilters{Type: "income"}
 	emptyResult := []models.Transaction{}
 
-	suite.mockRepo.On("GetByFilters", filters).Return(emptyResult, nil)
+	suite.mockRepo.On("GetByFilters", mock.Anything, filters).Return(emptyResult, nil)
 
 	// When
-	result, err := suite.service.GetTransactions(filters)
+	result, err := suite.service.GetTransactions(context.Background(), filters)
 
 	// Then
 	assert.NoError(suite.T(), err)
@@ -379,23 +548,34 @@ func (suite *TransactionServiceTestSuite) TestGetTransactions_EmptyResult() {
 func (suite *TransactionServiceTestSuite) TestGetTransactions_RepositoryError() {
 	// Given
 	filters := models.TransactionFilters{}
-	suite.mockRepo.On("GetByFilters", filters).Return([]models.Transaction{}, errors.New("database error"))
+	suite.mockRepo.On("GetByFilters", mock.Anything, filters).Return([]models.Transaction{}, errors.New("database error"))
 
 	// When
-	result, err := suite.service.GetTransactions(filters)
+	result, err := suite.service.GetTransactions(context.Background(), filters)
 
 	// Then
 	assert.Error(suite.T(), err)
 	assert.Nil(suite.T(), result)
 }
 
+func (suite *TransactionServiceTestSuite) TestGetLedger_RequiresCurrency() {
+	// When
+	result, err := suite.service.GetLedger(context.Background(), models.TransactionFilters{})
+
+	// Then
+	assert.ErrorIs(suite.T(), err, models.ErrCurrencyRequired)
+	assert.Nil(suite.T(), result)
+	suite.mockRepo.AssertNotCalled(suite.T(), "GetByFilters", mock.Anything, mock.Anything)
+}
+
 // Test DeleteTransaction
 func (suite *TransactionServiceTestSuite) TestDeleteTransaction_Success() {
 	// Given
-	suite.mockRepo.On("Delete", 1).Return(nil)
+	suite.mockRepo.On("GetByID", mock.Anything, 1).Return(existingTransactionFixture(), nil)
+	suite.mockRepo.On("Delete", mock.Anything, 1).Return(nil)
 
 	// When
-	err := suite.service.DeleteTransaction(1)
+	err := suite.service.DeleteTransaction(context.Background(), 1)
 
 	// Then
 	assert.NoError(suite.T(), err)
@@ -407,7 +587,7 @@ func (suite *TransactionServiceTestSuite) TestDeleteTransaction_InvalidID() {
 	for _, id := range testCases {
 		suite.T().Run(fmt.Sprintf("ID_%d", id), func(t *testing.T) {
 			// When
-			err := suite.service.DeleteTransaction(id)
+			err := suite.service.DeleteTransaction(context.Background(), id)
 
 			// Then
 			assert.Error(t, err)
@@ -418,14 +598,628 @@ func (suite *TransactionServiceTestSuite) TestDeleteTransaction_InvalidID() {
 
 func (suite *TransactionServiceTestSuite) TestDeleteTransaction_NotFound() {
 	// Given
-	suite.mockRepo.On("Delete", 999).Return(errors.New("transaction not found"))
+	suite.mockRepo.On("GetByID", mock.Anything, 999).Return(nil, errors.New("transaction not found"))
 
 	// When
-	err := suite.service.DeleteTransaction(999)
+	err := suite.service.DeleteTransaction(context.Background(), 999)
 
 	// Then
 	assert.Error(suite.T(), err)
 	assert.Contains(suite.T(), err.Error(), "transaction not found")
+	suite.mockRepo.AssertNotCalled(suite.T(), "Delete", mock.Anything, 999)
+}
+
+// Test UpdateTransaction
+func existingTransactionFixture() *models.Transaction {
+	return &models.Transaction{
+		ID:          1,
+		Type:        "expense",
+		Amount:      1500,
+		Currency:    "ARS",
+		Description: "Coffee",
+		Category:    "food",
+		Date:        time.Date(2024, 6, 15, 0, 0, 0, 0, time.UTC),
+		CreatedAt:   time.Date(2024, 6, 15, 10, 0, 0, 0, time.UTC),
+		UpdatedAt:   time.Date(2024, 6, 15, 10, 0, 0, 0, time.UTC),
+	}
+}
+
+func (suite *TransactionServiceTestSuite) TestUpdateTransaction_Success() {
+	// Given
+	existing := existingTransactionFixture()
+	newAmount := 2000.0
+	request := &models.UpdateTransactionRequest{Amount: &newAmount}
+
+	suite.mockRepo.On("GetByID", mock.Anything, 1).Return(existing, nil)
+	suite.mockRepo.On("Update", mock.Anything, mock.MatchedBy(func(t *models.Transaction) bool {
+		return t.ID == 1 && t.Amount == models.Money(newAmount)
+	})).Return(nil)
+
+	// When
+	result, err := suite.service.UpdateTransaction(context.Background(), 1, request)
+
+	// Then
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), models.Money(newAmount), result.Amount)
+	assert.Equal(suite.T(), "Coffee", result.Description)
+}
+
+func (suite *TransactionServiceTestSuite) TestUpdateTransaction_NoChangesSkipsRepository() {
+	// Given - a patch repeating the stored values
+	existing := existingTransactionFixture()
+	originalUpdatedAt := existing.UpdatedAt
+	amount := 1500.0
+	category := "food"
+	date := "2024-06-15"
+	request := &models.UpdateTransactionRequest{Amount: &amount, Category: &category, Date: &date}
+
+	suite.mockRepo.On("GetByID", mock.Anything, 1).Return(existing, nil)
+
+	// When
+	result, err := suite.service.UpdateTransaction(context.Background(), 1, request)
+
+	// Then
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), originalUpdatedAt, result.UpdatedAt)
+	assert.Equal(suite.T(), models.Money(1500.0), result.Amount)
+	suite.mockRepo.AssertNotCalled(suite.T(), "Update", mock.Anything, mock.Anything)
+}
+
+func (suite *TransactionServiceTestSuite) TestUpdateTransaction_RejectsMoveIntoClosedMonth() {
+	// Given - the stored transaction is in June; May is closed
+	closedMonths := repositories.NewMemoryClosedMonthRepository()
+	_, err := closedMonths.Close(context.Background(), 2024, 5)
+	suite.Require().NoError(err)
+	service := services.NewTransactionServiceWithConfig(suite.mockRepo, repositories.NewMemoryAuditRepository(), services.TransactionServiceConfig{
+		DefaultCurrency: models.CurrencyARS,
+		ClosedMonths:    closedMonths,
+	})
+
+	date := "2024-05-31"
+	suite.mockRepo.On("GetByID", mock.Anything, 1).Return(existingTransactionFixture(), nil)
+
+	// When
+	result, err := service.UpdateTransaction(context.Background(), 1, &models.UpdateTransactionRequest{Date: &date})
+
+	// Then
+	assert.Nil(suite.T(), result)
+	assert.ErrorIs(suite.T(), err, models.ErrMonthClosed)
+	assert.EqualError(suite.T(), err, "month 2024-05 is closed")
+	suite.mockRepo.AssertNotCalled(suite.T(), "Update", mock.Anything, mock.Anything)
+}
+
+func (suite *TransactionServiceTestSuite) constrainedCurrencyService() services.TransactionService {
+	return services.NewTransactionServiceWithConfig(suite.mockRepo, repositories.NewMemoryAuditRepository(), services.TransactionServiceConfig{
+		DefaultCurrency:     "ARS",
+		SupportedCurrencies: []string{"ARS", "USD", "EUR"},
+	})
+}
+
+func (suite *TransactionServiceTestSuite) TestCreateTransaction_SupportedCurrencyAllowed() {
+	// Given
+	service := suite.constrainedCurrencyService()
+	request := &models.CreateTransactionRequest{
+		Type:        "expense",
+		Amount:      20,
+		Currency:    "USD",
+		Description: "Book",
+		Category:    "education",
+	}
+
+	suite.mockRepo.On("Create", mock.Anything, mock.MatchedBy(func(t *models.Transaction) bool {
+		return t.Currency == "USD"
+	})).Return(nil)
+
+	// When
+	result, err := service.CreateTransaction(context.Background(), request)
+
+	// Then
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), "USD", result.Currency)
+}
+
+func (suite *TransactionServiceTestSuite) TestCreateTransaction_UnsupportedCurrencyRejected() {
+	// Given
+	service := suite.constrainedCurrencyService()
+	request := &models.CreateTransactionRequest{
+		Type:        "expense",
+		Amount:      2000,
+		Currency:    "JPY",
+		Description: "Ramen",
+		Category:    "food",
+	}
+
+	// When
+	result, err := service.CreateTransaction(context.Background(), request)
+
+	// Then
+	assert.Error(suite.T(), err)
+	assert.Nil(suite.T(), result)
+	assert.Contains(suite.T(), err.Error(), "allowed values: ARS, USD, EUR")
+	suite.mockRepo.AssertNotCalled(suite.T(), "Create", mock.Anything, mock.Anything)
+}
+
+func (suite *TransactionServiceTestSuite) TestCreateTransaction_AnyISOCurrencyWhenUnconstrained() {
+	// Given
+	request := &models.CreateTransactionRequest{
+		Type:        "expense",
+		Amount:      2000,
+		Currency:    "JPY",
+		Description: "Ramen",
+		Category:    "food",
+	}
+
+	suite.mockRepo.On("Create", mock.Anything, mock.Anything).Return(nil)
+
+	// When
+	result, err := suite.service.CreateTransaction(context.Background(), request)
+
+	// Then
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), "JPY", result.Currency)
+}
+
+func (suite *TransactionServiceTestSuite) TestCreateTransaction_InvalidCurrencyFormat() {
+	// Given
+	request := &models.CreateTransactionRequest{
+		Type:        "expense",
+		Amount:      100,
+		Currency:    "DOLLARS",
+		Description: "Test",
+		Category:    "test",
+	}
+
+	// When
+	result, err := suite.service.CreateTransaction(context.Background(), request)
+
+	// Then
+	assert.Error(suite.T(), err)
+	assert.Nil(suite.T(), result)
+	assert.Contains(suite.T(), err.Error(), "3-letter ISO code")
+}
+
+func (suite *TransactionServiceTestSuite) TestUpdateTransaction_UnsupportedCurrencyRejected() {
+	// Given
+	service := suite.constrainedCurrencyService()
+	currency := "BRL"
+
+	suite.mockRepo.On("GetByID", mock.Anything, 1).Return(existingTransactionFixture(), nil)
+
+	// When
+	result, err := service.UpdateTransaction(context.Background(), 1, &models.UpdateTransactionRequest{Currency: &currency})
+
+	// Then
+	assert.Error(suite.T(), err)
+	assert.Nil(suite.T(), result)
+	assert.Contains(suite.T(), err.Error(), "currency BRL is not supported")
+	suite.mockRepo.AssertNotCalled(suite.T(), "Update", mock.Anything, mock.Anything)
+}
+
+func (suite *TransactionServiceTestSuite) TestPreviewCreateTransaction_DoesNotStore() {
+	// Given
+	request := &models.CreateTransactionRequest{
+		Type:        "income",
+		Amount:      500,
+		Description: "Freelance",
+		Category:    "work",
+	}
+
+	// When
+	result, _, err := suite.service.PreviewCreateTransaction(context.Background(), request)
+
+	// Then
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), 0, result.ID)
+	assert.Equal(suite.T(), "ARS", result.Currency)
+	suite.mockRepo.AssertNotCalled(suite.T(), "Create", mock.Anything, mock.Anything)
+}
+
+func (suite *TransactionServiceTestSuite) TestPreviewCreateTransaction_FarDateMeasuredFromClock() {
+	// Given - the clock is mid 2024, years before the real now
+	service := services.NewTransactionServiceWithConfig(suite.mockRepo, repositories.NewMemoryAuditRepository(), services.TransactionServiceConfig{
+		DefaultCurrency: models.CurrencyARS,
+		Clock:           fixedClock{now: time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)},
+		FarDateWarning:  services.DefaultFarDateWarning,
+	})
+	nearDate, farDate := "2024-12-01", "2026-06-01"
+	near := &models.CreateTransactionRequest{Type: "expense", Amount: 100, Description: "Rent", Category: "home", Date: &nearDate}
+	far := &models.CreateTransactionRequest{Type: "expense", Amount: 100, Description: "Rent", Category: "home", Date: &farDate}
+
+	// When
+	_, nearWarnings, err := service.PreviewCreateTransaction(context.Background(), near)
+	suite.Require().NoError(err)
+	_, farWarnings, err := service.PreviewCreateTransaction(context.Background(), far)
+	suite.Require().NoError(err)
+
+	// Then
+	assert.Empty(suite.T(), nearWarnings)
+	if assert.Len(suite.T(), farWarnings, 1) {
+		assert.Equal(suite.T(), models.WarningFarDate, farWarnings[0].Code)
+	}
+}
+
+func (suite *TransactionServiceTestSuite) TestPreviewUpdateTransaction_DoesNotStore() {
+	// Given
+	newAmount := 3000.0
+	suite.mockRepo.On("GetByID", mock.Anything, 1).Return(existingTransactionFixture(), nil)
+
+	// When
+	result, _, err := suite.service.PreviewUpdateTransaction(context.Background(), 1, &models.UpdateTransactionRequest{Amount: &newAmount})
+
+	// Then
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), models.Money(newAmount), result.Amount)
+	suite.mockRepo.AssertNotCalled(suite.T(), "Update", mock.Anything, mock.Anything)
+}
+
+func (suite *TransactionServiceTestSuite) TestGetRecentTransactions_LimitNormalization() {
+	testCases := []struct {
+		name      string
+		requested int
+		expected  int
+	}{
+		{"default when zero", 0, services.DefaultRecentLimit},
+		{"passes through within range", 25, 25},
+		{"capped at maximum", 500, services.MaxRecentLimit},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			// Given
+			suite.mockRepo = new(MockTransactionRepository)
+			suite.service = services.NewTransactionService(suite.mockRepo)
+			suite.mockRepo.On("GetRecent", mock.Anything, tc.expected).Return([]models.Transaction{}, nil)
+
+			// When
+			_, err := suite.service.GetRecentTransactions(context.Background(), tc.requested)
+
+			// Then
+			assert.NoError(suite.T(), err)
+			suite.mockRepo.AssertCalled(suite.T(), "GetRecent", mock.Anything, tc.expected)
+		})
+	}
+}
+
+func (suite *TransactionServiceTestSuite) TestGetTopExpenses_RequiresCurrency() {
+	// When
+	result, err := suite.service.GetTopExpenses(context.Background(), models.TopExpensesQuery{Limit: 5})
+
+	// Then
+	assert.ErrorIs(suite.T(), err, models.ErrTopExpensesCurrency)
+	assert.Nil(suite.T(), result)
+	suite.mockRepo.AssertNotCalled(suite.T(), "GetTopExpenses", mock.Anything, mock.Anything)
+}
+
+func (suite *TransactionServiceTestSuite) TestGetTopExpenses_LimitNormalization() {
+	testCases := []struct {
+		name      string
+		requested int
+		expected  int
+	}{
+		{"default when zero", 0, services.DefaultTopExpensesLimit},
+		{"passes through within range", 25, 25},
+		{"capped at maximum", 500, services.MaxTopExpensesLimit},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			// Given
+			suite.mockRepo = new(MockTransactionRepository)
+			suite.service = services.NewTransactionService(suite.mockRepo)
+			expected := models.TopExpensesQuery{Currency: "ARS", Limit: tc.expected}
+			suite.mockRepo.On("GetTopExpenses", mock.Anything, expected).Return([]models.Transaction{}, nil)
+
+			// When
+			_, err := suite.service.GetTopExpenses(context.Background(), models.TopExpensesQuery{Currency: "ARS", Limit: tc.requested})
+
+			// Then
+			assert.NoError(suite.T(), err)
+			suite.mockRepo.AssertCalled(suite.T(), "GetTopExpenses", mock.Anything, expected)
+		})
+	}
+}
+
+// Test SetPinned
+func (suite *TransactionServiceTestSuite) TestSetPinned_UpdatesFlag() {
+	// Given
+	suite.mockRepo.On("GetByID", mock.Anything, 1).Return(existingTransactionFixture(), nil)
+	suite.mockRepo.On("Update", mock.Anything, mock.MatchedBy(func(tx *models.Transaction) bool {
+		return tx.Pinned
+	})).Return(nil)
+
+	// When
+	result, err := suite.service.SetPinned(context.Background(), 1, true)
+
+	// Then
+	assert.NoError(suite.T(), err)
+	assert.True(suite.T(), result.Pinned)
+	suite.mockRepo.AssertExpectations(suite.T())
+}
+
+func (suite *TransactionServiceTestSuite) TestSetPinned_AlreadyUnpinnedIsNoop() {
+	// Given
+	suite.mockRepo.On("GetByID", mock.Anything, 1).Return(existingTransactionFixture(), nil)
+
+	// When
+	result, err := suite.service.SetPinned(context.Background(), 1, false)
+
+	// Then
+	assert.NoError(suite.T(), err)
+	assert.False(suite.T(), result.Pinned)
+	suite.mockRepo.AssertNotCalled(suite.T(), "Update", mock.Anything, mock.Anything)
+}
+
+// Test GetTransactionsByIDs
+func (suite *TransactionServiceTestSuite) TestGetTransactionsByIDs_DedupesAndReportsMissing() {
+	// Given
+	suite.mockRepo.On("GetByIDs", mock.Anything, []int{2, 5, 7}).Return([]models.Transaction{
+		{ID: 2, Type: "expense", Amount: 100, Currency: "ARS"},
+		{ID: 7, Type: "income", Amount: 500, Currency: "USD"},
+	}, nil)
+
+	// When
+	result, err := suite.service.GetTransactionsByIDs(context.Background(), []int{2, 5, 2, 7, 5})
+
+	// Then
+	assert.NoError(suite.T(), err)
+	assert.Len(suite.T(), result.Transactions, 2)
+	assert.Equal(suite.T(), []int{5}, result.MissingIDs)
+	suite.mockRepo.AssertExpectations(suite.T())
+}
+
+func (suite *TransactionServiceTestSuite) TestGetTransactionsByIDs_InvalidInput() {
+	tooMany := make([]int, services.MaxBatchIDs+1)
+	for i := range tooMany {
+		tooMany[i] = i + 1
+	}
+
+	testCases := []struct {
+		name        string
+		ids         []int
+		errContains string
+	}{
+		{"no ids", nil, "at least one id is required"},
+		{"too many ids", tooMany, "at most 100 ids"},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			// When
+			result, err := suite.service.GetTransactionsByIDs(context.Background(), tc.ids)
+
+			// Then
+			assert.Error(suite.T(), err)
+			assert.Contains(suite.T(), err.Error(), tc.errContains)
+			assert.Nil(suite.T(), result)
+		})
+	}
+	suite.mockRepo.AssertNotCalled(suite.T(), "GetByIDs", mock.Anything, mock.Anything)
+}
+
+// Test GetTransactionHistory
+func (suite *TransactionServiceTestSuite) TestGetTransactionHistory_CreateUpdateDelete() {
+	// Given
+	repo := repositories.NewMemoryTransactionRepository()
+	service := services.NewTransactionService(repo)
+
+	created, err := service.CreateTransaction(context.Background(), &models.CreateTransactionRequest{
+		Type:        "expense",
+		Amount:      1500,
+		Currency:    "ARS",
+		Description: "Coffee",
+		Category:    "food",
+	})
+	assert.NoError(suite.T(), err)
+
+	newAmount := 2000.0
+	_, err = service.UpdateTransaction(context.Background(), created.ID, &models.UpdateTransactionRequest{Amount: &newAmount})
+	assert.NoError(suite.T(), err)
+	assert.NoError(suite.T(), service.DeleteTransaction(context.Background(), created.ID))
+
+	// When
+	history, err := service.GetTransactionHistory(context.Background(), created.ID)
+
+	// Then
+	assert.NoError(suite.T(), err)
+	assert.Len(suite.T(), history, 3)
+
+	assert.Equal(suite.T(), models.AuditActionCreate, history[0].Action)
+	assert.Nil(suite.T(), history[0].Before)
+	assert.Equal(suite.T(), models.Money(1500.0), history[0].After.Amount)
+
+	assert.Equal(suite.T(), models.AuditActionUpdate, history[1].Action)
+	assert.Equal(suite.T(), models.Money(1500.0), history[1].Before.Amount)
+	assert.Equal(suite.T(), models.Money(2000.0), history[1].After.Amount)
+
+	assert.Equal(suite.T(), models.AuditActionDelete, history[2].Action)
+	assert.Equal(suite.T(), models.Money(2000.0), history[2].Before.Amount)
+	assert.Nil(suite.T(), history[2].After)
+
+	for _, entry := range history {
+		assert.Equal(suite.T(), created.ID, entry.TransactionID)
+	}
+	assert.False(suite.T(), history[1].Timestamp.Before(history[0].Timestamp))
+	assert.False(suite.T(), history[2].Timestamp.Before(history[1].Timestamp))
+}
+
+func (suite *TransactionServiceTestSuite) TestGetTransactionHistory_NoopUpdateNotRecorded() {
+	// Given
+	existing := existingTransactionFixture()
+	sameAmount := float64(existing.Amount)
+	suite.mockRepo.On("GetByID", mock.Anything, 1).Return(existing, nil)
+
+	_, err := suite.service.UpdateTransaction(context.Background(), 1, &models.UpdateTransactionRequest{Amount: &sameAmount})
+	assert.NoError(suite.T(), err)
+
+	// When
+	history, err := suite.service.GetTransactionHistory(context.Background(), 1)
+
+	// Then
+	assert.ErrorIs(suite.T(), err, models.ErrTransactionNotFound)
+	assert.Nil(suite.T(), history)
+}
+
+func (suite *TransactionServiceTestSuite) aliasedCategoryService() services.TransactionService {
+	config := services.DefaultTransactionServiceConfig()
+	config.CategoryAliases = map[string]string{"groceries": "food", "gas": "transport"}
+	return services.NewTransactionServiceWithConfig(suite.mockRepo, repositories.NewMemoryAuditRepository(), config)
+}
+
+func (suite *TransactionServiceTestSuite) TestCreateTransaction_CategoryAliasRewritten() {
+	// Given
+	service := suite.aliasedCategoryService()
+	suite.mockRepo.On("Create", mock.Anything, mock.MatchedBy(func(tx *models.Transaction) bool {
+		return tx.Category == "food"
+	})).Return(nil)
+
+	// When
+	result, err := service.CreateTransaction(context.Background(), &models.CreateTransactionRequest{
+		Type:        "expense",
+		Amount:      800,
+		Description: "Supermarket",
+		Category:    "groceries",
+	})
+
+	// Then
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), "food", result.Category)
+	suite.mockRepo.AssertExpectations(suite.T())
+}
+
+func (suite *TransactionServiceTestSuite) TestCreateTransaction_CanonicalCategoryUnchanged() {
+	// Given
+	service := suite.aliasedCategoryService()
+	suite.mockRepo.On("Create", mock.Anything, mock.AnythingOfType("*models.Transaction")).Return(nil)
+
+	// When
+	result, err := service.CreateTransaction(context.Background(), &models.CreateTransactionRequest{
+		Type:        "expense",
+		Amount:      800,
+		Description: "Restaurant",
+		Category:    "food",
+	})
+
+	// Then
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), "food", result.Category)
+}
+
+func (suite *TransactionServiceTestSuite) TestUpdateTransaction_CategoryAliasRewritten() {
+	// Given
+	service := suite.aliasedCategoryService()
+	alias := "gas"
+	suite.mockRepo.On("GetByID", mock.Anything, 1).Return(existingTransactionFixture(), nil)
+	suite.mockRepo.On("Update", mock.Anything, mock.MatchedBy(func(tx *models.Transaction) bool {
+		return tx.Category == "transport"
+	})).Return(nil)
+
+	// When
+	result, err := service.UpdateTransaction(context.Background(), 1, &models.UpdateTransactionRequest{Category: &alias})
+
+	// Then
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), "transport", result.Category)
+	suite.mockRepo.AssertExpectations(suite.T())
+}
+
+func (suite *TransactionServiceTestSuite) TestRecategorizeTransactions_PassesFiltersAndCounts() {
+	// Given
+	fromDate := "2024-06-01"
+	expectedFrom := time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)
+	suite.mockRepo.On("Recategorize", mock.Anything, models.TransactionFilters{
+		Category: "uncategorized", Type: "expense", Currency: "USD", FromDate: &expectedFrom,
+	}, "misc").Return([]models.TransactionChange{
+		{Before: models.Transaction{ID: 3, Category: "uncategorized"}, After: models.Transaction{ID: 3, Category: "misc"}},
+		{Before: models.Transaction{ID: 7, Category: "uncategorized"}, After: models.Transaction{ID: 7, Category: "misc"}},
+	}, nil)
+
+	// When
+	result, err := suite.service.RecategorizeTransactions(context.Background(), &models.RecategorizeRequest{
+		From: "uncategorized",
+		To:   "misc",
+		Filters: models.RecategorizeFilters{
+			Type: "expense", Currency: "usd", FromDate: &fromDate,
+		},
+	})
+
+	// Then
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), &models.RecategorizeResult{From: "uncategorized", To: "misc", Updated: 2}, result)
+	suite.mockRepo.AssertExpectations(suite.T())
+}
+
+func (suite *TransactionServiceTestSuite) TestRecategorizeTransactions_RecordsHistory() {
+	// Given
+	repo := repositories.NewMemoryTransactionRepository()
+	service := services.NewTransactionService(repo)
+	created, _ := service.CreateTransaction(context.Background(), &models.CreateTransactionRequest{
+		Type: "expense", Amount: 1500, Currency: "ARS", Description: "Imported", Category: "uncategorized",
+	})
+
+	// When
+	_, err := service.RecategorizeTransactions(context.Background(), &models.RecategorizeRequest{From: "uncategorized", To: "misc"})
+
+	// Then
+	assert.NoError(suite.T(), err)
+	history, _ := service.GetTransactionHistory(context.Background(), created.ID)
+	assert.Len(suite.T(), history, 2)
+	assert.Equal(suite.T(), models.AuditActionUpdate, history[1].Action)
+	assert.Equal(suite.T(), "uncategorized", history[1].Before.Category)
+	assert.Equal(suite.T(), "misc", history[1].After.Category)
+}
+
+func (suite *TransactionServiceTestSuite) TestRecategorizeTransactions_ReservedCategory() {
+	// Given
+	repo := repositories.NewMemoryTransactionRepository()
+	config := services.DefaultTransactionServiceConfig()
+	config.ReservedCategories = []string{"uncategorized", "transfer"}
+	service := services.NewTransactionServiceWithConfig(repo, repositories.NewMemoryAuditRepository(), config)
+	for _, category := range []string{"uncategorized", "food"} {
+		_, err := service.CreateTransaction(context.Background(), &models.CreateTransactionRequest{
+			Type: "expense", Amount: 100, Currency: "ARS", Description: "Item", Category: category,
+		})
+		suite.Require().NoError(err)
+	}
+
+	// When
+	blocked, blockedErr := service.RecategorizeTransactions(context.Background(), &models.RecategorizeRequest{From: "uncategorized", To: "misc"})
+	allowed, allowedErr := service.RecategorizeTransactions(context.Background(), &models.RecategorizeRequest{From: "food", To: "transfer"})
+
+	// Then - moving away from a reserved category is refused, moving into one is not
+	assert.ErrorIs(suite.T(), blockedErr, models.ErrReservedCategory)
+	assert.Nil(suite.T(), blocked)
+	uncategorized, _ := repo.GetByFilters(context.Background(), models.TransactionFilters{Category: "uncategorized"})
+	assert.Len(suite.T(), uncategorized, 1)
+
+	assert.NoError(suite.T(), allowedErr)
+	assert.Equal(suite.T(), 1, allowed.Updated)
+}
+
+func (suite *TransactionServiceTestSuite) TestRecategorizeTransactions_InvalidRequest() {
+	badDate := "06/01/2024"
+
+	testCases := []struct {
+		name        string
+		service     services.TransactionService
+		req         models.RecategorizeRequest
+		expectedErr string
+	}{
+		{"same category", suite.service, models.RecategorizeRequest{From: "food", To: "food"}, "from and to must be different categories"},
+		{"alias of the same category", suite.aliasedCategoryService(), models.RecategorizeRequest{From: "food", To: "groceries"}, "from and to must be different categories"},
+		{"invalid date", suite.service, models.RecategorizeRequest{From: "food", To: "misc", Filters: models.RecategorizeFilters{ToDate: &badDate}}, "invalid to_date format, use YYYY-MM-DD"},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			// When
+			result, err := tc.service.RecategorizeTransactions(context.Background(), &tc.req)
+
+			// Then
+			assert.EqualError(suite.T(), err, tc.expectedErr)
+			assert.Nil(suite.T(), result)
+		})
+	}
+	suite.mockRepo.AssertNotCalled(suite.T(), "Recategorize", mock.Anything, mock.Anything, mock.Anything)
 }
 
 func TestTransactionServiceTestSuite(t *testing.T) {
@@ -6,10 +6,13 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"testing"
+	"time"
 
 	"github.com/gin-gonic/gin"
+	"github.com/maximicciullo/personal-finance-api/internal/config"
 	"github.com/maximicciullo/personal-finance-api/internal/controllers"
 	"github.com/maximicciullo/personal-finance-api/internal/middleware"
+	"github.com/maximicciullo/personal-finance-api/internal/models"
 	"github.com/maximicciullo/personal-finance-api/internal/repositories"
 	"github.com/maximicciullo/personal-finance-api/internal/services"
 	"github.com/stretchr/testify/assert"
@@ -17,13 +20,20 @@ import (
 
 // TestServer wraps the test dependencies
 type TestServer struct {
-	Router                *gin.Engine
-	TransactionRepo       *repositories.MemoryTransactionRepository
-	TransactionService    services.TransactionService
-	TransactionController *controllers.TransactionController
-	ReportService         services.ReportService
-	ReportController      *controllers.ReportController
-	HealthController      *controllers.HealthController
+	Router                  *gin.Engine
+	TransactionRepo         *repositories.MemoryTransactionRepository
+	TransactionService      services.TransactionService
+	TransactionController   *controllers.TransactionController
+	TransactionControllerV2 *controllers.TransactionController
+	ReportService           services.ReportService
+	ReportController        *controllers.ReportController
+	TemplateService         services.TemplateService
+	TemplateController      *controllers.TemplateController
+	CategoryMetaService     services.CategoryMetaService
+	CategoryMetaController  *controllers.CategoryMetaController
+	HealthController        *controllers.HealthController
+	SettingsController      *controllers.SettingsController
+	CurrencyController      *controllers.CurrencyController
 }
 
 // NewTestServer creates a new test server with all dependencies
@@ -36,27 +46,77 @@ func NewTestServer() *TestServer {
 
 	// Initialize repositories
 	transactionRepo := repositories.NewMemoryTransactionRepository()
+	categoryMetaRepo := repositories.NewMemoryCategoryMetaRepository()
+	closedMonthRepo := repositories.NewMemoryClosedMonthRepository()
 
 	// Initialize services
-	transactionService := services.NewTransactionService(transactionRepo)
-	reportService := services.NewReportService(transactionRepo)
+	transactionConfig := services.DefaultTransactionServiceConfig()
+	transactionConfig.ClosedMonths = closedMonthRepo
+	transactionService := services.NewTransactionServiceWithConfig(transactionRepo, repositories.NewMemoryAuditRepository(), transactionConfig)
+	reportConfig := services.DefaultReportServiceConfig()
+	reportConfig.CategoryMeta = categoryMetaRepo
+	reportConfig.ClosedMonths = closedMonthRepo
+	reportService := services.NewReportServiceWithConfig(transactionRepo, reportConfig)
+	templateService := services.NewTemplateService(repositories.NewMemoryTemplateRepository(), transactionService)
+	categoryMetaService := services.NewCategoryMetaService(categoryMetaRepo)
 
 	// Initialize controllers
 	healthController := controllers.NewHealthController()
 	transactionController := controllers.NewTransactionController(transactionService)
+	v2Config := controllers.DefaultTransactionControllerConfig()
+	v2Config.Envelope = true
+	transactionControllerV2 := controllers.NewTransactionControllerWithConfig(transactionService, v2Config)
 	reportController := controllers.NewReportController(reportService)
+	templateController := controllers.NewTemplateController(templateService)
+	categoryMetaController := controllers.NewCategoryMetaController(categoryMetaService)
+	settingsController := controllers.NewSettingsController(TestConfig().Sanitized())
+	currencyController := controllers.NewCurrencyController()
 
 	// Setup router
-	router := setupTestRoutes(healthController, transactionController, reportController)
+	router := setupTestRoutes(healthController, transactionController, transactionControllerV2, reportController, templateController, categoryMetaController, settingsController, currencyController)
 
 	return &TestServer{
-		Router:                router,
-		TransactionRepo:       transactionRepo,
-		TransactionService:    transactionService,
-		TransactionController: transactionController,
-		ReportService:         reportService,
-		ReportController:      reportController,
-		HealthController:      healthController,
+		Router:                  router,
+		TransactionRepo:         transactionRepo,
+		TransactionService:      transactionService,
+		TransactionController:   transactionController,
+		TransactionControllerV2: transactionControllerV2,
+		ReportService:           reportService,
+		ReportController:        reportController,
+		TemplateService:         templateService,
+		TemplateController:      templateController,
+		CategoryMetaService:     categoryMetaService,
+		CategoryMetaController:  categoryMetaController,
+		HealthController:        healthController,
+		SettingsController:      settingsController,
+		CurrencyController:      currencyController,
+	}
+}
+
+// Reset removes every stored transaction and restarts ids at 1, for tests that need an
+// empty store partway through
+func (ts *TestServer) Reset() {
+	ts.TransactionRepo.Reset()
+}
+
+// Seed stores the given records as-is and sets the next id, for tests that need a
+// specific, possibly inconsistent, store
+func (ts *TestServer) Seed(transactions []models.Transaction, nextID int) {
+	ts.TransactionRepo.Seed(transactions, nextID)
+}
+
+// TestConfig returns the configuration the test server reports as its settings
+func TestConfig() *config.Config {
+	return &config.Config{
+		Port:            "8080",
+		Environment:     "test",
+		HealthPath:      "/health",
+		DefaultCurrency: "ARS",
+		TrustedProxies:  []string{"10.0.0.1"},
+		MaxPageSize:     200,
+		EmptyListStatus: 200,
+		RequestTimeout:  30 * time.Second,
+		Features:        map[string]bool{config.FeatureAPIV2: true, config.FeatureReportInsights: true},
 	}
 }
 
@@ -64,15 +124,24 @@ func NewTestServer() *TestServer {
 func setupTestRoutes(
 	healthController *controllers.HealthController,
 	transactionController *controllers.TransactionController,
+	transactionControllerV2 *controllers.TransactionController,
 	reportController *controllers.ReportController,
+	templateController *controllers.TemplateController,
+	categoryMetaController *controllers.CategoryMetaController,
+	settingsController *controllers.SettingsController,
+	currencyController *controllers.CurrencyController,
 ) *gin.Engine {
 	router := gin.New()
 
 	// Minimal middleware for testing (no logging to avoid noise)
 	router.Use(gin.Recovery())
 
+	// Unknown routes
+	router.NoRoute(controllers.NotFound)
+
 	// Health check
 	router.GET("/health", healthController.HealthCheck)
+	router.GET("/health/ready", healthController.ReadinessCheck)
 
 	// API routes group
 	api := router.Group("/api/v1")
@@ -81,16 +150,83 @@ func setupTestRoutes(
 		transactions := api.Group("/transactions")
 		{
 			transactions.POST("", transactionController.CreateTransaction)
+			transactions.POST("/import", transactionController.ImportTransactions)
+			transactions.POST("/recategorize", transactionController.RecategorizeTransactions)
+			transactions.POST("/validate", transactionController.ValidateTransaction)
 			transactions.GET("", transactionController.GetTransactions)
+			transactions.PATCH("", transactionController.BulkUpdateTransactions)
+			transactions.GET("/recent", transactionController.GetRecentTransactions)
+			transactions.GET("/top-expenses", transactionController.GetTopExpenses)
+			transactions.GET("/by-day/:year/:month", transactionController.GetTransactionsByDay)
+			transactions.GET("/batch", transactionController.GetTransactionsBatch)
+			transactions.GET("/export", transactionController.ExportTransactions)
 			transactions.GET("/:id", transactionController.GetTransaction)
+			transactions.GET("/:id/history", transactionController.GetTransactionHistory)
+			transactions.PUT("/:id", transactionController.UpdateTransaction)
+			transactions.PATCH("/:id", transactionController.UpdateTransaction)
+			transactions.POST("/:id/pin", transactionController.PinTransaction)
+			transactions.POST("/:id/unpin", transactionController.UnpinTransaction)
+			transactions.POST("/:id/clone", transactionController.CloneTransaction)
 			transactions.DELETE("/:id", transactionController.DeleteTransaction)
 		}
 
 		// Report routes
 		reports := api.Group("/reports")
 		{
+			reports.GET("/months", reportController.GetReportMonths)
 			reports.GET("/monthly/:year/:month", reportController.GetMonthlyReport)
+			reports.GET("/monthly/:year/:month/totals", reportController.GetMonthlyTotals)
 			reports.GET("/current-month", reportController.GetCurrentMonthReport)
+			reports.GET("/savings-rate/:year/:month", reportController.GetSavingsRate)
+			reports.GET("/income-expense/:year/:month", reportController.GetIncomeExpense)
+			reports.GET("/diff", reportController.GetMonthDiff)
+			reports.GET("/group-by", reportController.GetGroupedReport)
+			reports.GET("/networth", reportController.GetNetWorthReport)
+			reports.GET("/balance", reportController.GetBalanceAsOf)
+			reports.POST("/close/:year/:month", reportController.CloseMonth)
+			reports.POST("/reopen/:year/:month", reportController.ReopenMonth)
+			reports.GET("/anomalies", reportController.GetAnomalyReport)
+			reports.GET("/trends/category/:category", reportController.GetCategoryTrend)
+		}
+
+		// Template routes
+		templates := api.Group("/templates")
+		{
+			templates.POST("", templateController.CreateTemplate)
+			templates.GET("", templateController.GetTemplates)
+			templates.GET("/:id", templateController.GetTemplate)
+			templates.PUT("/:id", templateController.UpdateTemplate)
+			templates.DELETE("/:id", templateController.DeleteTemplate)
+			templates.POST("/:id/apply", templateController.ApplyTemplate)
+		}
+
+		// Category metadata routes
+		categoryMeta := api.Group("/category-meta")
+		{
+			categoryMeta.GET("", categoryMetaController.GetAllCategoryMeta)
+			categoryMeta.GET("/:category", categoryMetaController.GetCategoryMeta)
+			categoryMeta.PUT("/:category", categoryMetaController.SetCategoryMeta)
+			categoryMeta.DELETE("/:category", categoryMetaController.DeleteCategoryMeta)
+		}
+
+		// Settings
+		api.GET("/settings", settingsController.GetSettings)
+
+		// Currencies
+		api.GET("/currencies/meta", currencyController.GetCurrencyMeta)
+
+		// Storage diagnostics
+		api.GET("/admin/integrity", transactionController.CheckIntegrity)
+	}
+
+	// v2 routes
+	apiV2 := router.Group("/api/v2")
+	{
+		transactions := apiV2.Group("/transactions")
+		{
+			transactions.GET("", transactionControllerV2.GetTransactions)
+			transactions.GET("/recent", transactionControllerV2.GetRecentTransactions)
+			transactions.GET("/:id", transactionControllerV2.GetTransaction)
 		}
 	}
 
@@ -99,6 +235,11 @@ func setupTestRoutes(
 
 // MakeRequest performs an HTTP request and returns the response
 func (ts *TestServer) MakeRequest(method, url string, body interface{}) *httptest.ResponseRecorder {
+	return ts.MakeRequestWithHeaders(method, url, body, nil)
+}
+
+// MakeRequestWithHeaders performs an HTTP request with extra headers and returns the response
+func (ts *TestServer) MakeRequestWithHeaders(method, url string, body interface{}, headers map[string]string) *httptest.ResponseRecorder {
 	var reqBody *bytes.Buffer
 	if body != nil {
 		jsonBody, _ := json.Marshal(body)
@@ -111,6 +252,20 @@ func (ts *TestServer) MakeRequest(method, url string, body interface{}) *httptes
 	if body != nil {
 		req.Header.Set("Content-Type", "application/json")
 	}
+	for key, value := range headers {
+		req.Header.Set(key, value)
+	}
+
+	w := httptest.NewRecorder()
+	ts.Router.ServeHTTP(w, req)
+
+	return w
+}
+
+// MakeRawRequest performs an HTTP request with a non-JSON body and returns the response
+func (ts *TestServer) MakeRawRequest(method, url, contentType, body string) *httptest.ResponseRecorder {
+	req, _ := http.NewRequest(method, url, bytes.NewBufferString(body))
+	req.Header.Set("Content-Type", contentType)
 
 	w := httptest.NewRecorder()
 	ts.Router.ServeHTTP(w, req)
@@ -160,17 +315,17 @@ func SafeGetMap(t *testing.T, response map[string]interface{}, key string) map[s
 		t.Logf("Key %s not found in response", key)
 		return make(map[string]interface{})
 	}
-	
+
 	if value == nil {
 		return make(map[string]interface{})
 	}
-	
+
 	mapValue, ok := value.(map[string]interface{})
 	if !ok {
 		t.Logf("Key %s is not a map, got type: %T", key, value)
 		return make(map[string]interface{})
 	}
-	
+
 	return mapValue
 }
 
@@ -181,16 +336,16 @@ func SafeGetArray(t *testing.T, response map[string]interface{}, key string) []i
 		t.Logf("Key %s not found in response", key)
 		return make([]interface{}, 0)
 	}
-	
+
 	if value == nil {
 		return make([]interface{}, 0)
 	}
-	
+
 	arrayValue, ok := value.([]interface{})
 	if !ok {
 		t.Logf("Key %s is not an array, got type: %T", key, value)
 		return make([]interface{}, 0)
 	}
-	
+
 	return arrayValue
-}
\ No newline at end of file
+}
@@ -0,0 +1,142 @@
+package utils
+
+import (
+	"encoding/csv"
+	"io"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/maximicciullo/personal-finance-api/internal/models"
+)
+
+const CSVContentType = "text/csv; charset=utf-8"
+
+// TransactionCSVHeader lists the CSV columns in the order rows are written
+var TransactionCSVHeader = []string{
+	"id", "type", "amount", "currency", "description", "category", "account", "date", "created_at", "updated_at",
+}
+
+// WriteTransactionsCSV writes a header row followed by one row per transaction
+func WriteTransactionsCSV(w io.Writer, transactions []models.Transaction) error {
+	writer := csv.NewWriter(w)
+
+	if err := writer.Write(TransactionCSVHeader); err != nil {
+		return err
+	}
+
+	for _, transaction := range transactions {
+		if err := writer.Write(transactionCSVRow(transaction)); err != nil {
+			return err
+		}
+	}
+
+	writer.Flush()
+	return writer.Error()
+}
+
+// WriteMonthlyReportCSV writes the report's transactions like WriteTransactionsCSV, then a
+// totals footer with income, expense and balance rows for each currency. Footer rows carry
+// the total's name in the type column and leave the per-transaction columns empty.
+func WriteMonthlyReportCSV(w io.Writer, report *models.MonthlyReport) error {
+	writer := csv.NewWriter(w)
+
+	if err := writer.Write(TransactionCSVHeader); err != nil {
+		return err
+	}
+
+	for _, transaction := range report.Transactions {
+		if err := writer.Write(transactionCSVRow(transaction)); err != nil {
+			return err
+		}
+	}
+
+	for _, currency := range reportCurrencies(report) {
+		totals := []struct {
+			name   string
+			amount models.Money
+		}{
+			{"total_income", report.TotalIncome[currency]},
+			{"total_expense", report.TotalExpense[currency]},
+			{"balance", report.Balance[currency]},
+		}
+		for _, total := range totals {
+			row := make([]string, len(TransactionCSVHeader))
+			row[1] = total.name
+			row[2] = strconv.FormatFloat(float64(total.amount), 'f', -1, 64)
+			row[3] = currency
+			if err := writer.Write(row); err != nil {
+				return err
+			}
+		}
+	}
+
+	writer.Flush()
+	return writer.Error()
+}
+
+// reportCurrencies returns every currency with a total in the report, sorted
+func reportCurrencies(report *models.MonthlyReport) []string {
+	seen := make(map[string]bool)
+	for _, totals := range []map[string]models.Money{report.TotalIncome, report.TotalExpense, report.Balance} {
+		for currency := range totals {
+			seen[currency] = true
+		}
+	}
+
+	currencies := make([]string, 0, len(seen))
+	for currency := range seen {
+		currencies = append(currencies, currency)
+	}
+	sort.Strings(currencies)
+	return currencies
+}
+
+// csvFlushEvery controls how often streamed rows are pushed to the underlying writer
+const csvFlushEvery = 100
+
+// StreamTransactionsCSV writes a header row and then one row per transaction received,
+// without holding the full set in memory. It returns the first error from errs, if any.
+func StreamTransactionsCSV(w io.Writer, transactions <-chan models.Transaction, errs <-chan error) error {
+	writer := csv.NewWriter(w)
+
+	if err := writer.Write(TransactionCSVHeader); err != nil {
+		return err
+	}
+
+	rows := 0
+	for transaction := range transactions {
+		if err := writer.Write(transactionCSVRow(transaction)); err != nil {
+			return err
+		}
+		rows++
+		if rows%csvFlushEvery == 0 {
+			writer.Flush()
+			if err := writer.Error(); err != nil {
+				return err
+			}
+		}
+	}
+
+	writer.Flush()
+	if err := writer.Error(); err != nil {
+		return err
+	}
+
+	return <-errs
+}
+
+func transactionCSVRow(transaction models.Transaction) []string {
+	return []string{
+		strconv.Itoa(transaction.ID),
+		transaction.Type,
+		strconv.FormatFloat(float64(transaction.Amount), 'f', -1, 64),
+		transaction.Currency,
+		transaction.Description,
+		transaction.Category,
+		transaction.Account,
+		transaction.Date.Format(time.RFC3339),
+		transaction.CreatedAt.Format(time.RFC3339),
+		transaction.UpdatedAt.Format(time.RFC3339),
+	}
+}
@@ -0,0 +1,25 @@
+package utils
+
+import (
+	"encoding/json"
+	"io"
+
+	"github.com/maximicciullo/personal-finance-api/internal/models"
+)
+
+const NDJSONContentType = "application/x-ndjson"
+
+// StreamTransactionsJSONL writes each transaction received as a JSON object on its own
+// line, without holding the full set in memory. It returns the first error from errs, if any.
+func StreamTransactionsJSONL(w io.Writer, transactions <-chan models.Transaction, errs <-chan error) error {
+	encoder := json.NewEncoder(w)
+
+	for transaction := range transactions {
+		// Encode terminates every value with a newline
+		if err := encoder.Encode(transaction); err != nil {
+			return err
+		}
+	}
+
+	return <-errs
+}